@@ -0,0 +1,123 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.9
+// source: server/v1/billing.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Billing_ListInvoices_FullMethodName = "/tigrisdata.billing.v1.Billing/ListInvoices"
+)
+
+// BillingClient is the client API for Billing service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BillingClient interface {
+	// ListInvoices fetches past invoices for this user
+	ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error)
+}
+
+type billingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBillingClient(cc grpc.ClientConnInterface) BillingClient {
+	return &billingClient{cc}
+}
+
+func (c *billingClient) ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error) {
+	out := new(ListInvoicesResponse)
+	err := c.cc.Invoke(ctx, Billing_ListInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BillingServer is the server API for Billing service.
+// All implementations should embed UnimplementedBillingServer
+// for forward compatibility
+type BillingServer interface {
+	// ListInvoices fetches past invoices for this user
+	ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error)
+}
+
+// UnimplementedBillingServer should be embedded to have forward compatible implementations.
+type UnimplementedBillingServer struct {
+}
+
+func (UnimplementedBillingServer) ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
+}
+
+// UnsafeBillingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BillingServer will
+// result in compilation errors.
+type UnsafeBillingServer interface {
+	mustEmbedUnimplementedBillingServer()
+}
+
+func RegisterBillingServer(s grpc.ServiceRegistrar, srv BillingServer) {
+	s.RegisterService(&Billing_ServiceDesc, srv)
+}
+
+func _Billing_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Billing_ListInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServer).ListInvoices(ctx, req.(*ListInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Billing_ServiceDesc is the grpc.ServiceDesc for Billing service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Billing_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tigrisdata.billing.v1.Billing",
+	HandlerType: (*BillingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInvoices",
+			Handler:    _Billing_ListInvoices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/v1/billing.proto",
+}
@@ -154,6 +154,208 @@ func (x *KeysRequest) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UnmarshalJSON for MSetRequest.
+func (x *MSetRequest) UnmarshalJSON(data []byte) error {
+	var mp map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(data, &mp); err != nil {
+		return err
+	}
+
+	for key, value := range mp {
+		var v any
+
+		switch strings.ToLower(key) {
+		case "project":
+			v = &x.Project
+		case "name":
+			v = &x.Name
+		case "values":
+			values, err := unmarshalRawMap(value)
+			if err != nil {
+				return err
+			}
+			x.Values = values
+			continue
+		default:
+			continue
+		}
+		if err := jsoniter.Unmarshal(value, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON for CompareAndSetRequest.
+func (x *CompareAndSetRequest) UnmarshalJSON(data []byte) error {
+	var mp map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(data, &mp); err != nil {
+		return err
+	}
+
+	for key, value := range mp {
+		var v any
+
+		switch strings.ToLower(key) {
+		case "project":
+			v = &x.Project
+		case "name":
+			v = &x.Name
+		case "key":
+			v = &x.Key
+		case "expected":
+			var doc jsoniter.RawMessage
+			if err := jsoniter.Unmarshal(value, &doc); err != nil {
+				return err
+			}
+			// null expects the key to not exist
+			if string(doc) != "null" {
+				x.Expected = doc
+			}
+			continue
+		case "value":
+			var doc jsoniter.RawMessage
+			if err := jsoniter.Unmarshal(value, &doc); err != nil {
+				return err
+			}
+			x.Value = doc
+			continue
+		case "ex":
+			v = &x.Ex
+		case "px":
+			v = &x.Px
+		default:
+			continue
+		}
+		if err := jsoniter.Unmarshal(value, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON for HSetRequest.
+func (x *HSetRequest) UnmarshalJSON(data []byte) error {
+	var mp map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(data, &mp); err != nil {
+		return err
+	}
+
+	for key, value := range mp {
+		var v any
+
+		switch strings.ToLower(key) {
+		case "project":
+			v = &x.Project
+		case "name":
+			v = &x.Name
+		case "key":
+			v = &x.Key
+		case "fields":
+			fields, err := unmarshalRawMap(value)
+			if err != nil {
+				return err
+			}
+			x.Fields = fields
+			continue
+		default:
+			continue
+		}
+		if err := jsoniter.Unmarshal(value, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON for LPushRequest.
+func (x *LPushRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalPushRequest(data, &x.Project, &x.Name, &x.Key, &x.Values)
+}
+
+// UnmarshalJSON for RPushRequest.
+func (x *RPushRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalPushRequest(data, &x.Project, &x.Name, &x.Key, &x.Values)
+}
+
+func unmarshalPushRequest(data []byte, project *string, name *string, key *string, values *[][]byte) error {
+	var mp map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(data, &mp); err != nil {
+		return err
+	}
+
+	for k, value := range mp {
+		var v any
+
+		switch strings.ToLower(k) {
+		case "project":
+			v = project
+		case "name":
+			v = name
+		case "key":
+			v = key
+		case "values":
+			list, err := unmarshalRawList(value)
+			if err != nil {
+				return err
+			}
+			*values = list
+			continue
+		default:
+			continue
+		}
+		if err := jsoniter.Unmarshal(value, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON for ZAddRequest.
+func (x *ZAddRequest) UnmarshalJSON(data []byte) error {
+	var mp map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(data, &mp); err != nil {
+		return err
+	}
+
+	for key, value := range mp {
+		var v any
+
+		switch strings.ToLower(key) {
+		case "project":
+			v = &x.Project
+		case "name":
+			v = &x.Name
+		case "key":
+			v = &x.Key
+		case "members":
+			var members []struct {
+				Member jsoniter.RawMessage `json:"member"`
+				Score  float64             `json:"score"`
+			}
+			if err := jsoniter.Unmarshal(value, &members); err != nil {
+				return err
+			}
+			x.Members = make([]*ScoredMember, len(members))
+			for i, m := range members {
+				x.Members[i] = &ScoredMember{Member: m.Member, Score: m.Score}
+			}
+			continue
+		default:
+			continue
+		}
+		if err := jsoniter.Unmarshal(value, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (x *GetSetResponse) MarshalJSON() ([]byte, error) {
 	resp := struct {
 		Status   string              `json:"status,omitempty"`
@@ -176,6 +378,97 @@ func (x *GetResponse) MarshalJSON() ([]byte, error) {
 	return jsoniter.Marshal(resp)
 }
 
+func (x *MGetResponse) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Values map[string]jsoniter.RawMessage `json:"values"`
+	}{
+		Values: rawMap(x.GetValues()),
+	}
+	return jsoniter.Marshal(resp)
+}
+
+func (x *IncrResponse) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Value int64 `json:"value"`
+	}{
+		Value: x.GetValue(),
+	}
+	return jsoniter.Marshal(resp)
+}
+
+func (x *DecrResponse) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Value int64 `json:"value"`
+	}{
+		Value: x.GetValue(),
+	}
+	return jsoniter.Marshal(resp)
+}
+
+func (x *HGetResponse) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Value jsoniter.RawMessage `json:"value,omitempty"`
+	}{
+		Value: x.GetValue(),
+	}
+	return jsoniter.Marshal(resp)
+}
+
+func (x *HGetAllResponse) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Fields map[string]jsoniter.RawMessage `json:"fields"`
+	}{
+		Fields: rawMap(x.GetFields()),
+	}
+	return jsoniter.Marshal(resp)
+}
+
+func (x *LPopResponse) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Value jsoniter.RawMessage `json:"value,omitempty"`
+	}{
+		Value: x.GetValue(),
+	}
+	return jsoniter.Marshal(resp)
+}
+
+func (x *RPopResponse) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Value jsoniter.RawMessage `json:"value,omitempty"`
+	}{
+		Value: x.GetValue(),
+	}
+	return jsoniter.Marshal(resp)
+}
+
+func (x *LRangeResponse) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Values []jsoniter.RawMessage `json:"values"`
+	}{
+		Values: rawList(x.GetValues()),
+	}
+	return jsoniter.Marshal(resp)
+}
+
+func (x *ZRangeResponse) MarshalJSON() ([]byte, error) {
+	type member struct {
+		Member jsoniter.RawMessage `json:"member"`
+		Score  float64             `json:"score"`
+	}
+
+	members := make([]member, len(x.GetMembers()))
+	for i, m := range x.GetMembers() {
+		members[i] = member{Member: m.GetMember(), Score: m.GetScore()}
+	}
+
+	resp := struct {
+		Members []member `json:"members"`
+	}{
+		Members: members,
+	}
+	return jsoniter.Marshal(resp)
+}
+
 func (x *KeysResponse) MarshalJSON() ([]byte, error) {
 	resp := struct {
 		Keys   []string `json:"keys"`
@@ -186,3 +479,49 @@ func (x *KeysResponse) MarshalJSON() ([]byte, error) {
 	}
 	return jsoniter.Marshal(resp)
 }
+
+func unmarshalRawMap(data []byte) (map[string][]byte, error) {
+	var mp map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(data, &mp); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]byte, len(mp))
+	for k, v := range mp {
+		values[k] = v
+	}
+
+	return values, nil
+}
+
+func unmarshalRawList(data []byte) ([][]byte, error) {
+	var list []jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, len(list))
+	for i, v := range list {
+		values[i] = v
+	}
+
+	return values, nil
+}
+
+func rawMap(values map[string][]byte) map[string]jsoniter.RawMessage {
+	raw := make(map[string]jsoniter.RawMessage, len(values))
+	for k, v := range values {
+		raw[k] = v
+	}
+
+	return raw
+}
+
+func rawList(values [][]byte) []jsoniter.RawMessage {
+	raw := make([]jsoniter.RawMessage, len(values))
+	for i, v := range values {
+		raw[i] = v
+	}
+
+	return raw
+}
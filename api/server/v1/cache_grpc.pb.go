@@ -0,0 +1,1037 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.9
+// source: server/v1/cache.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Cache_CreateCache_FullMethodName   = "/tigrisdata.cache.v1.Cache/CreateCache"
+	Cache_ListCaches_FullMethodName    = "/tigrisdata.cache.v1.Cache/ListCaches"
+	Cache_DeleteCache_FullMethodName   = "/tigrisdata.cache.v1.Cache/DeleteCache"
+	Cache_DescribeCache_FullMethodName = "/tigrisdata.cache.v1.Cache/DescribeCache"
+	Cache_Set_FullMethodName           = "/tigrisdata.cache.v1.Cache/Set"
+	Cache_GetSet_FullMethodName        = "/tigrisdata.cache.v1.Cache/GetSet"
+	Cache_Get_FullMethodName           = "/tigrisdata.cache.v1.Cache/Get"
+	Cache_Del_FullMethodName           = "/tigrisdata.cache.v1.Cache/Del"
+	Cache_Keys_FullMethodName          = "/tigrisdata.cache.v1.Cache/Keys"
+	Cache_MGet_FullMethodName          = "/tigrisdata.cache.v1.Cache/MGet"
+	Cache_MSet_FullMethodName          = "/tigrisdata.cache.v1.Cache/MSet"
+	Cache_Incr_FullMethodName          = "/tigrisdata.cache.v1.Cache/Incr"
+	Cache_Decr_FullMethodName          = "/tigrisdata.cache.v1.Cache/Decr"
+	Cache_CompareAndSet_FullMethodName = "/tigrisdata.cache.v1.Cache/CompareAndSet"
+	Cache_HSet_FullMethodName          = "/tigrisdata.cache.v1.Cache/HSet"
+	Cache_HGet_FullMethodName          = "/tigrisdata.cache.v1.Cache/HGet"
+	Cache_HGetAll_FullMethodName       = "/tigrisdata.cache.v1.Cache/HGetAll"
+	Cache_HDel_FullMethodName          = "/tigrisdata.cache.v1.Cache/HDel"
+	Cache_LPush_FullMethodName         = "/tigrisdata.cache.v1.Cache/LPush"
+	Cache_RPush_FullMethodName         = "/tigrisdata.cache.v1.Cache/RPush"
+	Cache_LPop_FullMethodName          = "/tigrisdata.cache.v1.Cache/LPop"
+	Cache_RPop_FullMethodName          = "/tigrisdata.cache.v1.Cache/RPop"
+	Cache_LRange_FullMethodName        = "/tigrisdata.cache.v1.Cache/LRange"
+	Cache_ZAdd_FullMethodName          = "/tigrisdata.cache.v1.Cache/ZAdd"
+	Cache_ZRange_FullMethodName        = "/tigrisdata.cache.v1.Cache/ZRange"
+)
+
+// CacheClient is the client API for Cache service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CacheClient interface {
+	CreateCache(ctx context.Context, in *CreateCacheRequest, opts ...grpc.CallOption) (*CreateCacheResponse, error)
+	ListCaches(ctx context.Context, in *ListCachesRequest, opts ...grpc.CallOption) (*ListCachesResponse, error)
+	DeleteCache(ctx context.Context, in *DeleteCacheRequest, opts ...grpc.CallOption) (*DeleteCacheResponse, error)
+	DescribeCache(ctx context.Context, in *DescribeCacheRequest, opts ...grpc.CallOption) (*DescribeCacheResponse, error)
+	Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error)
+	GetSet(ctx context.Context, in *GetSetRequest, opts ...grpc.CallOption) (*GetSetResponse, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	Del(ctx context.Context, in *DelRequest, opts ...grpc.CallOption) (*DelResponse, error)
+	Keys(ctx context.Context, in *KeysRequest, opts ...grpc.CallOption) (Cache_KeysClient, error)
+	MGet(ctx context.Context, in *MGetRequest, opts ...grpc.CallOption) (*MGetResponse, error)
+	MSet(ctx context.Context, in *MSetRequest, opts ...grpc.CallOption) (*MSetResponse, error)
+	Incr(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*IncrResponse, error)
+	Decr(ctx context.Context, in *DecrRequest, opts ...grpc.CallOption) (*DecrResponse, error)
+	CompareAndSet(ctx context.Context, in *CompareAndSetRequest, opts ...grpc.CallOption) (*CompareAndSetResponse, error)
+	HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetResponse, error)
+	HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetResponse, error)
+	HGetAll(ctx context.Context, in *HGetAllRequest, opts ...grpc.CallOption) (*HGetAllResponse, error)
+	HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelResponse, error)
+	LPush(ctx context.Context, in *LPushRequest, opts ...grpc.CallOption) (*LPushResponse, error)
+	RPush(ctx context.Context, in *RPushRequest, opts ...grpc.CallOption) (*RPushResponse, error)
+	LPop(ctx context.Context, in *LPopRequest, opts ...grpc.CallOption) (*LPopResponse, error)
+	RPop(ctx context.Context, in *RPopRequest, opts ...grpc.CallOption) (*RPopResponse, error)
+	LRange(ctx context.Context, in *LRangeRequest, opts ...grpc.CallOption) (*LRangeResponse, error)
+	ZAdd(ctx context.Context, in *ZAddRequest, opts ...grpc.CallOption) (*ZAddResponse, error)
+	ZRange(ctx context.Context, in *ZRangeRequest, opts ...grpc.CallOption) (*ZRangeResponse, error)
+}
+
+type cacheClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCacheClient(cc grpc.ClientConnInterface) CacheClient {
+	return &cacheClient{cc}
+}
+
+func (c *cacheClient) CreateCache(ctx context.Context, in *CreateCacheRequest, opts ...grpc.CallOption) (*CreateCacheResponse, error) {
+	out := new(CreateCacheResponse)
+	err := c.cc.Invoke(ctx, Cache_CreateCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ListCaches(ctx context.Context, in *ListCachesRequest, opts ...grpc.CallOption) (*ListCachesResponse, error) {
+	out := new(ListCachesResponse)
+	err := c.cc.Invoke(ctx, Cache_ListCaches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) DeleteCache(ctx context.Context, in *DeleteCacheRequest, opts ...grpc.CallOption) (*DeleteCacheResponse, error) {
+	out := new(DeleteCacheResponse)
+	err := c.cc.Invoke(ctx, Cache_DeleteCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) DescribeCache(ctx context.Context, in *DescribeCacheRequest, opts ...grpc.CallOption) (*DescribeCacheResponse, error) {
+	out := new(DescribeCacheResponse)
+	err := c.cc.Invoke(ctx, Cache_DescribeCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Set(ctx context.Context, in *SetRequest, opts ...grpc.CallOption) (*SetResponse, error) {
+	out := new(SetResponse)
+	err := c.cc.Invoke(ctx, Cache_Set_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) GetSet(ctx context.Context, in *GetSetRequest, opts ...grpc.CallOption) (*GetSetResponse, error) {
+	out := new(GetSetResponse)
+	err := c.cc.Invoke(ctx, Cache_GetSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, Cache_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Del(ctx context.Context, in *DelRequest, opts ...grpc.CallOption) (*DelResponse, error) {
+	out := new(DelResponse)
+	err := c.cc.Invoke(ctx, Cache_Del_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Keys(ctx context.Context, in *KeysRequest, opts ...grpc.CallOption) (Cache_KeysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Cache_ServiceDesc.Streams[0], Cache_Keys_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Cache_KeysClient interface {
+	Recv() (*KeysResponse, error)
+	grpc.ClientStream
+}
+
+type cacheKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheKeysClient) Recv() (*KeysResponse, error) {
+	m := new(KeysResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheClient) MGet(ctx context.Context, in *MGetRequest, opts ...grpc.CallOption) (*MGetResponse, error) {
+	out := new(MGetResponse)
+	err := c.cc.Invoke(ctx, Cache_MGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) MSet(ctx context.Context, in *MSetRequest, opts ...grpc.CallOption) (*MSetResponse, error) {
+	out := new(MSetResponse)
+	err := c.cc.Invoke(ctx, Cache_MSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Incr(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*IncrResponse, error) {
+	out := new(IncrResponse)
+	err := c.cc.Invoke(ctx, Cache_Incr_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) Decr(ctx context.Context, in *DecrRequest, opts ...grpc.CallOption) (*DecrResponse, error) {
+	out := new(DecrResponse)
+	err := c.cc.Invoke(ctx, Cache_Decr_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) CompareAndSet(ctx context.Context, in *CompareAndSetRequest, opts ...grpc.CallOption) (*CompareAndSetResponse, error) {
+	out := new(CompareAndSetResponse)
+	err := c.cc.Invoke(ctx, Cache_CompareAndSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetResponse, error) {
+	out := new(HSetResponse)
+	err := c.cc.Invoke(ctx, Cache_HSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetResponse, error) {
+	out := new(HGetResponse)
+	err := c.cc.Invoke(ctx, Cache_HGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) HGetAll(ctx context.Context, in *HGetAllRequest, opts ...grpc.CallOption) (*HGetAllResponse, error) {
+	out := new(HGetAllResponse)
+	err := c.cc.Invoke(ctx, Cache_HGetAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelResponse, error) {
+	out := new(HDelResponse)
+	err := c.cc.Invoke(ctx, Cache_HDel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) LPush(ctx context.Context, in *LPushRequest, opts ...grpc.CallOption) (*LPushResponse, error) {
+	out := new(LPushResponse)
+	err := c.cc.Invoke(ctx, Cache_LPush_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) RPush(ctx context.Context, in *RPushRequest, opts ...grpc.CallOption) (*RPushResponse, error) {
+	out := new(RPushResponse)
+	err := c.cc.Invoke(ctx, Cache_RPush_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) LPop(ctx context.Context, in *LPopRequest, opts ...grpc.CallOption) (*LPopResponse, error) {
+	out := new(LPopResponse)
+	err := c.cc.Invoke(ctx, Cache_LPop_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) RPop(ctx context.Context, in *RPopRequest, opts ...grpc.CallOption) (*RPopResponse, error) {
+	out := new(RPopResponse)
+	err := c.cc.Invoke(ctx, Cache_RPop_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) LRange(ctx context.Context, in *LRangeRequest, opts ...grpc.CallOption) (*LRangeResponse, error) {
+	out := new(LRangeResponse)
+	err := c.cc.Invoke(ctx, Cache_LRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ZAdd(ctx context.Context, in *ZAddRequest, opts ...grpc.CallOption) (*ZAddResponse, error) {
+	out := new(ZAddResponse)
+	err := c.cc.Invoke(ctx, Cache_ZAdd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ZRange(ctx context.Context, in *ZRangeRequest, opts ...grpc.CallOption) (*ZRangeResponse, error) {
+	out := new(ZRangeResponse)
+	err := c.cc.Invoke(ctx, Cache_ZRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CacheServer is the server API for Cache service.
+// All implementations should embed UnimplementedCacheServer
+// for forward compatibility
+type CacheServer interface {
+	CreateCache(context.Context, *CreateCacheRequest) (*CreateCacheResponse, error)
+	ListCaches(context.Context, *ListCachesRequest) (*ListCachesResponse, error)
+	DeleteCache(context.Context, *DeleteCacheRequest) (*DeleteCacheResponse, error)
+	DescribeCache(context.Context, *DescribeCacheRequest) (*DescribeCacheResponse, error)
+	Set(context.Context, *SetRequest) (*SetResponse, error)
+	GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error)
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	Del(context.Context, *DelRequest) (*DelResponse, error)
+	Keys(*KeysRequest, Cache_KeysServer) error
+	MGet(context.Context, *MGetRequest) (*MGetResponse, error)
+	MSet(context.Context, *MSetRequest) (*MSetResponse, error)
+	Incr(context.Context, *IncrRequest) (*IncrResponse, error)
+	Decr(context.Context, *DecrRequest) (*DecrResponse, error)
+	CompareAndSet(context.Context, *CompareAndSetRequest) (*CompareAndSetResponse, error)
+	HSet(context.Context, *HSetRequest) (*HSetResponse, error)
+	HGet(context.Context, *HGetRequest) (*HGetResponse, error)
+	HGetAll(context.Context, *HGetAllRequest) (*HGetAllResponse, error)
+	HDel(context.Context, *HDelRequest) (*HDelResponse, error)
+	LPush(context.Context, *LPushRequest) (*LPushResponse, error)
+	RPush(context.Context, *RPushRequest) (*RPushResponse, error)
+	LPop(context.Context, *LPopRequest) (*LPopResponse, error)
+	RPop(context.Context, *RPopRequest) (*RPopResponse, error)
+	LRange(context.Context, *LRangeRequest) (*LRangeResponse, error)
+	ZAdd(context.Context, *ZAddRequest) (*ZAddResponse, error)
+	ZRange(context.Context, *ZRangeRequest) (*ZRangeResponse, error)
+}
+
+// UnimplementedCacheServer should be embedded to have forward compatible implementations.
+type UnimplementedCacheServer struct {
+}
+
+func (UnimplementedCacheServer) CreateCache(context.Context, *CreateCacheRequest) (*CreateCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCache not implemented")
+}
+func (UnimplementedCacheServer) ListCaches(context.Context, *ListCachesRequest) (*ListCachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCaches not implemented")
+}
+func (UnimplementedCacheServer) DeleteCache(context.Context, *DeleteCacheRequest) (*DeleteCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCache not implemented")
+}
+func (UnimplementedCacheServer) DescribeCache(context.Context, *DescribeCacheRequest) (*DescribeCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeCache not implemented")
+}
+func (UnimplementedCacheServer) Set(context.Context, *SetRequest) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedCacheServer) GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSet not implemented")
+}
+func (UnimplementedCacheServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCacheServer) Del(context.Context, *DelRequest) (*DelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Del not implemented")
+}
+func (UnimplementedCacheServer) Keys(*KeysRequest, Cache_KeysServer) error {
+	return status.Errorf(codes.Unimplemented, "method Keys not implemented")
+}
+func (UnimplementedCacheServer) MGet(context.Context, *MGetRequest) (*MGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MGet not implemented")
+}
+func (UnimplementedCacheServer) MSet(context.Context, *MSetRequest) (*MSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MSet not implemented")
+}
+func (UnimplementedCacheServer) Incr(context.Context, *IncrRequest) (*IncrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Incr not implemented")
+}
+func (UnimplementedCacheServer) Decr(context.Context, *DecrRequest) (*DecrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decr not implemented")
+}
+func (UnimplementedCacheServer) CompareAndSet(context.Context, *CompareAndSetRequest) (*CompareAndSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndSet not implemented")
+}
+func (UnimplementedCacheServer) HSet(context.Context, *HSetRequest) (*HSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HSet not implemented")
+}
+func (UnimplementedCacheServer) HGet(context.Context, *HGetRequest) (*HGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGet not implemented")
+}
+func (UnimplementedCacheServer) HGetAll(context.Context, *HGetAllRequest) (*HGetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGetAll not implemented")
+}
+func (UnimplementedCacheServer) HDel(context.Context, *HDelRequest) (*HDelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HDel not implemented")
+}
+func (UnimplementedCacheServer) LPush(context.Context, *LPushRequest) (*LPushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPush not implemented")
+}
+func (UnimplementedCacheServer) RPush(context.Context, *RPushRequest) (*RPushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPush not implemented")
+}
+func (UnimplementedCacheServer) LPop(context.Context, *LPopRequest) (*LPopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPop not implemented")
+}
+func (UnimplementedCacheServer) RPop(context.Context, *RPopRequest) (*RPopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPop not implemented")
+}
+func (UnimplementedCacheServer) LRange(context.Context, *LRangeRequest) (*LRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LRange not implemented")
+}
+func (UnimplementedCacheServer) ZAdd(context.Context, *ZAddRequest) (*ZAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZAdd not implemented")
+}
+func (UnimplementedCacheServer) ZRange(context.Context, *ZRangeRequest) (*ZRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRange not implemented")
+}
+
+// UnsafeCacheServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CacheServer will
+// result in compilation errors.
+type UnsafeCacheServer interface {
+	mustEmbedUnimplementedCacheServer()
+}
+
+func RegisterCacheServer(s grpc.ServiceRegistrar, srv CacheServer) {
+	s.RegisterService(&Cache_ServiceDesc, srv)
+}
+
+func _Cache_CreateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).CreateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_CreateCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).CreateCache(ctx, req.(*CreateCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ListCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ListCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ListCaches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ListCaches(ctx, req.(*ListCachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_DeleteCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).DeleteCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_DeleteCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).DeleteCache(ctx, req.(*DeleteCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_DescribeCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).DescribeCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_DescribeCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).DescribeCache(ctx, req.(*DescribeCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Set(ctx, req.(*SetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_GetSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).GetSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_GetSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).GetSet(ctx, req.(*GetSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Del_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Del(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Del_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Del(ctx, req.(*DelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Keys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(KeysRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServer).Keys(m, &cacheKeysServer{stream})
+}
+
+type Cache_KeysServer interface {
+	Send(*KeysResponse) error
+	grpc.ServerStream
+}
+
+type cacheKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheKeysServer) Send(m *KeysResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Cache_MGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).MGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_MGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).MGet(ctx, req.(*MGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_MSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).MSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_MSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).MSet(ctx, req.(*MSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Incr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Incr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Incr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Incr(ctx, req.(*IncrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_Decr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).Decr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_Decr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).Decr(ctx, req.(*DecrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_CompareAndSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).CompareAndSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_CompareAndSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).CompareAndSet(ctx, req.(*CompareAndSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_HSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).HSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_HSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).HSet(ctx, req.(*HSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_HGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).HGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_HGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).HGet(ctx, req.(*HGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_HGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).HGetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_HGetAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).HGetAll(ctx, req.(*HGetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_HDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).HDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_HDel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).HDel(ctx, req.(*HDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_LPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).LPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_LPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).LPush(ctx, req.(*LPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_RPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).RPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_RPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).RPush(ctx, req.(*RPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_LPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).LPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_LPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).LPop(ctx, req.(*LPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_RPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).RPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_RPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).RPop(ctx, req.(*RPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_LRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).LRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_LRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).LRange(ctx, req.(*LRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ZAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ZAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ZAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ZAdd(ctx, req.(*ZAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ZRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ZRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ZRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ZRange(ctx, req.(*ZRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Cache_ServiceDesc is the grpc.ServiceDesc for Cache service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Cache_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tigrisdata.cache.v1.Cache",
+	HandlerType: (*CacheServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCache",
+			Handler:    _Cache_CreateCache_Handler,
+		},
+		{
+			MethodName: "ListCaches",
+			Handler:    _Cache_ListCaches_Handler,
+		},
+		{
+			MethodName: "DeleteCache",
+			Handler:    _Cache_DeleteCache_Handler,
+		},
+		{
+			MethodName: "DescribeCache",
+			Handler:    _Cache_DescribeCache_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _Cache_Set_Handler,
+		},
+		{
+			MethodName: "GetSet",
+			Handler:    _Cache_GetSet_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Cache_Get_Handler,
+		},
+		{
+			MethodName: "Del",
+			Handler:    _Cache_Del_Handler,
+		},
+		{
+			MethodName: "MGet",
+			Handler:    _Cache_MGet_Handler,
+		},
+		{
+			MethodName: "MSet",
+			Handler:    _Cache_MSet_Handler,
+		},
+		{
+			MethodName: "Incr",
+			Handler:    _Cache_Incr_Handler,
+		},
+		{
+			MethodName: "Decr",
+			Handler:    _Cache_Decr_Handler,
+		},
+		{
+			MethodName: "CompareAndSet",
+			Handler:    _Cache_CompareAndSet_Handler,
+		},
+		{
+			MethodName: "HSet",
+			Handler:    _Cache_HSet_Handler,
+		},
+		{
+			MethodName: "HGet",
+			Handler:    _Cache_HGet_Handler,
+		},
+		{
+			MethodName: "HGetAll",
+			Handler:    _Cache_HGetAll_Handler,
+		},
+		{
+			MethodName: "HDel",
+			Handler:    _Cache_HDel_Handler,
+		},
+		{
+			MethodName: "LPush",
+			Handler:    _Cache_LPush_Handler,
+		},
+		{
+			MethodName: "RPush",
+			Handler:    _Cache_RPush_Handler,
+		},
+		{
+			MethodName: "LPop",
+			Handler:    _Cache_LPop_Handler,
+		},
+		{
+			MethodName: "RPop",
+			Handler:    _Cache_RPop_Handler,
+		},
+		{
+			MethodName: "LRange",
+			Handler:    _Cache_LRange_Handler,
+		},
+		{
+			MethodName: "ZAdd",
+			Handler:    _Cache_ZAdd_Handler,
+		},
+		{
+			MethodName: "ZRange",
+			Handler:    _Cache_ZRange_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Keys",
+			Handler:       _Cache_Keys_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "server/v1/cache.proto",
+}
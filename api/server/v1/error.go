@@ -114,6 +114,29 @@ func (e *TigrisError) RetryDelay() time.Duration {
 	return dur
 }
 
+// WithField attaches the path of the field which caused the error.
+func (e *TigrisError) WithField(field string) *TigrisError {
+	if len(field) > 0 {
+		e.Details = append(e.Details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: e.Message}},
+		})
+	}
+	return e
+}
+
+// Field retrieves the path of the field which caused the error if it's attached to the error.
+func (e *TigrisError) Field() string {
+	var field string
+
+	for _, d := range e.Details {
+		if t, ok := d.(*errdetails.BadRequest); ok && len(t.FieldViolations) > 0 {
+			field = t.FieldViolations[0].Field
+		}
+	}
+
+	return field
+}
+
 // ToGRPCCode converts Tigris error code to GRPC code
 // Extended codes converted to 'Unknown' GRPC code.
 func ToGRPCCode(code Code) codes.Code {
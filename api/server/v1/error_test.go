@@ -46,6 +46,11 @@ func TestTigrisError(t *testing.T) {
 	err = err.WithRetry(7 * time.Second)
 	require.Equal(t, 7*time.Second, err.RetryDelay())
 
+	// Test field path
+	fe := Errorf(Code_INVALID_ARGUMENT, "wrong type").WithField("a.b")
+	require.Equal(t, "a.b", fe.Field())
+	require.Equal(t, "", err.Field())
+
 	st, err1 := status.New(codes.AlreadyExists, "msg2").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(11 * time.Second)})
 	require.NoError(t, err1)
 
@@ -0,0 +1,513 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.9
+// source: server/v1/management.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Management_CreateNamespace_FullMethodName         = "/tigrisdata.management.v1.Management/CreateNamespace"
+	Management_ListNamespaces_FullMethodName          = "/tigrisdata.management.v1.Management/ListNamespaces"
+	Management_DeleteNamespace_FullMethodName         = "/tigrisdata.management.v1.Management/DeleteNamespace"
+	Management_InsertUserMetadata_FullMethodName      = "/tigrisdata.management.v1.Management/InsertUserMetadata"
+	Management_GetUserMetadata_FullMethodName         = "/tigrisdata.management.v1.Management/GetUserMetadata"
+	Management_UpdateUserMetadata_FullMethodName      = "/tigrisdata.management.v1.Management/UpdateUserMetadata"
+	Management_InsertNamespaceMetadata_FullMethodName = "/tigrisdata.management.v1.Management/InsertNamespaceMetadata"
+	Management_GetNamespaceMetadata_FullMethodName    = "/tigrisdata.management.v1.Management/GetNamespaceMetadata"
+	Management_UpdateNamespaceMetadata_FullMethodName = "/tigrisdata.management.v1.Management/UpdateNamespaceMetadata"
+	Management_ListTransactions_FullMethodName        = "/tigrisdata.management.v1.Management/ListTransactions"
+	Management_KillTransaction_FullMethodName         = "/tigrisdata.management.v1.Management/KillTransaction"
+)
+
+// ManagementClient is the client API for Management service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ManagementClient interface {
+	// Creates a new namespace, if it does not exist
+	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
+	// List all namespace and optionally lists specific namespace by namespaceId filter, also supports `describe` request.
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	// DeleteNamespace deletes the namespace and all the data inside it
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	// insertUserMetadata inserts the user metadata object
+	InsertUserMetadata(ctx context.Context, in *InsertUserMetadataRequest, opts ...grpc.CallOption) (*InsertUserMetadataResponse, error)
+	// GetUserMetadata inserts the user metadata object
+	GetUserMetadata(ctx context.Context, in *GetUserMetadataRequest, opts ...grpc.CallOption) (*GetUserMetadataResponse, error)
+	// updateUserMetadata updates the user metadata object
+	UpdateUserMetadata(ctx context.Context, in *UpdateUserMetadataRequest, opts ...grpc.CallOption) (*UpdateUserMetadataResponse, error)
+	// InsertNamespaceMetadata inserts the namespace metadata object
+	InsertNamespaceMetadata(ctx context.Context, in *InsertNamespaceMetadataRequest, opts ...grpc.CallOption) (*InsertNamespaceMetadataResponse, error)
+	// GetNamespaceMetadata inserts the user metadata object
+	GetNamespaceMetadata(ctx context.Context, in *GetNamespaceMetadataRequest, opts ...grpc.CallOption) (*GetNamespaceMetadataResponse, error)
+	// UpdateNamespaceMetadata updates the user metadata object
+	UpdateNamespaceMetadata(ctx context.Context, in *UpdateNamespaceMetadataRequest, opts ...grpc.CallOption) (*UpdateNamespaceMetadataResponse, error)
+	// Lists the explicit transactions open on the server.
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// Rolls back the explicit transaction open on the server.
+	KillTransaction(ctx context.Context, in *KillTransactionRequest, opts ...grpc.CallOption) (*KillTransactionResponse, error)
+}
+
+type managementClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManagementClient(cc grpc.ClientConnInterface) ManagementClient {
+	return &managementClient{cc}
+}
+
+func (c *managementClient) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error) {
+	out := new(CreateNamespaceResponse)
+	err := c.cc.Invoke(ctx, Management_CreateNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, Management_ListNamespaces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, Management_DeleteNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) InsertUserMetadata(ctx context.Context, in *InsertUserMetadataRequest, opts ...grpc.CallOption) (*InsertUserMetadataResponse, error) {
+	out := new(InsertUserMetadataResponse)
+	err := c.cc.Invoke(ctx, Management_InsertUserMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) GetUserMetadata(ctx context.Context, in *GetUserMetadataRequest, opts ...grpc.CallOption) (*GetUserMetadataResponse, error) {
+	out := new(GetUserMetadataResponse)
+	err := c.cc.Invoke(ctx, Management_GetUserMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) UpdateUserMetadata(ctx context.Context, in *UpdateUserMetadataRequest, opts ...grpc.CallOption) (*UpdateUserMetadataResponse, error) {
+	out := new(UpdateUserMetadataResponse)
+	err := c.cc.Invoke(ctx, Management_UpdateUserMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) InsertNamespaceMetadata(ctx context.Context, in *InsertNamespaceMetadataRequest, opts ...grpc.CallOption) (*InsertNamespaceMetadataResponse, error) {
+	out := new(InsertNamespaceMetadataResponse)
+	err := c.cc.Invoke(ctx, Management_InsertNamespaceMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) GetNamespaceMetadata(ctx context.Context, in *GetNamespaceMetadataRequest, opts ...grpc.CallOption) (*GetNamespaceMetadataResponse, error) {
+	out := new(GetNamespaceMetadataResponse)
+	err := c.cc.Invoke(ctx, Management_GetNamespaceMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) UpdateNamespaceMetadata(ctx context.Context, in *UpdateNamespaceMetadataRequest, opts ...grpc.CallOption) (*UpdateNamespaceMetadataResponse, error) {
+	out := new(UpdateNamespaceMetadataResponse)
+	err := c.cc.Invoke(ctx, Management_UpdateNamespaceMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, Management_ListTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) KillTransaction(ctx context.Context, in *KillTransactionRequest, opts ...grpc.CallOption) (*KillTransactionResponse, error) {
+	out := new(KillTransactionResponse)
+	err := c.cc.Invoke(ctx, Management_KillTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagementServer is the server API for Management service.
+// All implementations should embed UnimplementedManagementServer
+// for forward compatibility
+type ManagementServer interface {
+	// Creates a new namespace, if it does not exist
+	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
+	// List all namespace and optionally lists specific namespace by namespaceId filter, also supports `describe` request.
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	// DeleteNamespace deletes the namespace and all the data inside it
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	// insertUserMetadata inserts the user metadata object
+	InsertUserMetadata(context.Context, *InsertUserMetadataRequest) (*InsertUserMetadataResponse, error)
+	// GetUserMetadata inserts the user metadata object
+	GetUserMetadata(context.Context, *GetUserMetadataRequest) (*GetUserMetadataResponse, error)
+	// updateUserMetadata updates the user metadata object
+	UpdateUserMetadata(context.Context, *UpdateUserMetadataRequest) (*UpdateUserMetadataResponse, error)
+	// InsertNamespaceMetadata inserts the namespace metadata object
+	InsertNamespaceMetadata(context.Context, *InsertNamespaceMetadataRequest) (*InsertNamespaceMetadataResponse, error)
+	// GetNamespaceMetadata inserts the user metadata object
+	GetNamespaceMetadata(context.Context, *GetNamespaceMetadataRequest) (*GetNamespaceMetadataResponse, error)
+	// UpdateNamespaceMetadata updates the user metadata object
+	UpdateNamespaceMetadata(context.Context, *UpdateNamespaceMetadataRequest) (*UpdateNamespaceMetadataResponse, error)
+	// Lists the explicit transactions open on the server.
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// Rolls back the explicit transaction open on the server.
+	KillTransaction(context.Context, *KillTransactionRequest) (*KillTransactionResponse, error)
+}
+
+// UnimplementedManagementServer should be embedded to have forward compatible implementations.
+type UnimplementedManagementServer struct {
+}
+
+func (UnimplementedManagementServer) CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (UnimplementedManagementServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
+}
+func (UnimplementedManagementServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedManagementServer) InsertUserMetadata(context.Context, *InsertUserMetadataRequest) (*InsertUserMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertUserMetadata not implemented")
+}
+func (UnimplementedManagementServer) GetUserMetadata(context.Context, *GetUserMetadataRequest) (*GetUserMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserMetadata not implemented")
+}
+func (UnimplementedManagementServer) UpdateUserMetadata(context.Context, *UpdateUserMetadataRequest) (*UpdateUserMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserMetadata not implemented")
+}
+func (UnimplementedManagementServer) InsertNamespaceMetadata(context.Context, *InsertNamespaceMetadataRequest) (*InsertNamespaceMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertNamespaceMetadata not implemented")
+}
+func (UnimplementedManagementServer) GetNamespaceMetadata(context.Context, *GetNamespaceMetadataRequest) (*GetNamespaceMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceMetadata not implemented")
+}
+func (UnimplementedManagementServer) UpdateNamespaceMetadata(context.Context, *UpdateNamespaceMetadataRequest) (*UpdateNamespaceMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceMetadata not implemented")
+}
+func (UnimplementedManagementServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedManagementServer) KillTransaction(context.Context, *KillTransactionRequest) (*KillTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillTransaction not implemented")
+}
+
+// UnsafeManagementServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManagementServer will
+// result in compilation errors.
+type UnsafeManagementServer interface {
+	mustEmbedUnimplementedManagementServer()
+}
+
+func RegisterManagementServer(s grpc.ServiceRegistrar, srv ManagementServer) {
+	s.RegisterService(&Management_ServiceDesc, srv)
+}
+
+func _Management_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_CreateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).CreateNamespace(ctx, req.(*CreateNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_DeleteNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_InsertUserMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertUserMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).InsertUserMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_InsertUserMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).InsertUserMetadata(ctx, req.(*InsertUserMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_GetUserMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).GetUserMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_GetUserMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).GetUserMetadata(ctx, req.(*GetUserMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_UpdateUserMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).UpdateUserMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_UpdateUserMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).UpdateUserMetadata(ctx, req.(*UpdateUserMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_InsertNamespaceMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertNamespaceMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).InsertNamespaceMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_InsertNamespaceMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).InsertNamespaceMetadata(ctx, req.(*InsertNamespaceMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_GetNamespaceMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).GetNamespaceMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_GetNamespaceMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).GetNamespaceMetadata(ctx, req.(*GetNamespaceMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_UpdateNamespaceMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).UpdateNamespaceMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_UpdateNamespaceMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).UpdateNamespaceMetadata(ctx, req.(*UpdateNamespaceMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_KillTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).KillTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Management_KillTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).KillTransaction(ctx, req.(*KillTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Management_ServiceDesc is the grpc.ServiceDesc for Management service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Management_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tigrisdata.management.v1.Management",
+	HandlerType: (*ManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _Management_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _Management_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _Management_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "InsertUserMetadata",
+			Handler:    _Management_InsertUserMetadata_Handler,
+		},
+		{
+			MethodName: "GetUserMetadata",
+			Handler:    _Management_GetUserMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateUserMetadata",
+			Handler:    _Management_UpdateUserMetadata_Handler,
+		},
+		{
+			MethodName: "InsertNamespaceMetadata",
+			Handler:    _Management_InsertNamespaceMetadata_Handler,
+		},
+		{
+			MethodName: "GetNamespaceMetadata",
+			Handler:    _Management_GetNamespaceMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceMetadata",
+			Handler:    _Management_UpdateNamespaceMetadata_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _Management_ListTransactions_Handler,
+		},
+		{
+			MethodName: "KillTransaction",
+			Handler:    _Management_KillTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/v1/management.proto",
+}
@@ -206,6 +206,10 @@ func (x *SearchRequest) UnmarshalJSON(data []byte) error {
 			// delaying the vector deserialization
 			x.Vector = value
 			continue
+		case "highlight":
+			// delaying the highlight deserialization
+			x.Highlight = value
+			continue
 		case "include_fields":
 			v = &x.IncludeFields
 		case "exclude_fields":
@@ -469,6 +473,8 @@ func (x *CountRequest) UnmarshalJSON(data []byte) error {
 			// not decoding it here and let it decode during filter parsing
 			x.Filter = value
 			continue
+		case "as_of":
+			v = &x.AsOf
 		default:
 			continue
 		}
@@ -929,6 +935,23 @@ type dmlResponse struct {
 	Status        string                `json:"status,omitempty"`
 	ModifiedCount int32                 `json:"modified_count,omitempty"`
 	Keys          []jsoniter.RawMessage `json:"keys,omitempty"`
+	Errors        []*DocError           `json:"errors,omitempty"`
+}
+
+// MarshalJSON on DocError returns the key of the document as an object, same as the keys in the DML responses.
+func (x *DocError) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		Index int32               `json:"index"`
+		Key   jsoniter.RawMessage `json:"key,omitempty"`
+		Field string              `json:"field,omitempty"`
+		Error *Error              `json:"error"`
+	}{
+		Index: x.Index,
+		Key:   x.Key,
+		Field: x.Field,
+		Error: x.Error,
+	}
+	return jsoniter.Marshal(resp)
 }
 
 func (x *InsertResponse) MarshalJSON() ([]byte, error) {
@@ -936,7 +959,12 @@ func (x *InsertResponse) MarshalJSON() ([]byte, error) {
 	for _, k := range x.Keys {
 		keys = append(keys, k)
 	}
-	return jsoniter.Marshal(&dmlResponse{Metadata: CreateMDFromResponseMD(x.Metadata), Status: x.Status, Keys: keys})
+	return jsoniter.Marshal(&dmlResponse{
+		Metadata: CreateMDFromResponseMD(x.Metadata),
+		Status:   x.Status,
+		Keys:     keys,
+		Errors:   x.Errors,
+	})
 }
 
 func (x *ReplaceResponse) MarshalJSON() ([]byte, error) {
@@ -944,7 +972,12 @@ func (x *ReplaceResponse) MarshalJSON() ([]byte, error) {
 	for _, k := range x.Keys {
 		keys = append(keys, k)
 	}
-	return jsoniter.Marshal(&dmlResponse{Metadata: CreateMDFromResponseMD(x.Metadata), Status: x.Status, Keys: keys})
+	return jsoniter.Marshal(&dmlResponse{
+		Metadata: CreateMDFromResponseMD(x.Metadata),
+		Status:   x.Status,
+		Keys:     keys,
+		Errors:   x.Errors,
+	})
 }
 
 func (x *DeleteResponse) MarshalJSON() ([]byte, error) {
@@ -44,6 +44,17 @@ func (x *Message) MarshalJSON() ([]byte, error) {
 	return jsoniter.Marshal(resp)
 }
 
+func (x *PresenceMember) MarshalJSON() ([]byte, error) {
+	resp := struct {
+		ClientId string              `json:"client_id,omitempty"`
+		Data     jsoniter.RawMessage `json:"data,omitempty"`
+	}{
+		ClientId: x.ClientId,
+		Data:     x.Data,
+	}
+	return jsoniter.Marshal(resp)
+}
+
 func (x *AuthEvent) UnmarshalJSON(data []byte) error {
 	var mp map[string]jsoniter.RawMessage
 	if err := jsoniter.Unmarshal(data, &mp); err != nil {
@@ -95,6 +106,15 @@ func (x *SubscribeEvent) UnmarshalJSON(data []byte) error {
 				return err
 			}
 			x.Position = position
+		case "rewind":
+			var rewind string
+			if err := jsoniter.Unmarshal(value, &rewind); err != nil {
+				return err
+			}
+			x.Rewind = rewind
+		case "filter":
+			// the filter is parsed by the server
+			x.Filter = value
 		}
 	}
 
@@ -134,6 +154,33 @@ func (x *MessageEvent) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (x *PresenceMemberEvent) UnmarshalJSON(data []byte) error {
+	var mp map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(data, &mp); err != nil {
+		return err
+	}
+	for key, value := range mp {
+		switch key {
+		case "channel":
+			var channel string
+			if err := jsoniter.Unmarshal(value, &channel); err != nil {
+				return err
+			}
+			x.Channel = channel
+		case "name":
+			var name string
+			if err := jsoniter.Unmarshal(value, &name); err != nil {
+				return err
+			}
+			x.Name = name
+		case "data":
+			x.Data = value
+		}
+	}
+
+	return nil
+}
+
 func (x *RealTimeMessage) UnmarshalJSON(data []byte) error {
 	var mp map[string]jsoniter.RawMessage
 	if err := jsoniter.Unmarshal(data, &mp); err != nil {
@@ -364,6 +364,14 @@ func (x *SearchIndexRequest) UnmarshalJSON(data []byte) error {
 			// delaying the vector deserialization
 			x.Vector = value
 			continue
+		case "highlight":
+			// delaying the highlight deserialization
+			x.Highlight = value
+			continue
+		case "settings":
+			// delaying the settings deserialization
+			x.Settings = value
+			continue
 		default:
 			continue
 		}
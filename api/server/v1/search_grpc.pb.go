@@ -0,0 +1,959 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.9
+// source: server/v1/search.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Search_CreateOrUpdateIndex_FullMethodName = "/tigrisdata.search.v1.Search/CreateOrUpdateIndex"
+	Search_GetIndex_FullMethodName            = "/tigrisdata.search.v1.Search/GetIndex"
+	Search_DeleteIndex_FullMethodName         = "/tigrisdata.search.v1.Search/DeleteIndex"
+	Search_ListIndexes_FullMethodName         = "/tigrisdata.search.v1.Search/ListIndexes"
+	Search_UpsertSynonym_FullMethodName       = "/tigrisdata.search.v1.Search/UpsertSynonym"
+	Search_DeleteSynonym_FullMethodName       = "/tigrisdata.search.v1.Search/DeleteSynonym"
+	Search_ListSynonyms_FullMethodName        = "/tigrisdata.search.v1.Search/ListSynonyms"
+	Search_CreateOrUpdateAlias_FullMethodName = "/tigrisdata.search.v1.Search/CreateOrUpdateAlias"
+	Search_DeleteAlias_FullMethodName         = "/tigrisdata.search.v1.Search/DeleteAlias"
+	Search_ListAliases_FullMethodName         = "/tigrisdata.search.v1.Search/ListAliases"
+	Search_Reindex_FullMethodName             = "/tigrisdata.search.v1.Search/Reindex"
+	Search_GetReindexJob_FullMethodName       = "/tigrisdata.search.v1.Search/GetReindexJob"
+	Search_Get_FullMethodName                 = "/tigrisdata.search.v1.Search/Get"
+	Search_CreateById_FullMethodName          = "/tigrisdata.search.v1.Search/CreateById"
+	Search_Create_FullMethodName              = "/tigrisdata.search.v1.Search/Create"
+	Search_CreateOrReplace_FullMethodName     = "/tigrisdata.search.v1.Search/CreateOrReplace"
+	Search_Update_FullMethodName              = "/tigrisdata.search.v1.Search/Update"
+	Search_Delete_FullMethodName              = "/tigrisdata.search.v1.Search/Delete"
+	Search_DeleteByQuery_FullMethodName       = "/tigrisdata.search.v1.Search/DeleteByQuery"
+	Search_Search_FullMethodName              = "/tigrisdata.search.v1.Search/Search"
+	Search_MultiSearch_FullMethodName         = "/tigrisdata.search.v1.Search/MultiSearch"
+)
+
+// SearchClient is the client API for Search service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SearchClient interface {
+	CreateOrUpdateIndex(ctx context.Context, in *CreateOrUpdateIndexRequest, opts ...grpc.CallOption) (*CreateOrUpdateIndexResponse, error)
+	GetIndex(ctx context.Context, in *GetIndexRequest, opts ...grpc.CallOption) (*GetIndexResponse, error)
+	DeleteIndex(ctx context.Context, in *DeleteIndexRequest, opts ...grpc.CallOption) (*DeleteIndexResponse, error)
+	ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error)
+	// Creates or replaces the synonym set of the index.
+	UpsertSynonym(ctx context.Context, in *UpsertSynonymRequest, opts ...grpc.CallOption) (*UpsertSynonymResponse, error)
+	DeleteSynonym(ctx context.Context, in *DeleteSynonymRequest, opts ...grpc.CallOption) (*DeleteSynonymResponse, error)
+	ListSynonyms(ctx context.Context, in *ListSynonymsRequest, opts ...grpc.CallOption) (*ListSynonymsResponse, error)
+	// Creates the alias of the index or points the existing alias to the index.
+	CreateOrUpdateAlias(ctx context.Context, in *CreateOrUpdateAliasRequest, opts ...grpc.CallOption) (*CreateOrUpdateAliasResponse, error)
+	DeleteAlias(ctx context.Context, in *DeleteAliasRequest, opts ...grpc.CallOption) (*DeleteAliasResponse, error)
+	ListAliases(ctx context.Context, in *ListAliasesRequest, opts ...grpc.CallOption) (*ListAliasesResponse, error)
+	// Builds the index from its source and then points the alias to it. The alias keeps serving the
+	// previous index while the build is in progress.
+	Reindex(ctx context.Context, in *ReindexRequest, opts ...grpc.CallOption) (*ReindexResponse, error)
+	// Returns the state of the reindex job.
+	GetReindexJob(ctx context.Context, in *GetReindexJobRequest, opts ...grpc.CallOption) (*GetReindexJobResponse, error)
+	// Retrieves one or more documents by id. The response is an array of documents in the same order it is requests.
+	// A null is returned for the documents that are not found.
+	Get(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error)
+	// CreateById is used for indexing a single document. The API expects a single document. An "id" is optional
+	// and the server can automatically generate it for you in case it is missing. In cases an id is provided in
+	// the document and the document already exists then that document will not be indexed and an error is returned
+	// with HTTP status code 409.
+	CreateById(ctx context.Context, in *CreateByIdRequest, opts ...grpc.CallOption) (*CreateByIdResponse, error)
+	// Create is used for indexing a single or multiple documents. The API expects an array of documents.
+	// Each document is a JSON object. An "id" is optional and the server can automatically generate it for you in
+	// case it is missing. In cases when an id is provided in the document and the document already exists then that
+	// document will not be indexed and in the response there will be an error corresponding to that document id other
+	// documents will succeed. Returns an array of status indicating the status of each document.
+	Create(ctx context.Context, in *CreateDocumentRequest, opts ...grpc.CallOption) (*CreateDocumentResponse, error)
+	// Creates or replaces one or more documents. Each document is a JSON object. A document is replaced
+	// if it already exists. An "id" is generated automatically in case it is missing in the document. The
+	// document is created if "id" doesn't exists otherwise it is replaced. Returns an array of status indicating
+	// the status of each document.
+	CreateOrReplace(ctx context.Context, in *CreateOrReplaceDocumentRequest, opts ...grpc.CallOption) (*CreateOrReplaceDocumentResponse, error)
+	// Updates one or more documents by "id". Each document is required to have the
+	// "id" field in it. Returns an array of status indicating the status of each document. Each status
+	// has an error field that is set to null in case document is updated successfully otherwise the error
+	// field is set with a code and message.
+	Update(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*UpdateDocumentResponse, error)
+	// Delete one or more documents by id. Returns an array of status indicating the status of each document. Each status
+	// has an error field that is set to null in case document is deleted successfully otherwise it will non null with
+	// an error code and message.
+	Delete(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
+	// DeleteByQuery is used to delete documents that match the filter. A filter is required. To delete document by id,
+	// you can pass the filter as follows ```{"id": "test"}```. Returns a count of number of documents deleted.
+	DeleteByQuery(ctx context.Context, in *DeleteByQueryRequest, opts ...grpc.CallOption) (*DeleteByQueryResponse, error)
+	// Searches an index for the documents matching the query. A search can be a term search or a phrase search.
+	// Search API allows filtering the result set using filters as documented
+	// <a href="https://docs.tigrisdata.com/overview/query#specification-1" title="here">here</a>. You can also perform
+	// a faceted search by passing the fields in the facet parameter. You can find more detailed documentation of the
+	// Search API with multiple examples <a href="https://docs.tigrisdata.com/overview/search" title="here">here</a>.
+	Search(ctx context.Context, in *SearchIndexRequest, opts ...grpc.CallOption) (Search_SearchClient, error)
+	// Runs several searches over the indexes of the project, the results can be merged into a single ranked list.
+	MultiSearch(ctx context.Context, in *MultiSearchRequest, opts ...grpc.CallOption) (*MultiSearchResponse, error)
+}
+
+type searchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchClient(cc grpc.ClientConnInterface) SearchClient {
+	return &searchClient{cc}
+}
+
+func (c *searchClient) CreateOrUpdateIndex(ctx context.Context, in *CreateOrUpdateIndexRequest, opts ...grpc.CallOption) (*CreateOrUpdateIndexResponse, error) {
+	out := new(CreateOrUpdateIndexResponse)
+	err := c.cc.Invoke(ctx, Search_CreateOrUpdateIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) GetIndex(ctx context.Context, in *GetIndexRequest, opts ...grpc.CallOption) (*GetIndexResponse, error) {
+	out := new(GetIndexResponse)
+	err := c.cc.Invoke(ctx, Search_GetIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) DeleteIndex(ctx context.Context, in *DeleteIndexRequest, opts ...grpc.CallOption) (*DeleteIndexResponse, error) {
+	out := new(DeleteIndexResponse)
+	err := c.cc.Invoke(ctx, Search_DeleteIndex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) ListIndexes(ctx context.Context, in *ListIndexesRequest, opts ...grpc.CallOption) (*ListIndexesResponse, error) {
+	out := new(ListIndexesResponse)
+	err := c.cc.Invoke(ctx, Search_ListIndexes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) UpsertSynonym(ctx context.Context, in *UpsertSynonymRequest, opts ...grpc.CallOption) (*UpsertSynonymResponse, error) {
+	out := new(UpsertSynonymResponse)
+	err := c.cc.Invoke(ctx, Search_UpsertSynonym_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) DeleteSynonym(ctx context.Context, in *DeleteSynonymRequest, opts ...grpc.CallOption) (*DeleteSynonymResponse, error) {
+	out := new(DeleteSynonymResponse)
+	err := c.cc.Invoke(ctx, Search_DeleteSynonym_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) ListSynonyms(ctx context.Context, in *ListSynonymsRequest, opts ...grpc.CallOption) (*ListSynonymsResponse, error) {
+	out := new(ListSynonymsResponse)
+	err := c.cc.Invoke(ctx, Search_ListSynonyms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) CreateOrUpdateAlias(ctx context.Context, in *CreateOrUpdateAliasRequest, opts ...grpc.CallOption) (*CreateOrUpdateAliasResponse, error) {
+	out := new(CreateOrUpdateAliasResponse)
+	err := c.cc.Invoke(ctx, Search_CreateOrUpdateAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) DeleteAlias(ctx context.Context, in *DeleteAliasRequest, opts ...grpc.CallOption) (*DeleteAliasResponse, error) {
+	out := new(DeleteAliasResponse)
+	err := c.cc.Invoke(ctx, Search_DeleteAlias_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) ListAliases(ctx context.Context, in *ListAliasesRequest, opts ...grpc.CallOption) (*ListAliasesResponse, error) {
+	out := new(ListAliasesResponse)
+	err := c.cc.Invoke(ctx, Search_ListAliases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) Reindex(ctx context.Context, in *ReindexRequest, opts ...grpc.CallOption) (*ReindexResponse, error) {
+	out := new(ReindexResponse)
+	err := c.cc.Invoke(ctx, Search_Reindex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) GetReindexJob(ctx context.Context, in *GetReindexJobRequest, opts ...grpc.CallOption) (*GetReindexJobResponse, error) {
+	out := new(GetReindexJobResponse)
+	err := c.cc.Invoke(ctx, Search_GetReindexJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) Get(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error) {
+	out := new(GetDocumentResponse)
+	err := c.cc.Invoke(ctx, Search_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) CreateById(ctx context.Context, in *CreateByIdRequest, opts ...grpc.CallOption) (*CreateByIdResponse, error) {
+	out := new(CreateByIdResponse)
+	err := c.cc.Invoke(ctx, Search_CreateById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) Create(ctx context.Context, in *CreateDocumentRequest, opts ...grpc.CallOption) (*CreateDocumentResponse, error) {
+	out := new(CreateDocumentResponse)
+	err := c.cc.Invoke(ctx, Search_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) CreateOrReplace(ctx context.Context, in *CreateOrReplaceDocumentRequest, opts ...grpc.CallOption) (*CreateOrReplaceDocumentResponse, error) {
+	out := new(CreateOrReplaceDocumentResponse)
+	err := c.cc.Invoke(ctx, Search_CreateOrReplace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) Update(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*UpdateDocumentResponse, error) {
+	out := new(UpdateDocumentResponse)
+	err := c.cc.Invoke(ctx, Search_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) Delete(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error) {
+	out := new(DeleteDocumentResponse)
+	err := c.cc.Invoke(ctx, Search_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) DeleteByQuery(ctx context.Context, in *DeleteByQueryRequest, opts ...grpc.CallOption) (*DeleteByQueryResponse, error) {
+	out := new(DeleteByQueryResponse)
+	err := c.cc.Invoke(ctx, Search_DeleteByQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) Search(ctx context.Context, in *SearchIndexRequest, opts ...grpc.CallOption) (Search_SearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Search_ServiceDesc.Streams[0], Search_Search_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &searchSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Search_SearchClient interface {
+	Recv() (*SearchIndexResponse, error)
+	grpc.ClientStream
+}
+
+type searchSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *searchSearchClient) Recv() (*SearchIndexResponse, error) {
+	m := new(SearchIndexResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *searchClient) MultiSearch(ctx context.Context, in *MultiSearchRequest, opts ...grpc.CallOption) (*MultiSearchResponse, error) {
+	out := new(MultiSearchResponse)
+	err := c.cc.Invoke(ctx, Search_MultiSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServer is the server API for Search service.
+// All implementations should embed UnimplementedSearchServer
+// for forward compatibility
+type SearchServer interface {
+	CreateOrUpdateIndex(context.Context, *CreateOrUpdateIndexRequest) (*CreateOrUpdateIndexResponse, error)
+	GetIndex(context.Context, *GetIndexRequest) (*GetIndexResponse, error)
+	DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error)
+	ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error)
+	// Creates or replaces the synonym set of the index.
+	UpsertSynonym(context.Context, *UpsertSynonymRequest) (*UpsertSynonymResponse, error)
+	DeleteSynonym(context.Context, *DeleteSynonymRequest) (*DeleteSynonymResponse, error)
+	ListSynonyms(context.Context, *ListSynonymsRequest) (*ListSynonymsResponse, error)
+	// Creates the alias of the index or points the existing alias to the index.
+	CreateOrUpdateAlias(context.Context, *CreateOrUpdateAliasRequest) (*CreateOrUpdateAliasResponse, error)
+	DeleteAlias(context.Context, *DeleteAliasRequest) (*DeleteAliasResponse, error)
+	ListAliases(context.Context, *ListAliasesRequest) (*ListAliasesResponse, error)
+	// Builds the index from its source and then points the alias to it. The alias keeps serving the
+	// previous index while the build is in progress.
+	Reindex(context.Context, *ReindexRequest) (*ReindexResponse, error)
+	// Returns the state of the reindex job.
+	GetReindexJob(context.Context, *GetReindexJobRequest) (*GetReindexJobResponse, error)
+	// Retrieves one or more documents by id. The response is an array of documents in the same order it is requests.
+	// A null is returned for the documents that are not found.
+	Get(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
+	// CreateById is used for indexing a single document. The API expects a single document. An "id" is optional
+	// and the server can automatically generate it for you in case it is missing. In cases an id is provided in
+	// the document and the document already exists then that document will not be indexed and an error is returned
+	// with HTTP status code 409.
+	CreateById(context.Context, *CreateByIdRequest) (*CreateByIdResponse, error)
+	// Create is used for indexing a single or multiple documents. The API expects an array of documents.
+	// Each document is a JSON object. An "id" is optional and the server can automatically generate it for you in
+	// case it is missing. In cases when an id is provided in the document and the document already exists then that
+	// document will not be indexed and in the response there will be an error corresponding to that document id other
+	// documents will succeed. Returns an array of status indicating the status of each document.
+	Create(context.Context, *CreateDocumentRequest) (*CreateDocumentResponse, error)
+	// Creates or replaces one or more documents. Each document is a JSON object. A document is replaced
+	// if it already exists. An "id" is generated automatically in case it is missing in the document. The
+	// document is created if "id" doesn't exists otherwise it is replaced. Returns an array of status indicating
+	// the status of each document.
+	CreateOrReplace(context.Context, *CreateOrReplaceDocumentRequest) (*CreateOrReplaceDocumentResponse, error)
+	// Updates one or more documents by "id". Each document is required to have the
+	// "id" field in it. Returns an array of status indicating the status of each document. Each status
+	// has an error field that is set to null in case document is updated successfully otherwise the error
+	// field is set with a code and message.
+	Update(context.Context, *UpdateDocumentRequest) (*UpdateDocumentResponse, error)
+	// Delete one or more documents by id. Returns an array of status indicating the status of each document. Each status
+	// has an error field that is set to null in case document is deleted successfully otherwise it will non null with
+	// an error code and message.
+	Delete(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
+	// DeleteByQuery is used to delete documents that match the filter. A filter is required. To delete document by id,
+	// you can pass the filter as follows ```{"id": "test"}```. Returns a count of number of documents deleted.
+	DeleteByQuery(context.Context, *DeleteByQueryRequest) (*DeleteByQueryResponse, error)
+	// Searches an index for the documents matching the query. A search can be a term search or a phrase search.
+	// Search API allows filtering the result set using filters as documented
+	// <a href="https://docs.tigrisdata.com/overview/query#specification-1" title="here">here</a>. You can also perform
+	// a faceted search by passing the fields in the facet parameter. You can find more detailed documentation of the
+	// Search API with multiple examples <a href="https://docs.tigrisdata.com/overview/search" title="here">here</a>.
+	Search(*SearchIndexRequest, Search_SearchServer) error
+	// Runs several searches over the indexes of the project, the results can be merged into a single ranked list.
+	MultiSearch(context.Context, *MultiSearchRequest) (*MultiSearchResponse, error)
+}
+
+// UnimplementedSearchServer should be embedded to have forward compatible implementations.
+type UnimplementedSearchServer struct {
+}
+
+func (UnimplementedSearchServer) CreateOrUpdateIndex(context.Context, *CreateOrUpdateIndexRequest) (*CreateOrUpdateIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateIndex not implemented")
+}
+func (UnimplementedSearchServer) GetIndex(context.Context, *GetIndexRequest) (*GetIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndex not implemented")
+}
+func (UnimplementedSearchServer) DeleteIndex(context.Context, *DeleteIndexRequest) (*DeleteIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIndex not implemented")
+}
+func (UnimplementedSearchServer) ListIndexes(context.Context, *ListIndexesRequest) (*ListIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIndexes not implemented")
+}
+func (UnimplementedSearchServer) UpsertSynonym(context.Context, *UpsertSynonymRequest) (*UpsertSynonymResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertSynonym not implemented")
+}
+func (UnimplementedSearchServer) DeleteSynonym(context.Context, *DeleteSynonymRequest) (*DeleteSynonymResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSynonym not implemented")
+}
+func (UnimplementedSearchServer) ListSynonyms(context.Context, *ListSynonymsRequest) (*ListSynonymsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSynonyms not implemented")
+}
+func (UnimplementedSearchServer) CreateOrUpdateAlias(context.Context, *CreateOrUpdateAliasRequest) (*CreateOrUpdateAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrUpdateAlias not implemented")
+}
+func (UnimplementedSearchServer) DeleteAlias(context.Context, *DeleteAliasRequest) (*DeleteAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlias not implemented")
+}
+func (UnimplementedSearchServer) ListAliases(context.Context, *ListAliasesRequest) (*ListAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAliases not implemented")
+}
+func (UnimplementedSearchServer) Reindex(context.Context, *ReindexRequest) (*ReindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reindex not implemented")
+}
+func (UnimplementedSearchServer) GetReindexJob(context.Context, *GetReindexJobRequest) (*GetReindexJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReindexJob not implemented")
+}
+func (UnimplementedSearchServer) Get(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedSearchServer) CreateById(context.Context, *CreateByIdRequest) (*CreateByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateById not implemented")
+}
+func (UnimplementedSearchServer) Create(context.Context, *CreateDocumentRequest) (*CreateDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedSearchServer) CreateOrReplace(context.Context, *CreateOrReplaceDocumentRequest) (*CreateOrReplaceDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrReplace not implemented")
+}
+func (UnimplementedSearchServer) Update(context.Context, *UpdateDocumentRequest) (*UpdateDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedSearchServer) Delete(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedSearchServer) DeleteByQuery(context.Context, *DeleteByQueryRequest) (*DeleteByQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteByQuery not implemented")
+}
+func (UnimplementedSearchServer) Search(*SearchIndexRequest, Search_SearchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSearchServer) MultiSearch(context.Context, *MultiSearchRequest) (*MultiSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSearch not implemented")
+}
+
+// UnsafeSearchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchServer will
+// result in compilation errors.
+type UnsafeSearchServer interface {
+	mustEmbedUnimplementedSearchServer()
+}
+
+func RegisterSearchServer(s grpc.ServiceRegistrar, srv SearchServer) {
+	s.RegisterService(&Search_ServiceDesc, srv)
+}
+
+func _Search_CreateOrUpdateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrUpdateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).CreateOrUpdateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_CreateOrUpdateIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).CreateOrUpdateIndex(ctx, req.(*CreateOrUpdateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_GetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).GetIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_GetIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).GetIndex(ctx, req.(*GetIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_DeleteIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).DeleteIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_DeleteIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).DeleteIndex(ctx, req.(*DeleteIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_ListIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).ListIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_ListIndexes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).ListIndexes(ctx, req.(*ListIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_UpsertSynonym_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertSynonymRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).UpsertSynonym(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_UpsertSynonym_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).UpsertSynonym(ctx, req.(*UpsertSynonymRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_DeleteSynonym_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSynonymRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).DeleteSynonym(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_DeleteSynonym_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).DeleteSynonym(ctx, req.(*DeleteSynonymRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_ListSynonyms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSynonymsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).ListSynonyms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_ListSynonyms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).ListSynonyms(ctx, req.(*ListSynonymsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_CreateOrUpdateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrUpdateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).CreateOrUpdateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_CreateOrUpdateAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).CreateOrUpdateAlias(ctx, req.(*CreateOrUpdateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_DeleteAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).DeleteAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_DeleteAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).DeleteAlias(ctx, req.(*DeleteAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_ListAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).ListAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_ListAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).ListAliases(ctx, req.(*ListAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_Reindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Reindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_Reindex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Reindex(ctx, req.(*ReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_GetReindexJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReindexJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).GetReindexJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_GetReindexJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).GetReindexJob(ctx, req.(*GetReindexJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Get(ctx, req.(*GetDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_CreateById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).CreateById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_CreateById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).CreateById(ctx, req.(*CreateByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Create(ctx, req.(*CreateDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_CreateOrReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrReplaceDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).CreateOrReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_CreateOrReplace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).CreateOrReplace(ctx, req.(*CreateOrReplaceDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Update(ctx, req.(*UpdateDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Delete(ctx, req.(*DeleteDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_DeleteByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteByQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).DeleteByQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_DeleteByQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).DeleteByQuery(ctx, req.(*DeleteByQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_Search_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchIndexRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SearchServer).Search(m, &searchSearchServer{stream})
+}
+
+type Search_SearchServer interface {
+	Send(*SearchIndexResponse) error
+	grpc.ServerStream
+}
+
+type searchSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *searchSearchServer) Send(m *SearchIndexResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Search_MultiSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).MultiSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Search_MultiSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).MultiSearch(ctx, req.(*MultiSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Search_ServiceDesc is the grpc.ServiceDesc for Search service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Search_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tigrisdata.search.v1.Search",
+	HandlerType: (*SearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrUpdateIndex",
+			Handler:    _Search_CreateOrUpdateIndex_Handler,
+		},
+		{
+			MethodName: "GetIndex",
+			Handler:    _Search_GetIndex_Handler,
+		},
+		{
+			MethodName: "DeleteIndex",
+			Handler:    _Search_DeleteIndex_Handler,
+		},
+		{
+			MethodName: "ListIndexes",
+			Handler:    _Search_ListIndexes_Handler,
+		},
+		{
+			MethodName: "UpsertSynonym",
+			Handler:    _Search_UpsertSynonym_Handler,
+		},
+		{
+			MethodName: "DeleteSynonym",
+			Handler:    _Search_DeleteSynonym_Handler,
+		},
+		{
+			MethodName: "ListSynonyms",
+			Handler:    _Search_ListSynonyms_Handler,
+		},
+		{
+			MethodName: "CreateOrUpdateAlias",
+			Handler:    _Search_CreateOrUpdateAlias_Handler,
+		},
+		{
+			MethodName: "DeleteAlias",
+			Handler:    _Search_DeleteAlias_Handler,
+		},
+		{
+			MethodName: "ListAliases",
+			Handler:    _Search_ListAliases_Handler,
+		},
+		{
+			MethodName: "Reindex",
+			Handler:    _Search_Reindex_Handler,
+		},
+		{
+			MethodName: "GetReindexJob",
+			Handler:    _Search_GetReindexJob_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Search_Get_Handler,
+		},
+		{
+			MethodName: "CreateById",
+			Handler:    _Search_CreateById_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Search_Create_Handler,
+		},
+		{
+			MethodName: "CreateOrReplace",
+			Handler:    _Search_CreateOrReplace_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Search_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Search_Delete_Handler,
+		},
+		{
+			MethodName: "DeleteByQuery",
+			Handler:    _Search_DeleteByQuery_Handler,
+		},
+		{
+			MethodName: "MultiSearch",
+			Handler:    _Search_MultiSearch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Search",
+			Handler:       _Search_Search_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "server/v1/search.proto",
+}
@@ -46,6 +46,11 @@ const (
 	SearchMethodName = apiMethodPrefix + "Search"
 	ImportMethodName = apiMethodPrefix + "Import"
 
+	ImportStreamMethodName = apiMethodPrefix + "ImportStream"
+	GetImportJobMethodName = apiMethodPrefix + "GetImportJob"
+	ExportMethodName       = apiMethodPrefix + "Export"
+	GetExportJobMethodName = apiMethodPrefix + "GetExportJob"
+
 	IndexCollection                 = apiMethodPrefix + "IndexCollection"
 	SearchIndexCollectionMethodName = apiMethodPrefix + "BuildSearchIndex"
 
@@ -90,14 +95,31 @@ const (
 	ListInvoicesMethodName = billingMethodPrefix + "ListInvoices"
 
 	// Cache.
-	CreateCacheMethodName = cacheMethodPrefix + "CreateCache"
-	ListCachesMethodName  = cacheMethodPrefix + "ListCaches"
-	DeleteCacheMethodName = cacheMethodPrefix + "DeleteCache"
-	SetMethodName         = cacheMethodPrefix + "Set"
-	GetSetMethodName      = cacheMethodPrefix + "GetSet"
-	GetMethodName         = cacheMethodPrefix + "Get"
-	DelMethodName         = cacheMethodPrefix + "Del"
-	KeysMethodName        = cacheMethodPrefix + "Keys"
+	CreateCacheMethodName   = cacheMethodPrefix + "CreateCache"
+	ListCachesMethodName    = cacheMethodPrefix + "ListCaches"
+	DeleteCacheMethodName   = cacheMethodPrefix + "DeleteCache"
+	DescribeCacheMethodName = cacheMethodPrefix + "DescribeCache"
+	SetMethodName           = cacheMethodPrefix + "Set"
+	GetSetMethodName        = cacheMethodPrefix + "GetSet"
+	GetMethodName           = cacheMethodPrefix + "Get"
+	DelMethodName           = cacheMethodPrefix + "Del"
+	KeysMethodName          = cacheMethodPrefix + "Keys"
+	MGetMethodName          = cacheMethodPrefix + "MGet"
+	MSetMethodName          = cacheMethodPrefix + "MSet"
+	IncrMethodName          = cacheMethodPrefix + "Incr"
+	DecrMethodName          = cacheMethodPrefix + "Decr"
+	CompareAndSetMethodName = cacheMethodPrefix + "CompareAndSet"
+	HSetMethodName          = cacheMethodPrefix + "HSet"
+	HGetMethodName          = cacheMethodPrefix + "HGet"
+	HGetAllMethodName       = cacheMethodPrefix + "HGetAll"
+	HDelMethodName          = cacheMethodPrefix + "HDel"
+	LPushMethodName         = cacheMethodPrefix + "LPush"
+	RPushMethodName         = cacheMethodPrefix + "RPush"
+	LPopMethodName          = cacheMethodPrefix + "LPop"
+	RPopMethodName          = cacheMethodPrefix + "RPop"
+	LRangeMethodName        = cacheMethodPrefix + "LRange"
+	ZAddMethodName          = cacheMethodPrefix + "ZAdd"
+	ZRangeMethodName        = cacheMethodPrefix + "ZRange"
 
 	// Health.
 	HealthMethodName = "/HealthAPI/Health"
@@ -112,6 +134,8 @@ const (
 	InsertNamespaceMetadataMethodName = ManagementMethodPrefix + "InsertNamespaceMetadata"
 	GetNamespaceMetadataMethodName    = ManagementMethodPrefix + "GetNamespaceMetadata"
 	UpdateNamespaceMetadataMethodName = ManagementMethodPrefix + "UpdateNamespaceMetadata"
+	ListTransactionsMethodName        = ManagementMethodPrefix + "ListTransactions"
+	KillTransactionMethodName         = ManagementMethodPrefix + "KillTransaction"
 
 	// Observability.
 	QueryTimeSeriesMetricsMethodName = ObservabilityMethodPrefix + "QueryTimeSeriesMetrics"
@@ -124,6 +148,10 @@ const (
 	ReadMessagesMethodName      = realtimeMethodPrefix + "ReadMessages"
 	MessagesMethodName          = realtimeMethodPrefix + "Messages"
 	ListSubscriptionsMethodName = realtimeMethodPrefix + "ListSubscriptions"
+	GetPresenceMethodName       = realtimeMethodPrefix + "GetPresence"
+	UpdateRTChannelMethodName   = realtimeMethodPrefix + "UpdateRTChannel"
+	HistoryMethodName           = realtimeMethodPrefix + "History"
+	CreateRTTokenMethodName     = realtimeMethodPrefix + "CreateRTToken"
 )
 
 func IsTxSupported(ctx context.Context) bool {
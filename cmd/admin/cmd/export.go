@@ -0,0 +1,171 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/blob"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/defaults"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/services/v1/database"
+	"github.com/tigrisdata/tigris/util"
+)
+
+var (
+	exportNamespace  string
+	exportProject    string
+	exportBranch     string
+	exportCollection string
+	exportFormat     string
+	exportOutput     string
+	exportFilter     string
+	exportFields     string
+	exportJobId      string
+	exportSpec       database.ExportSpec
+	exportS3         blob.Config
+	exportS3KeyFile  string
+)
+
+// exportS3SecretEnv is the environment variable of the S3 secret access key. The secret is not accepted as a flag, so
+// it doesn't leak to the shell history and the process list.
+const exportS3SecretEnv = "TIGRIS_EXPORT_S3_SECRET_ACCESS_KEY"
+
+// exportS3Secret reads the S3 secret access key from the file of the flag or from the environment.
+func exportS3Secret() string {
+	if exportS3KeyFile != "" {
+		b, err := os.ReadFile(exportS3KeyFile)
+		util.Fatal(err, "read S3 secret access key file")
+
+		return strings.TrimSpace(string(b))
+	}
+
+	return os.Getenv(exportS3SecretEnv)
+}
+
+// exportConfig overrides the export config of the server with the command line flags.
+func exportConfig(cmd *cobra.Command) *config.ExportConfig {
+	cfg := config.DefaultConfig.Export
+
+	if exportS3.Endpoint != "" {
+		cfg.S3.Endpoint = exportS3.Endpoint
+	}
+	if exportS3.Region != "" {
+		cfg.S3.Region = exportS3.Region
+	}
+	if exportS3.AccessKeyID != "" {
+		cfg.S3.AccessKeyID = exportS3.AccessKeyID
+		cfg.S3.SecretAccessKey = exportS3Secret()
+	}
+	if cmd.Flags().Changed("s3-use-ssl") {
+		cfg.S3.UseSSL = exportS3.UseSSL
+	}
+
+	return &cfg
+}
+
+func exportData(cfg *config.ExportConfig) {
+	ctx := context.TODO()
+
+	tenant, err := Mgr.Tenant.GetTenant(ctx, exportNamespace)
+	util.Fatal(err, "get tenant")
+
+	var (
+		job    *metadata.JobMetadata
+		create = exportJobId == ""
+	)
+
+	if create {
+		exportSpec.Destination = exportOutput
+		if exportFilter != "" {
+			exportSpec.Filter = []byte(exportFilter)
+		}
+		if exportFields != "" {
+			exportSpec.Fields = []byte(exportFields)
+		}
+
+		job, err = database.NewExportJob(exportProject, exportBranch, exportCollection, exportFormat, &exportSpec)
+		util.Fatal(err, "export job")
+	} else {
+		tx, err := Mgr.Tx.StartTx(ctx)
+		util.Fatal(err, "starting tx")
+
+		job, err = Mgr.MetaStore.Job().Get(ctx, tx, tenant.GetNamespace().Id(), exportJobId)
+		_ = tx.Rollback(ctx)
+		if err == errors.ErrNotFound || (job != nil && job.Type != metadata.ExportJobType) {
+			util.Fatal(fmt.Errorf("export job '%s' not found", exportJobId), "resume")
+		}
+		util.Fatal(err, "read job")
+
+		if job.State != metadata.JobFailed {
+			// running jobs are resumed by the export worker
+			util.Fatal(fmt.Errorf("job is %s, only failed jobs can be resumed", job.State), "resume")
+		}
+		// every batch is read at its own version, the failed job continues after the last written file
+		job.State = metadata.JobRunning
+		job.Message = ""
+	}
+
+	job, err = database.NewExportWorker(Mgr.Tx, Mgr.Tenant, cfg).RunJob(ctx, tenant, job, create)
+	if job != nil {
+		b, merr := json.MarshalIndent(job, "", "\t")
+		util.Fatal(merr, "marshal job")
+
+		_, _ = fmt.Fprintf(os.Stdout, "%v\n", string(b))
+	}
+	util.Fatal(err, "export")
+}
+
+var exportDataCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export collection documents to NDJSON, CSV or Parquet files",
+	Long: `Export collection documents to NDJSON, CSV or Parquet files on the local disk or in an S3 compatible storage.
+The output is split into files of --part-rows documents, the progress is saved after every file.
+An interrupted export is resumed by the export worker of the server, a failed export is resumed
+from the last written file with --job-id.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		exportData(exportConfig(cmd))
+	},
+}
+
+func init() {
+	exportDataCmd.Flags().StringVar(&exportNamespace, "namespace", defaults.DefaultNamespaceName, "namespace name")
+	exportDataCmd.Flags().StringVar(&exportProject, "project", "", "project name")
+	exportDataCmd.Flags().StringVar(&exportBranch, "branch", "", "database branch")
+	exportDataCmd.Flags().StringVar(&exportCollection, "collection", "", "collection name")
+	exportDataCmd.Flags().StringVar(&exportFormat, "format", "ndjson", "output format: ndjson, csv or parquet")
+	exportDataCmd.Flags().StringVar(&exportOutput, "output", "", "local directory or s3://<bucket>/<prefix>")
+	exportDataCmd.Flags().StringVar(&exportFilter, "filter", "", "filter of the exported documents")
+	exportDataCmd.Flags().StringVar(&exportFields, "fields", "", "projection of the exported documents")
+	exportDataCmd.Flags().StringVar(&exportSpec.CSVDelimiter, "csv-delimiter", "", "CSV field delimiter")
+	exportDataCmd.Flags().Int64Var(&exportSpec.PartRows, "part-rows", 0, "maximum number of documents per file")
+	exportDataCmd.Flags().StringVar(&exportJobId, "job-id", "", "resume the failed export job")
+
+	exportDataCmd.Flags().StringVar(&exportS3.Endpoint, "s3-endpoint", "", "S3 endpoint, overrides the server config")
+	exportDataCmd.Flags().StringVar(&exportS3.Region, "s3-region", "", "S3 region")
+	exportDataCmd.Flags().StringVar(&exportS3.AccessKeyID, "s3-access-key-id", "", "S3 access key id")
+	exportDataCmd.Flags().StringVar(&exportS3KeyFile, "s3-secret-access-key-file", "",
+		"file with the S3 secret access key, read from "+exportS3SecretEnv+" if not set")
+	exportDataCmd.Flags().BoolVar(&exportS3.UseSSL, "s3-use-ssl", false, "use TLS to connect to the S3 endpoint")
+
+	dataCmd.AddCommand(exportDataCmd)
+}
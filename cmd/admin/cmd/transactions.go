@@ -0,0 +1,110 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tigrisdata/tigris/util"
+)
+
+var (
+	txServerURL string
+	txToken     string
+	txNamespace string
+)
+
+// callManagement invokes the management API of the server. Open transactions only live in
+// the memory of the server which started them, so unlike the metadata commands these
+// don't go to the FoundationDB directly.
+func callManagement(method string, req any) {
+	body, err := json.Marshal(req)
+	util.Fatal(err, "marshal request")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	url := strings.TrimSuffix(txServerURL, "/") + "/v1/management/transactions/" + method
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	util.Fatal(err, "create request")
+
+	hreq.Header.Set("Content-Type", "application/json")
+	if txToken != "" {
+		hreq.Header.Set("Authorization", "Bearer "+txToken)
+	}
+
+	resp, err := http.DefaultClient.Do(hreq)
+	util.Fatal(err, "call "+url)
+	defer func() { _ = resp.Body.Close() }()
+
+	b, err := io.ReadAll(resp.Body)
+	util.Fatal(err, "read response")
+
+	if resp.StatusCode != http.StatusOK {
+		util.Fatal(fmt.Errorf("%s: %s", resp.Status, string(b)), method)
+	}
+
+	var out bytes.Buffer
+	if json.Indent(&out, b, "", "\t") != nil {
+		out.Reset()
+		out.Write(b)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%v\n", out.String())
+}
+
+var transactionsCmd = &cobra.Command{
+	Use:   "transactions",
+	Short: "Inspect and kill the open transactions of a server",
+}
+
+var listTransactionsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the open transactions of the server",
+	Run: func(cmd *cobra.Command, args []string) {
+		callManagement("list", map[string]string{"namespace": txNamespace})
+	},
+}
+
+var killTransactionCmd = &cobra.Command{
+	Use:   "kill <id>",
+	Short: "Roll back the open transaction",
+	Long: `Roll back the open transaction. The next request of the client in this transaction
+fails with the ABORTED error.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		callManagement("kill", map[string]string{"id": args[0]})
+	},
+}
+
+func init() {
+	transactionsCmd.PersistentFlags().StringVar(&txServerURL, "url", "http://localhost:8081", "server HTTP address")
+	transactionsCmd.PersistentFlags().StringVar(&txToken, "token", "", "access token of the cluster admin")
+	listTransactionsCmd.Flags().StringVar(&txNamespace, "namespace", "", "only list the transactions of the namespace")
+
+	transactionsCmd.AddCommand(listTransactionsCmd)
+	transactionsCmd.AddCommand(killTransactionCmd)
+
+	rootCmd.AddCommand(transactionsCmd)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/tigrisdata/tigris/server/metadata"
 	"github.com/tigrisdata/tigris/server/transaction"
 	"github.com/tigrisdata/tigris/store/kv"
+	"github.com/tigrisdata/tigris/store/search"
 )
 
 type Managers struct {
@@ -27,6 +28,7 @@ type Managers struct {
 	KVDB      kv.TxStore
 	KVSearch  kv.TxStore
 	MetaStore *metadata.Dictionary
+	Search    search.Store
 }
 
 var Mgr Managers
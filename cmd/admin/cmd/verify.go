@@ -0,0 +1,86 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tigrisdata/tigris/server/defaults"
+	"github.com/tigrisdata/tigris/server/services/v1/database"
+	"github.com/tigrisdata/tigris/util"
+)
+
+var (
+	verifyNamespace  string
+	verifyProject    string
+	verifyBranch     string
+	verifyCollection string
+	verifyOpts       database.ConsistencyOptions
+)
+
+func verifyData() {
+	ctx := context.TODO()
+
+	tenant, err := Mgr.Tenant.GetTenant(ctx, verifyNamespace)
+	util.Fatal(err, "get tenant")
+
+	job, err := database.NewVerifyJob(verifyProject, verifyBranch, verifyCollection, &verifyOpts)
+	util.Fatal(err, "verify job")
+
+	tx, err := Mgr.Tx.StartTx(ctx)
+	util.Fatal(err, "starting tx")
+
+	err = Mgr.MetaStore.Job().Create(ctx, tx, tenant.GetNamespace().Id(), job)
+	util.Fatal(err, "create job")
+	util.Fatal(tx.Commit(ctx), "commit tx")
+
+	job, err = database.NewConsistencyChecker(Mgr.Tx, Mgr.Search).Verify(ctx, tenant, job)
+	if job != nil {
+		b, merr := json.MarshalIndent(job, "", "\t")
+		util.Fatal(merr, "marshal job")
+
+		_, _ = fmt.Fprintf(os.Stdout, "%v\n", string(b))
+	}
+	util.Fatal(err, "verify")
+}
+
+var verifyDataCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the search index and the secondary indexes of the collection",
+	Long: `Verify the search index and the secondary indexes of the collection against the collection rows.
+Reports the rows missing or stale in the indexes and the index entries without a row, with --repair fixes them.
+The collection is verified in batches while it may be written, so the repair should follow a repeated verification.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		verifyData()
+	},
+}
+
+func init() {
+	verifyDataCmd.Flags().StringVar(&verifyNamespace, "namespace", defaults.DefaultNamespaceName, "namespace name")
+	verifyDataCmd.Flags().StringVar(&verifyProject, "project", "", "project name")
+	verifyDataCmd.Flags().StringVar(&verifyBranch, "branch", "", "database branch")
+	verifyDataCmd.Flags().StringVar(&verifyCollection, "collection", "", "collection name")
+	verifyDataCmd.Flags().BoolVar(&verifyOpts.Search, "search", true, "verify the search index")
+	verifyDataCmd.Flags().BoolVar(&verifyOpts.SecondaryIndex, "secondary-index", true, "verify the secondary indexes")
+	verifyDataCmd.Flags().BoolVar(&verifyOpts.Repair, "repair", false, "repair the inconsistencies")
+	verifyDataCmd.Flags().IntVar(&verifyOpts.BatchSize, "batch-size", 0, "number of rows verified in a single transaction")
+	verifyDataCmd.Flags().IntVar(&verifyOpts.MaxSamples, "max-samples", 0, "maximum number of reported keys")
+
+	dataCmd.AddCommand(verifyDataCmd)
+}
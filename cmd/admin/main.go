@@ -81,6 +81,7 @@ func main() {
 		KVDB:      kvStoreForDatabase,
 		KVSearch:  kvStoreForSearch,
 		MetaStore: metadata.NewMetadataDictionary(metadata.DefaultNameRegistry),
+		Search:    searchStore,
 	}
 
 	cmd.Execute()
@@ -79,3 +79,24 @@ func DecodeCacheData(b []byte) (*CacheData, error) {
 
 	return v, nil
 }
+
+// EncodeCacheElement encodes a field value of a hash, an element of a list or a member of a sorted set. The elements
+// are the user data only, the type of the structure is added as the first byte so that the element of one structure
+// is never decoded as the element of another structure.
+func EncodeCacheElement(dataType byte, data []byte) []byte {
+	enc := make([]byte, 0, len(data)+1)
+	enc = append(enc, dataType)
+	return append(enc, data...)
+}
+
+func DecodeCacheElement(dataType byte, b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return nil, errors.Internal("unable to decode element data is empty")
+	}
+
+	if b[0] != dataType {
+		return nil, errors.Internal("unable to decode '%v'", b[0])
+	}
+
+	return b[1:], nil
+}
@@ -45,6 +45,9 @@ const (
 	CacheDataType
 	StreamDataType
 	TableDataProtoType
+	CacheHashDataType
+	CacheListDataType
+	CacheSortedSetDataType
 )
 
 type UserDataEncType int8
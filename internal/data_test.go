@@ -68,6 +68,16 @@ func TestEncode_Decode(t *testing.T) {
 		require.Equal(t, int32(20), data.Size())
 	})
 
+	t.Run("cache_element", func(t *testing.T) {
+		encoded := EncodeCacheElement(CacheHashDataType, []byte(`{"a": 1}`))
+		data, err := DecodeCacheElement(CacheHashDataType, encoded)
+		require.NoError(t, err)
+		require.Equal(t, []byte(`{"a": 1}`), data)
+
+		_, err = DecodeCacheElement(CacheListDataType, encoded)
+		require.Equal(t, errors.Internal("unable to decode '%v'", CacheHashDataType), err)
+	})
+
 	t.Run("decode_proto", func(t *testing.T) {
 		d := NewTableData([]byte(`{"a": 1, "b": "foo"}`))
 		encoded, err := encodeInternalProto(d)
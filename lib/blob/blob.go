@@ -0,0 +1,210 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blob writes files to the local disk or to an S3 compatible object storage.
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	s3Scheme   = "s3"
+	fileScheme = "file"
+
+	// s3PartSize is the size of the multipart upload parts, it bounds the memory used by an upload.
+	s3PartSize = 16 * 1024 * 1024
+)
+
+// Config contains the S3 endpoint settings. The AWS endpoint is used when Endpoint is empty.
+type Config struct {
+	Endpoint        string `mapstructure:"endpoint" yaml:"endpoint" json:"endpoint"`
+	Region          string `mapstructure:"region" yaml:"region" json:"region"`
+	AccessKeyID     string `mapstructure:"access_key_id" yaml:"access_key_id" json:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key" yaml:"secret_access_key" json:"secret_access_key"`
+	UseSSL          bool   `mapstructure:"use_ssl" yaml:"use_ssl" json:"use_ssl"`
+}
+
+// Object is a file being written. The object becomes visible under its name only after a successful Commit, so a
+// reader never observes a partially written object.
+type Object interface {
+	io.Writer
+	Commit() error
+	Abort()
+}
+
+// Bucket is a location objects are written to.
+type Bucket interface {
+	Create(ctx context.Context, name string) (Object, error)
+	// URL returns the location of the object.
+	URL(name string) string
+}
+
+// Open returns the bucket of the location. The location is either "s3://<bucket>/<prefix>" or a local directory,
+// optionally prefixed by "file://".
+func Open(location string, cfg *Config) (Bucket, error) {
+	if location == "" {
+		return nil, fmt.Errorf("empty destination")
+	}
+
+	u, err := url.Parse(location)
+	if err != nil || u.Scheme == "" || len(u.Scheme) == 1 {
+		// not a URL or a windows drive letter
+		return newLocalBucket(location)
+	}
+
+	switch u.Scheme {
+	case fileScheme:
+		return newLocalBucket(u.Host + u.Path)
+	case s3Scheme:
+		if cfg == nil {
+			cfg = &Config{}
+		}
+		return newS3Bucket(u.Host, strings.Trim(u.Path, "/"), cfg)
+	default:
+		return nil, fmt.Errorf("unsupported destination scheme '%s'", u.Scheme)
+	}
+}
+
+type localBucket struct {
+	dir string
+}
+
+func newLocalBucket(dir string) (*localBucket, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &localBucket{dir: dir}, nil
+}
+
+func (b *localBucket) Create(_ context.Context, name string) (Object, error) {
+	file, err := os.CreateTemp(b.dir, "."+name+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+
+	return &localObject{File: file, name: b.URL(name)}, nil
+}
+
+func (b *localBucket) URL(name string) string {
+	return filepath.Join(b.dir, name)
+}
+
+type localObject struct {
+	*os.File
+	name string
+}
+
+func (o *localObject) Commit() error {
+	if err := o.File.Close(); err != nil {
+		o.Abort()
+		return err
+	}
+
+	return os.Rename(o.File.Name(), o.name)
+}
+
+func (o *localObject) Abort() {
+	_ = o.File.Close()
+	_ = os.Remove(o.File.Name())
+}
+
+type s3Bucket struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func newS3Bucket(bucket string, prefix string, cfg *Config) (*s3Bucket, error) {
+	if bucket == "" {
+		return nil, fmt.Errorf("empty bucket name")
+	}
+
+	endpoint := cfg.Endpoint
+	secure := cfg.UseSSL
+	if endpoint == "" {
+		endpoint, secure = "s3.amazonaws.com", true
+	}
+
+	var creds *credentials.Credentials
+	if cfg.AccessKeyID != "" {
+		creds = credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, "")
+	} else {
+		creds = credentials.NewEnvAWS()
+	}
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: secure,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Bucket{client: client, bucket: bucket, prefix: prefix}, nil
+}
+
+func (b *s3Bucket) key(name string) string {
+	return path.Join(b.prefix, name)
+}
+
+func (b *s3Bucket) Create(ctx context.Context, name string) (Object, error) {
+	pr, pw := io.Pipe()
+	obj := &s3Object{pw: pw, done: make(chan error, 1)}
+
+	// the multipart upload is completed only when the pipe is closed without an error
+	go func() {
+		_, err := b.client.PutObject(ctx, b.bucket, b.key(name), pr, -1, minio.PutObjectOptions{PartSize: s3PartSize})
+		_ = pr.CloseWithError(err)
+		obj.done <- err
+	}()
+
+	return obj, nil
+}
+
+func (b *s3Bucket) URL(name string) string {
+	return s3Scheme + "://" + b.bucket + "/" + b.key(name)
+}
+
+type s3Object struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func (o *s3Object) Write(p []byte) (int, error) {
+	return o.pw.Write(p)
+}
+
+func (o *s3Object) Commit() error {
+	_ = o.pw.Close()
+
+	return <-o.done
+}
+
+func (o *s3Object) Abort() {
+	_ = o.pw.CloseWithError(fmt.Errorf("upload aborted"))
+	<-o.done
+}
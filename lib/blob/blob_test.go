@@ -0,0 +1,76 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	_, err := Open("", nil)
+	require.Error(t, err)
+
+	_, err = Open("gs://bucket/prefix", nil)
+	require.Error(t, err)
+
+	_, err = Open("s3:///prefix", nil)
+	require.Error(t, err)
+
+	b, err := Open("s3://bucket/some/prefix/", &Config{Endpoint: "localhost:9000", AccessKeyID: "a", SecretAccessKey: "b"})
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/some/prefix/part.csv", b.URL("part.csv"))
+
+	dir := t.TempDir()
+	b, err = Open("file://"+filepath.Join(dir, "out"), nil)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "out", "part.csv"), b.URL("part.csv"))
+}
+
+func TestLocalBucket(t *testing.T) {
+	dir := t.TempDir()
+	b, err := Open(dir, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	obj, err := b.Create(ctx, "committed.ndjson")
+	require.NoError(t, err)
+	_, err = obj.Write([]byte("data"))
+	require.NoError(t, err)
+
+	_, err = os.Stat(b.URL("committed.ndjson"))
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, obj.Commit())
+	data, err := os.ReadFile(b.URL("committed.ndjson"))
+	require.NoError(t, err)
+	require.Equal(t, "data", string(data))
+
+	obj, err = b.Create(ctx, "aborted.ndjson")
+	require.NoError(t, err)
+	_, err = obj.Write([]byte("data"))
+	require.NoError(t, err)
+	obj.Abort()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "committed.ndjson", entries[0].Name())
+}
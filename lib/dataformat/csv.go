@@ -0,0 +1,166 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataformat
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+type csvReader struct {
+	r       *csv.Reader
+	header  []string
+	types   []string
+	row     int64
+	columns map[string]string
+}
+
+func newCSVReader(input io.Reader, opts *ReaderOptions) (*csvReader, error) {
+	r := csv.NewReader(input)
+	if opts.CSVDelimiter != 0 {
+		r.Comma = opts.CSVDelimiter
+	}
+	r.ReuseRecord = true
+
+	for name, typ := range opts.CSVColumnTypes {
+		switch typ {
+		case "string", "integer", "number", "boolean", "object", "array":
+		default:
+			return nil, fmt.Errorf("unsupported type '%s' of the column '%s'", typ, name)
+		}
+	}
+
+	return &csvReader{
+		r:       r,
+		columns: opts.CSVColumnTypes,
+	}, nil
+}
+
+func (c *csvReader) readHeader() error {
+	header, err := c.r.Read()
+	if err == io.EOF {
+		return io.EOF
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	c.header = make([]string, len(header))
+	c.types = make([]string, len(header))
+	for i, h := range header {
+		c.header[i] = strings.TrimSpace(h)
+		c.types[i] = c.columns[c.header[i]]
+	}
+
+	return nil
+}
+
+func (c *csvReader) Next() ([]byte, error) {
+	if c.header == nil {
+		if err := c.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	record, err := c.r.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+
+	c.row++
+	if err != nil {
+		var pe *csv.ParseError
+		if !errors.As(err, &pe) {
+			return nil, err
+		}
+
+		return nil, &RowError{Row: c.row, Err: err}
+	}
+
+	doc := make(map[string]any, len(record))
+	for i, value := range record {
+		if len(value) == 0 {
+			// empty cells are treated as missing fields
+			continue
+		}
+
+		v, err := convertCSVValue(c.types[i], value)
+		if err != nil {
+			return nil, &RowError{Row: c.row, Err: fmt.Errorf("column '%s': %w", c.header[i], err)}
+		}
+		doc[c.header[i]] = v
+	}
+
+	js, err := jsoniter.Marshal(doc)
+	if err != nil {
+		return nil, &RowError{Row: c.row, Err: err}
+	}
+
+	return js, nil
+}
+
+func (c *csvReader) Row() int64 {
+	return c.row
+}
+
+func (*csvReader) Close() error {
+	return nil
+}
+
+// csvNumber matches the numbers written the way JSON writes them, so the detected numbers don't lose the leading
+// zeros, the signs or the notation of the cell, for example zip codes and hex ids are kept as strings.
+var csvNumber = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// convertCSVValue converts the cell to the declared type. If the type is not declared then integers, floating point
+// numbers and booleans are detected from the value and the rest is kept as string.
+func convertCSVValue(typ string, value string) (any, error) {
+	switch typ {
+	case "string":
+		return value, nil
+	case "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "object", "array":
+		var v any
+		if err := jsoniter.UnmarshalFromString(value, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+
+	if csvNumber.MatchString(value) {
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i, nil
+		}
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f, nil
+		}
+	}
+	if value == "true" || value == "false" {
+		return value == "true", nil
+	}
+
+	return value, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dataformat converts between Tigris JSON documents and the file formats supported by the bulk import and
+// export APIs.
+package dataformat
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format is a bulk data file format.
+type Format string
+
+const (
+	NDJSON  Format = "ndjson"
+	CSV     Format = "csv"
+	Parquet Format = "parquet"
+)
+
+// ParseFormat returns the Format for its name, NDJSON is the default when name is empty.
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(name)) {
+	case "", NDJSON, "jsonl", "json":
+		return NDJSON, nil
+	case CSV:
+		return CSV, nil
+	case Parquet:
+		return Parquet, nil
+	default:
+		return "", fmt.Errorf("unsupported data format '%s'", name)
+	}
+}
+
+// Extension returns the file extension conventionally used for the format.
+func (f Format) Extension() string {
+	if f == NDJSON {
+		return ".ndjson"
+	}
+
+	return "." + string(f)
+}
+
+// RowError is returned by Reader.Next when a single row can't be decoded. The reader stays usable and the caller may
+// skip the row and continue with the next one.
+type RowError struct {
+	Row int64
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("row %d: %s", e.Row, e.Err.Error())
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Reader iterates over the rows of the input and returns every row as a JSON document.
+type Reader interface {
+	// Next returns the next document, io.EOF is returned once all the rows are consumed.
+	Next() ([]byte, error)
+	// Row returns the number of rows returned by Next so far, including the rows that failed to decode.
+	Row() int64
+	Close() error
+}
+
+// ReaderOptions contains format specific settings of the reader.
+type ReaderOptions struct {
+	// CSVColumnTypes maps CSV header names to JSON schema types ("string", "integer", "number", "boolean", "object",
+	// "array"). The type of the columns which are not present in the map is detected from the value.
+	CSVColumnTypes map[string]string
+	// CSVDelimiter is the field delimiter, comma is used if not set.
+	CSVDelimiter rune
+}
+
+// NewReader returns a Reader decoding input in the given format.
+func NewReader(format Format, input io.Reader, opts *ReaderOptions) (Reader, error) {
+	if opts == nil {
+		opts = &ReaderOptions{}
+	}
+
+	switch format {
+	case NDJSON:
+		return newNDJSONReader(input), nil
+	case CSV:
+		return newCSVReader(input, opts)
+	case Parquet:
+		return newParquetReader(input)
+	default:
+		return nil, fmt.Errorf("unsupported data format '%s'", format)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataformat
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+const maxNDJSONLine = 64 * 1024 * 1024
+
+type ndjsonReader struct {
+	r   *bufio.Reader
+	row int64
+}
+
+func newNDJSONReader(input io.Reader) *ndjsonReader {
+	return &ndjsonReader{r: bufio.NewReaderSize(input, 64*1024)}
+}
+
+func (n *ndjsonReader) readLine() ([]byte, error) {
+	var line []byte
+	for {
+		chunk, isPrefix, err := n.r.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+
+		line = append(line, chunk...)
+		if len(line) > maxNDJSONLine {
+			return nil, errors.New("line exceeds maximum document size")
+		}
+		if !isPrefix {
+			return line, nil
+		}
+	}
+}
+
+func (n *ndjsonReader) Next() ([]byte, error) {
+	for {
+		line, err := n.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			// blank lines are not counted as rows
+			continue
+		}
+
+		n.row++
+		if line[0] != '{' || !jsoniter.Valid(line) {
+			return nil, &RowError{Row: n.row, Err: errors.New("not a valid JSON object")}
+		}
+
+		return line, nil
+	}
+}
+
+func (n *ndjsonReader) Row() int64 {
+	return n.row
+}
+
+func (*ndjsonReader) Close() error {
+	return nil
+}
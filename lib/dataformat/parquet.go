@@ -0,0 +1,180 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataformat
+
+import (
+	"io"
+	"os"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+const (
+	parquetReadBatch   = 1024
+	parquetParallelism = 4
+)
+
+// localFile is a source.ParquetFile backed by a file on the local disk.
+type localFile struct {
+	*os.File
+}
+
+func (f *localFile) Open(name string) (source.ParquetFile, error) {
+	if name == "" {
+		name = f.Name()
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localFile{File: file}, nil
+}
+
+func (*localFile) Create(name string) (source.ParquetFile, error) {
+	file, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localFile{File: file}, nil
+}
+
+type parquetReader struct {
+	file    *localFile
+	pr      *reader.ParquetReader
+	names   map[string]string
+	total   int64
+	row     int64
+	pending []any
+}
+
+// newParquetReader spools the input to a temporary file because parquet metadata is stored in the footer and the
+// reader needs random access to the column chunks.
+func newParquetReader(input io.Reader) (*parquetReader, error) {
+	tmp, err := os.CreateTemp("", "tigris-import-*.parquet")
+	if err != nil {
+		return nil, err
+	}
+
+	file := &localFile{File: tmp}
+	if _, err = io.Copy(tmp, input); err != nil {
+		_ = closeAndRemove(file)
+		return nil, err
+	}
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		_ = closeAndRemove(file)
+		return nil, err
+	}
+
+	pr, err := reader.NewParquetReader(file, nil, parquetParallelism)
+	if err != nil {
+		_ = closeAndRemove(file)
+		return nil, err
+	}
+
+	// the reader generates Go struct fields from the column names, map them back to the original names
+	names := make(map[string]string, len(pr.SchemaHandler.Infos))
+	for _, info := range pr.SchemaHandler.Infos {
+		names[info.InName] = info.ExName
+	}
+
+	return &parquetReader{
+		file:  file,
+		pr:    pr,
+		names: names,
+		total: pr.GetNumRows(),
+	}, nil
+}
+
+func (p *parquetReader) Next() ([]byte, error) {
+	if len(p.pending) == 0 {
+		if p.row >= p.total {
+			return nil, io.EOF
+		}
+
+		rows, err := p.pr.ReadByNumber(parquetReadBatch)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			return nil, io.EOF
+		}
+		p.pending = rows
+	}
+
+	row := p.pending[0]
+	p.pending = p.pending[1:]
+	p.row++
+
+	js, err := jsoniter.Marshal(row)
+	if err != nil {
+		return nil, &RowError{Row: p.row, Err: err}
+	}
+
+	var doc map[string]any
+	if err = jsoniter.Unmarshal(js, &doc); err != nil {
+		return nil, &RowError{Row: p.row, Err: err}
+	}
+
+	if js, err = jsoniter.Marshal(p.rename(doc)); err != nil {
+		return nil, &RowError{Row: p.row, Err: err}
+	}
+
+	return js, nil
+}
+
+// rename restores the column names and removes null values.
+func (p *parquetReader) rename(doc map[string]any) map[string]any {
+	renamed := make(map[string]any, len(doc))
+	for k, v := range doc {
+		if v == nil {
+			continue
+		}
+
+		if nested, ok := v.(map[string]any); ok {
+			v = p.rename(nested)
+		}
+
+		if name, ok := p.names[k]; ok {
+			k = name
+		}
+		renamed[k] = v
+	}
+
+	return renamed
+}
+
+func (p *parquetReader) Row() int64 {
+	return p.row
+}
+
+func (p *parquetReader) Close() error {
+	p.pr.ReadStop()
+
+	return closeAndRemove(p.file)
+}
+
+func closeAndRemove(f *localFile) error {
+	err := f.Close()
+	if rmErr := os.Remove(f.Name()); err == nil {
+		err = rmErr
+	}
+
+	return err
+}
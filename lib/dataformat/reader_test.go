@@ -0,0 +1,150 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataformat
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+func readAll(t *testing.T, r Reader) ([]string, []int64) {
+	var (
+		docs   []string
+		failed []int64
+	)
+
+	for {
+		doc, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			var rowErr *RowError
+			require.ErrorAs(t, err, &rowErr)
+			failed = append(failed, rowErr.Row)
+			continue
+		}
+		docs = append(docs, string(doc))
+	}
+	require.NoError(t, r.Close())
+
+	return docs, failed
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, exp := range map[string]Format{"": NDJSON, "JSONL": NDJSON, "csv": CSV, "Parquet": Parquet} {
+		f, err := ParseFormat(name)
+		require.NoError(t, err)
+		assert.Equal(t, exp, f)
+	}
+
+	_, err := ParseFormat("xml")
+	require.Error(t, err)
+}
+
+func TestNDJSONReader(t *testing.T) {
+	input := "{\"a\":1}\n\n  {\"b\":\"x\"}  \nnot json\n[1,2]\n{\"c\":true}"
+
+	r, err := NewReader(NDJSON, bytes.NewReader([]byte(input)), nil)
+	require.NoError(t, err)
+
+	docs, failed := readAll(t, r)
+	assert.Equal(t, []string{`{"a":1}`, `{"b":"x"}`, `{"c":true}`}, docs)
+	assert.Equal(t, []int64{3, 4}, failed)
+	assert.Equal(t, int64(5), r.Row())
+}
+
+func TestCSVReader(t *testing.T) {
+	t.Run("detect", func(t *testing.T) {
+		input := "id,name,price,active\n1,shoe,10.5,true\n2,,NaN,false\n3,hat\n"
+
+		r, err := NewReader(CSV, bytes.NewReader([]byte(input)), nil)
+		require.NoError(t, err)
+
+		docs, failed := readAll(t, r)
+		require.Len(t, docs, 2)
+		assert.JSONEq(t, `{"id":1,"name":"shoe","price":10.5,"active":true}`, docs[0])
+		assert.JSONEq(t, `{"id":2,"price":"NaN","active":false}`, docs[1])
+		assert.Equal(t, []int64{3}, failed)
+	})
+
+	t.Run("detect_numbers", func(t *testing.T) {
+		input := "zip,code,amount,ratio,big\n00123,+7,-12,1.5e3,99999999999999999999\n"
+
+		r, err := NewReader(CSV, bytes.NewReader([]byte(input)), nil)
+		require.NoError(t, err)
+
+		docs, failed := readAll(t, r)
+		require.Len(t, docs, 1)
+		assert.JSONEq(t, `{"zip":"00123","code":"+7","amount":-12,"ratio":1500,"big":1e20}`, docs[0])
+		assert.Empty(t, failed)
+	})
+
+	t.Run("types", func(t *testing.T) {
+		input := "id;zip;tags;score\n1;02134;\"[\"\"a\"\"]\";7\n2;10001;[];x\n"
+
+		r, err := NewReader(CSV, bytes.NewReader([]byte(input)), &ReaderOptions{
+			CSVDelimiter:   ';',
+			CSVColumnTypes: map[string]string{"zip": "string", "tags": "array", "score": "number"},
+		})
+		require.NoError(t, err)
+
+		docs, failed := readAll(t, r)
+		require.Len(t, docs, 1)
+		assert.JSONEq(t, `{"id":1,"zip":"02134","tags":["a"],"score":7}`, docs[0])
+		assert.Equal(t, []int64{2}, failed)
+	})
+
+	t.Run("invalid_type", func(t *testing.T) {
+		_, err := NewReader(CSV, bytes.NewReader(nil), &ReaderOptions{CSVColumnTypes: map[string]string{"a": "date"}})
+		require.Error(t, err)
+	})
+}
+
+func TestParquetReader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.parquet")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	sch := `{"Tag":"name=parquet_go_root, repetitiontype=REQUIRED","Fields":[
+		{"Tag":"name=name, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"},
+		{"Tag":"name=item_count, type=INT64, repetitiontype=OPTIONAL"}]}`
+	w, err := writer.NewJSONWriter(sch, &localFile{File: f}, 1)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(`{"name":"a","item_count":3}`))
+	require.NoError(t, w.Write(`{"name":"b"}`))
+	require.NoError(t, w.WriteStop())
+	require.NoError(t, f.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	r, err := NewReader(Parquet, bytes.NewReader(data), nil)
+	require.NoError(t, err)
+
+	docs, failed := readAll(t, r)
+	require.Len(t, docs, 2)
+	assert.Empty(t, failed)
+	assert.JSONEq(t, `{"name":"a","item_count":3}`, docs[0])
+	assert.JSONEq(t, `{"name":"b"}`, docs[1])
+}
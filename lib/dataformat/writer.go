@@ -0,0 +1,257 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataformat
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// Column describes a top level field of the exported documents. Type is the JSON schema type of the field, the values
+// of "object" and "array" columns are written as JSON text by the CSV and Parquet writers.
+type Column struct {
+	Name string
+	Type string
+}
+
+// Writer encodes JSON documents in the output format. Close flushes the buffered data, it doesn't close the
+// underlying io.Writer.
+type Writer interface {
+	Write(doc []byte) error
+	Close() error
+}
+
+// WriterOptions contains format specific settings of the writer.
+type WriterOptions struct {
+	// Columns of the CSV and Parquet output. CSV columns are detected from the first document when not set, Parquet
+	// requires the columns.
+	Columns []Column
+	// CSVDelimiter is the field delimiter, comma is used if not set.
+	CSVDelimiter rune
+}
+
+// NewWriter returns a Writer encoding the documents to output in the given format.
+func NewWriter(format Format, output io.Writer, opts *WriterOptions) (Writer, error) {
+	if opts == nil {
+		opts = &WriterOptions{}
+	}
+
+	switch format {
+	case NDJSON:
+		return &ndjsonWriter{w: bufio.NewWriter(output)}, nil
+	case CSV:
+		return newCSVWriter(output, opts), nil
+	case Parquet:
+		return newParquetWriter(output, opts.Columns)
+	default:
+		return nil, fmt.Errorf("unsupported data format '%s'", format)
+	}
+}
+
+type ndjsonWriter struct {
+	w *bufio.Writer
+}
+
+func (n *ndjsonWriter) Write(doc []byte) error {
+	if _, err := n.w.Write(doc); err != nil {
+		return err
+	}
+
+	return n.w.WriteByte('\n')
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.w.Flush()
+}
+
+type csvWriter struct {
+	w       *csv.Writer
+	columns []Column
+	record  []string
+}
+
+func newCSVWriter(output io.Writer, opts *WriterOptions) *csvWriter {
+	w := csv.NewWriter(output)
+	if opts.CSVDelimiter != 0 {
+		w.Comma = opts.CSVDelimiter
+	}
+
+	return &csvWriter{w: w, columns: opts.Columns}
+}
+
+func (c *csvWriter) Write(doc []byte) error {
+	var fields map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(doc, &fields); err != nil {
+		return err
+	}
+
+	if c.record == nil {
+		if len(c.columns) == 0 {
+			c.columns = detectColumns(fields)
+		}
+
+		c.record = make([]string, len(c.columns))
+		for i, col := range c.columns {
+			c.record[i] = col.Name
+		}
+		if err := c.w.Write(c.record); err != nil {
+			return err
+		}
+	}
+
+	for i, col := range c.columns {
+		c.record[i] = ""
+		if raw, ok := fields[col.Name]; ok {
+			v, err := csvValue(raw)
+			if err != nil {
+				return fmt.Errorf("column '%s': %w", col.Name, err)
+			}
+			c.record[i] = v
+		}
+	}
+
+	return c.w.Write(c.record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+
+	return c.w.Error()
+}
+
+// detectColumns returns the fields of the document in the lexicographical order.
+func detectColumns(fields map[string]jsoniter.RawMessage) []Column {
+	columns := make([]Column, 0, len(fields))
+	for name := range fields {
+		columns = append(columns, Column{Name: name})
+	}
+	sort.Slice(columns, func(i, j int) bool { return columns[i].Name < columns[j].Name })
+
+	return columns
+}
+
+// csvValue converts JSON value to the CSV cell. Strings are unquoted, nulls are empty cells and the rest is kept as
+// JSON text, this is the inverse of the conversion done by the CSV reader.
+func csvValue(raw jsoniter.RawMessage) (string, error) {
+	switch {
+	case len(raw) == 0 || string(raw) == "null":
+		return "", nil
+	case raw[0] == '"':
+		var s string
+		if err := jsoniter.Unmarshal(raw, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	default:
+		return string(raw), nil
+	}
+}
+
+type parquetWriter struct {
+	pw      *writer.JSONWriter
+	columns []Column
+}
+
+func newParquetWriter(output io.Writer, columns []Column) (*parquetWriter, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("parquet output requires columns")
+	}
+
+	schema, err := parquetSchema(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	pw, err := writer.NewJSONWriterFromWriter(schema, output, parquetParallelism)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parquetWriter{pw: pw, columns: columns}, nil
+}
+
+func (p *parquetWriter) Write(doc []byte) error {
+	var fields map[string]jsoniter.RawMessage
+	if err := jsoniter.Unmarshal(doc, &fields); err != nil {
+		return err
+	}
+
+	row := make(map[string]any, len(p.columns))
+	for _, col := range p.columns {
+		raw, ok := fields[col.Name]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+
+		switch col.Type {
+		case "integer", "number", "boolean":
+			row[col.Name] = raw
+		case "string":
+			var s string
+			if err := jsoniter.Unmarshal(raw, &s); err != nil {
+				return fmt.Errorf("column '%s': %w", col.Name, err)
+			}
+			row[col.Name] = s
+		default:
+			row[col.Name] = string(raw)
+		}
+	}
+
+	js, err := jsoniter.MarshalToString(row)
+	if err != nil {
+		return err
+	}
+
+	return p.pw.Write(js)
+}
+
+func (p *parquetWriter) Close() error {
+	return p.pw.WriteStop()
+}
+
+// parquetSchema builds the parquet-go JSON schema of the columns. All the columns are optional because documents may
+// not have every field of the collection.
+func parquetSchema(columns []Column) (string, error) {
+	fields := make([]string, 0, len(columns))
+	for _, col := range columns {
+		if strings.ContainsAny(col.Name, ",=") {
+			return "", fmt.Errorf("column name '%s' is not supported by parquet output", col.Name)
+		}
+
+		var typ string
+		switch col.Type {
+		case "integer":
+			typ = "type=INT64"
+		case "number":
+			typ = "type=DOUBLE"
+		case "boolean":
+			typ = "type=BOOLEAN"
+		default:
+			typ = "type=BYTE_ARRAY, convertedtype=UTF8"
+		}
+
+		fields = append(fields, `{"Tag": `+strconv.Quote("name="+col.Name+", "+typ+", repetitiontype=OPTIONAL")+`}`)
+	}
+
+	return `{"Tag": "name=parquet_go_root, repetitiontype=REQUIRED", "Fields": [` + strings.Join(fields, ", ") + `]}`, nil
+}
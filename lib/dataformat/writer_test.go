@@ -0,0 +1,94 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataformat
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeAll(t *testing.T, format Format, opts *WriterOptions, docs ...string) []byte {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(format, &buf, opts)
+	require.NoError(t, err)
+	for _, doc := range docs {
+		require.NoError(t, w.Write([]byte(doc)))
+	}
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	out := writeAll(t, NDJSON, nil, `{"a":1}`, `{"b":"x"}`)
+	require.Equal(t, "{\"a\":1}\n{\"b\":\"x\"}\n", string(out))
+}
+
+func TestCSVWriter(t *testing.T) {
+	t.Run("detect_columns", func(t *testing.T) {
+		out := writeAll(t, CSV, nil, `{"name":"a,b","id":1}`, `{"id":2,"tags":["x"],"name":null}`)
+		require.Equal(t, "id,name\n1,\"a,b\"\n2,\n", string(out))
+	})
+
+	t.Run("columns", func(t *testing.T) {
+		out := writeAll(t, CSV, &WriterOptions{
+			Columns:      []Column{{Name: "id"}, {Name: "tags"}, {Name: "ok"}},
+			CSVDelimiter: ';',
+		}, `{"id":1,"tags":["x","y"],"ok":true}`)
+		require.Equal(t, "id;tags;ok\n1;\"[\"\"x\"\",\"\"y\"\"]\";true\n", string(out))
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		out := writeAll(t, CSV, &WriterOptions{
+			Columns: []Column{{Name: "id"}, {Name: "name"}, {Name: "obj"}},
+		}, `{"id":1,"name":"n1","obj":{"a":1}}`)
+
+		r, err := NewReader(CSV, bytes.NewReader(out), &ReaderOptions{CSVColumnTypes: map[string]string{"obj": "object"}})
+		require.NoError(t, err)
+		docs, failed := readAll(t, r)
+		require.Empty(t, failed)
+		require.Len(t, docs, 1)
+		require.JSONEq(t, `{"id":1,"name":"n1","obj":{"a":1}}`, docs[0])
+	})
+}
+
+func TestParquetWriter(t *testing.T) {
+	_, err := NewWriter(Parquet, &bytes.Buffer{}, nil)
+	require.Error(t, err)
+
+	columns := []Column{
+		{Name: "id", Type: "integer"},
+		{Name: "name", Type: "string"},
+		{Name: "price", Type: "number"},
+		{Name: "ok", Type: "boolean"},
+		{Name: "obj", Type: "object"},
+	}
+
+	out := writeAll(t, Parquet, &WriterOptions{Columns: columns},
+		`{"id":1,"name":"n1","price":1.5,"ok":true,"obj":{"a":1}}`,
+		`{"id":2,"name":"n2"}`,
+	)
+
+	r, err := NewReader(Parquet, bytes.NewReader(out), nil)
+	require.NoError(t, err)
+	docs, failed := readAll(t, r)
+	require.Empty(t, failed)
+	require.Len(t, docs, 2)
+	require.JSONEq(t, `{"id":1,"name":"n1","price":1.5,"ok":true,"obj":"{\"a\":1}"}`, docs[0])
+	require.JSONEq(t, `{"id":2,"name":"n2"}`, docs[1])
+}
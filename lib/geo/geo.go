@@ -0,0 +1,227 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	// EarthRadiusMeters is the mean radius of the earth used by the distance calculations.
+	EarthRadiusMeters = 6371008.8
+	// HashPrecision is the number of characters of the geohash stored in the index keys, it is precise to a few
+	// centimeters.
+	HashPrecision = 12
+	// hashEnd sorts after all the geohash characters, it bounds the keys sharing a geohash prefix.
+	hashEnd = "~"
+)
+
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Point is a location on the earth represented as [lat, lng] in the documents.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// NewPoint returns a point after validating the latitude and longitude ranges.
+func NewPoint(lat float64, lng float64) (Point, error) {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return Point{}, fmt.Errorf("latitude '%v' is out of range [-90, 90]", lat)
+	}
+	if math.IsNaN(lng) || lng < -180 || lng > 180 {
+		return Point{}, fmt.Errorf("longitude '%v' is out of range [-180, 180]", lng)
+	}
+
+	return Point{Lat: lat, Lng: lng}, nil
+}
+
+// ParsePoint converts the decoded JSON value [lat, lng] to a point.
+func ParsePoint(v any) (Point, error) {
+	arr, ok := v.([]any)
+	if !ok || len(arr) != 2 {
+		return Point{}, fmt.Errorf("expected geopoint as [lat, lng] but found '%v'", v)
+	}
+
+	var coords [2]float64
+	for i, c := range arr {
+		f, ok := toFloat(c)
+		if !ok {
+			return Point{}, fmt.Errorf("expected numeric coordinates in geopoint but found '%v'", c)
+		}
+		coords[i] = f
+	}
+
+	return NewPoint(coords[0], coords[1])
+}
+
+// ParsePointJSON converts the raw JSON value [lat, lng] to a point.
+func ParsePointJSON(raw []byte) (Point, error) {
+	var v any
+	if err := jsoniter.Unmarshal(raw, &v); err != nil {
+		return Point{}, err
+	}
+
+	return ParsePoint(v)
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// DistanceTo returns the great-circle distance in meters between the points using the haversine formula.
+func (p Point) DistanceTo(o Point) float64 {
+	lat1, lat2 := radians(p.Lat), radians(o.Lat)
+	dLat, dLng := lat2-lat1, radians(o.Lng-p.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func (p Point) String() string {
+	return fmt.Sprintf("[%v, %v]", p.Lat, p.Lng)
+}
+
+// Box is a bounding box defined by its south-west and north-east corners.
+type Box struct {
+	SouthWest Point
+	NorthEast Point
+}
+
+// NewBox returns a box after validating that the corners are ordered, the boxes crossing the antimeridian are not
+// supported.
+func NewBox(southWest Point, northEast Point) (Box, error) {
+	if southWest.Lat > northEast.Lat || southWest.Lng > northEast.Lng {
+		return Box{}, fmt.Errorf("box corner %v is not south-west of %v", southWest, northEast)
+	}
+
+	return Box{SouthWest: southWest, NorthEast: northEast}, nil
+}
+
+// BoundingBox returns the box enclosing the circle of the radius in meters around the center. The box is clamped to
+// the valid coordinates, so it spans all the longitudes near the poles or when the circle crosses the antimeridian.
+func BoundingBox(center Point, radius float64) Box {
+	dLat := degrees(radius / EarthRadiusMeters)
+	minLat, maxLat := center.Lat-dLat, center.Lat+dLat
+	if minLat <= -90 || maxLat >= 90 {
+		return Box{
+			SouthWest: Point{Lat: math.Max(minLat, -90), Lng: -180},
+			NorthEast: Point{Lat: math.Min(maxLat, 90), Lng: 180},
+		}
+	}
+
+	dLng := degrees(math.Asin(math.Min(1, math.Sin(radius/EarthRadiusMeters)/math.Cos(radians(center.Lat)))))
+	minLng, maxLng := center.Lng-dLng, center.Lng+dLng
+	if minLng < -180 || maxLng > 180 {
+		minLng, maxLng = -180, 180
+	}
+
+	return Box{SouthWest: Point{Lat: minLat, Lng: minLng}, NorthEast: Point{Lat: maxLat, Lng: maxLng}}
+}
+
+// Contains returns true if the point is inside the box or on its edges.
+func (b Box) Contains(p Point) bool {
+	return p.Lat >= b.SouthWest.Lat && p.Lat <= b.NorthEast.Lat && p.Lng >= b.SouthWest.Lng && p.Lng <= b.NorthEast.Lng
+}
+
+// Center returns the middle of the box.
+func (b Box) Center() Point {
+	return Point{Lat: (b.SouthWest.Lat + b.NorthEast.Lat) / 2, Lng: (b.SouthWest.Lng + b.NorthEast.Lng) / 2}
+}
+
+// Encode returns the geohash of the point with the number of characters requested. The points sharing a geohash
+// prefix are inside the same cell, so a prefix scan on the geohash keys returns the points of a cell.
+func Encode(p Point, precision int) string {
+	var (
+		sb       strings.Builder
+		latRange = [2]float64{-90, 90}
+		lngRange = [2]float64{-180, 180}
+		even     = true
+		bit      = 0
+		ch       = 0
+	)
+	for sb.Len() < precision {
+		if even {
+			ch <<= 1
+			if mid := (lngRange[0] + lngRange[1]) / 2; p.Lng >= mid {
+				ch |= 1
+				lngRange[0] = mid
+			} else {
+				lngRange[1] = mid
+			}
+		} else {
+			ch <<= 1
+			if mid := (latRange[0] + latRange[1]) / 2; p.Lat >= mid {
+				ch |= 1
+				latRange[0] = mid
+			} else {
+				latRange[1] = mid
+			}
+		}
+		even = !even
+
+		if bit++; bit == 5 {
+			sb.WriteByte(base32[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return sb.String()
+}
+
+// CoveringPrefix returns the longest geohash prefix whose cell covers the whole box. The cells are rectangles, so the
+// cell containing both the corners contains the box. An empty prefix covers the whole world.
+func CoveringPrefix(b Box) string {
+	sw, ne := Encode(b.SouthWest, HashPrecision), Encode(b.NorthEast, HashPrecision)
+
+	i := 0
+	for i < len(sw) && sw[i] == ne[i] {
+		i++
+	}
+	return sw[:i]
+}
+
+// PrefixEnd returns the exclusive upper bound of the geohashes starting with the prefix.
+func PrefixEnd(prefix string) string {
+	return prefix + hashEnd
+}
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
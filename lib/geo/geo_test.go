@@ -0,0 +1,88 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePoint(t *testing.T) {
+	p, err := ParsePointJSON([]byte(`[37.7749, -122.4194]`))
+	require.NoError(t, err)
+	require.Equal(t, Point{Lat: 37.7749, Lng: -122.4194}, p)
+
+	p, err = ParsePoint([]any{json.Number("48.8584"), json.Number("2.2945")})
+	require.NoError(t, err)
+	require.Equal(t, Point{Lat: 48.8584, Lng: 2.2945}, p)
+
+	for _, invalid := range []string{`[91, 0]`, `[0, -181]`, `[1]`, `[1, 2, 3]`, `["a", 1]`, `{"lat": 1}`} {
+		_, err = ParsePointJSON([]byte(invalid))
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	sf, la := Point{Lat: 37.7749, Lng: -122.4194}, Point{Lat: 34.0522, Lng: -118.2437}
+	require.InDelta(t, 559120, sf.DistanceTo(la), 1000)
+	require.InDelta(t, sf.DistanceTo(la), la.DistanceTo(sf), 1e-6)
+	require.Zero(t, sf.DistanceTo(sf))
+}
+
+func TestBox(t *testing.T) {
+	_, err := NewBox(Point{Lat: 10, Lng: 10}, Point{Lat: 0, Lng: 20})
+	require.Error(t, err)
+
+	b, err := NewBox(Point{Lat: 0, Lng: 10}, Point{Lat: 10, Lng: 20})
+	require.NoError(t, err)
+	require.True(t, b.Contains(Point{Lat: 5, Lng: 15}))
+	require.True(t, b.Contains(Point{Lat: 10, Lng: 10}))
+	require.False(t, b.Contains(Point{Lat: 5, Lng: 21}))
+	require.Equal(t, Point{Lat: 5, Lng: 15}, b.Center())
+
+	// the box encloses the points on the circle
+	center := Point{Lat: 37.7749, Lng: -122.4194}
+	bbox := BoundingBox(center, 10000)
+	for _, offset := range []Point{{Lat: 0.0899, Lng: 0}, {Lat: -0.0899, Lng: 0}, {Lat: 0, Lng: 0.1137}, {Lat: 0, Lng: -0.1137}} {
+		p := Point{Lat: center.Lat + offset.Lat, Lng: center.Lng + offset.Lng}
+		require.InDelta(t, 10000, center.DistanceTo(p), 50)
+		require.True(t, bbox.Contains(Point{Lat: center.Lat + offset.Lat*0.99, Lng: center.Lng + offset.Lng*0.99}))
+	}
+
+	// near the poles the box spans all the longitudes
+	polar := BoundingBox(Point{Lat: 89.99, Lng: 0}, 10000)
+	require.Equal(t, -180.0, polar.SouthWest.Lng)
+	require.Equal(t, 180.0, polar.NorthEast.Lng)
+}
+
+func TestGeohash(t *testing.T) {
+	require.Equal(t, "9q8yyk8ytpxr", Encode(Point{Lat: 37.7749, Lng: -122.4194}, HashPrecision))
+	require.Equal(t, "u09tunq", Encode(Point{Lat: 48.8584, Lng: 2.2945}, 7))
+
+	b, err := NewBox(Point{Lat: 37.70, Lng: -122.52}, Point{Lat: 37.82, Lng: -122.35})
+	require.NoError(t, err)
+	prefix := CoveringPrefix(b)
+	require.Equal(t, "9q8", prefix)
+	for _, p := range []Point{b.SouthWest, b.NorthEast, b.Center()} {
+		hash := Encode(p, HashPrecision)
+		require.True(t, strings.HasPrefix(hash, prefix))
+		require.Less(t, hash, PrefixEnd(prefix))
+	}
+
+	require.Empty(t, CoveringPrefix(Box{SouthWest: Point{Lat: -1, Lng: -1}, NorthEast: Point{Lat: 1, Lng: 1}}))
+}
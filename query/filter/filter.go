@@ -117,6 +117,9 @@ type Factory struct {
 	// 1. Reject Case insensitive queries
 	// 2. Always use Factory Top level collation because it will be a sort key collation
 	buildForSecondaryIndex bool
+	// dynamic is set for the documents without a schema, every field of the filter is a dynamic field and its type
+	// is inferred from the value in the filter.
+	dynamic bool
 }
 
 func NewFactory(fields []*schema.QueryableField, collation *value.Collation) *Factory {
@@ -135,6 +138,13 @@ func NewFactoryForSecondaryIndex(fields []*schema.QueryableField) *Factory {
 	}
 }
 
+// NewDynamicFactory returns a factory for filtering the documents without a schema like the realtime messages.
+func NewDynamicFactory() *Factory {
+	return &Factory{
+		dynamic: true,
+	}
+}
+
 func (factory *Factory) WrappedFilter(reqFilter []byte) (*WrappedFilter, error) {
 	filters, err := factory.Factorize(reqFilter)
 	if err != nil {
@@ -273,6 +283,9 @@ func (factory *Factory) filterToQueryableField(filterField string) (*schema.Quer
 func (factory *Factory) ParseSelector(k []byte, v []byte, dataType jsonparser.ValueType) (Filter, error) {
 	filterField := string(k)
 	field, parent := factory.filterToQueryableField(filterField)
+	if field == nil && factory.dynamic {
+		field = schema.NewDynamicQueryableField(filterField, filterField, schema.UnknownType)
+	}
 	if field == nil {
 		// try level - 1
 		idx := strings.LastIndex(filterField, ".")
@@ -377,6 +390,9 @@ func buildValueMatcher(input jsoniter.RawMessage, field *schema.QueryableField,
 				valueMatcher, err = NewMatcher(string(key), val)
 				return err
 			}
+		case NEAR, GEOWITHIN:
+			valueMatcher, err = buildGeoMatcher(string(key), v, dataType, field)
+			return err
 		case REGEX, CONTAINS, NOT:
 			if dataType != jsonparser.String {
 				return errors.InvalidArgument("string is only supported type for 'regex/contains/not' filters")
@@ -183,3 +183,26 @@ func TestFiltersWithCollation(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, filters)
 }
+
+func TestDynamicFactory(t *testing.T) {
+	factory := NewDynamicFactory()
+
+	cases := []struct {
+		filter string
+		doc    string
+		match  bool
+	}{
+		{`{"name": "chat"}`, `{"name": "chat", "data": {"room": 1}}`, true},
+		{`{"name": "chat"}`, `{"name": "typing", "data": {"room": 1}}`, false},
+		{`{"data.room": 1}`, `{"name": "chat", "data": {"room": 1}}`, true},
+		{`{"data.room": {"$gt": 1}}`, `{"name": "chat", "data": {"room": 1}}`, false},
+		{`{"data.user.active": true}`, `{"data": {"user": {"active": true}}}`, true},
+		{`{"data.missing": 1}`, `{"data": {"room": 1}}`, false},
+		{`{"$or": [{"name": "typing"}, {"data.room": {"$lte": 2}}]}`, `{"name": "chat", "data": {"room": 1}}`, true},
+	}
+	for _, c := range cases {
+		f, err := factory.WrappedFilter([]byte(c.filter))
+		require.NoError(t, err)
+		require.Equal(t, c.match, f.Matches([]byte(c.doc), nil), c.filter)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/buger/jsonparser"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/geo"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/value"
+)
+
+const (
+	NEAR      = "$near"
+	GEOWITHIN = "$geoWithin"
+
+	geoPoint       = "$point"
+	geoMaxDistance = "$maxDistance"
+	geoBox         = "$box"
+)
+
+// GeoMatcher implements the "$near" and "$geoWithin" operands on a geopoint field. The "$near" matches the points
+// within the max distance in meters from the center point and the "$geoWithin" matches the points inside the box.
+//
+//	{"location": {"$near": {"$point": [37.77, -122.41], "$maxDistance": 1000}}}
+//	{"location": {"$geoWithin": {"$box": [[37.70, -122.52], [37.82, -122.35]]}}}
+//
+// The box is defined by its south-west and north-east corners.
+type GeoMatcher struct {
+	op          string
+	center      geo.Point
+	maxDistance float64
+	box         geo.Box
+}
+
+func NewGeoMatcher(key string, input jsoniter.RawMessage) (*GeoMatcher, error) {
+	switch key {
+	case NEAR:
+		return newNearMatcher(input)
+	case GEOWITHIN:
+		return newGeoWithinMatcher(input)
+	default:
+		return nil, errors.InvalidArgument("unsupported operand '%s'", key)
+	}
+}
+
+func newNearMatcher(input jsoniter.RawMessage) (*GeoMatcher, error) {
+	raw, dt, _, _ := jsonparser.Get(input, geoPoint)
+	if dt != jsonparser.Array {
+		return nil, errors.InvalidArgument("'%s' requires the '%s' as [lat, lng]", NEAR, geoPoint)
+	}
+	center, err := geo.ParsePointJSON(raw)
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid '%s': %s", geoPoint, err.Error())
+	}
+
+	maxDistance, err := jsonparser.GetFloat(input, geoMaxDistance)
+	if err != nil || maxDistance <= 0 {
+		return nil, errors.InvalidArgument("'%s' requires a positive '%s' in meters", NEAR, geoMaxDistance)
+	}
+
+	return &GeoMatcher{
+		op:          NEAR,
+		center:      center,
+		maxDistance: maxDistance,
+		box:         geo.BoundingBox(center, maxDistance),
+	}, nil
+}
+
+func newGeoWithinMatcher(input jsoniter.RawMessage) (*GeoMatcher, error) {
+	var corners []geo.Point
+	_, err := jsonparser.ArrayEach(input, func(item []byte, _ jsonparser.ValueType, _ int, e error) {
+		if e != nil {
+			return
+		}
+		if p, e := geo.ParsePointJSON(item); e == nil {
+			corners = append(corners, p)
+		}
+	}, geoBox)
+	if err != nil || len(corners) != 2 {
+		return nil, errors.InvalidArgument("'%s' requires the '%s' as [[lat, lng], [lat, lng]]", GEOWITHIN, geoBox)
+	}
+
+	box, err := geo.NewBox(corners[0], corners[1])
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid '%s': %s", geoBox, err.Error())
+	}
+
+	return &GeoMatcher{
+		op:     GEOWITHIN,
+		center: box.Center(),
+		box:    box,
+	}, nil
+}
+
+// GetValue returns the center of the area.
+func (g *GeoMatcher) GetValue() value.Value {
+	return value.NewGeoPointValue(g.center)
+}
+
+func (g *GeoMatcher) Matches(input value.Value) bool {
+	p, ok := input.(*value.GeoPointValue)
+	if !ok {
+		return false
+	}
+
+	return g.contains(p.Point)
+}
+
+func (*GeoMatcher) ArrMatches(_ []any) bool {
+	return false
+}
+
+func (g *GeoMatcher) contains(p geo.Point) bool {
+	if g.op == NEAR {
+		return g.center.DistanceTo(p) <= g.maxDistance
+	}
+	return g.box.Contains(p)
+}
+
+// CoveringPrefix returns the geohash prefix covering the area, the matching points are stored in the index keys
+// starting with this prefix.
+func (g *GeoMatcher) CoveringPrefix() string {
+	return geo.CoveringPrefix(g.box)
+}
+
+// ToSearchFilter returns the radius filter for "$near" and the polygon filter of the box corners for "$geoWithin".
+func (g *GeoMatcher) ToSearchFilter(field string) string {
+	if g.op == NEAR {
+		return fmt.Sprintf("%s:(%s, %s, %s km)", field, formatCoordinate(g.center.Lat), formatCoordinate(g.center.Lng),
+			formatCoordinate(g.maxDistance/1000))
+	}
+
+	sw, ne := g.box.SouthWest, g.box.NorthEast
+	return fmt.Sprintf("%s:(%s, %s, %s, %s, %s, %s, %s, %s)", field,
+		formatCoordinate(ne.Lat), formatCoordinate(sw.Lng),
+		formatCoordinate(ne.Lat), formatCoordinate(ne.Lng),
+		formatCoordinate(sw.Lat), formatCoordinate(ne.Lng),
+		formatCoordinate(sw.Lat), formatCoordinate(sw.Lng))
+}
+
+func (g *GeoMatcher) Type() string {
+	return g.op
+}
+
+func (g *GeoMatcher) String() string {
+	if g.op == NEAR {
+		return fmt.Sprintf("{%s:{%s:%v, %s:%v}}", NEAR, geoPoint, g.center, geoMaxDistance, g.maxDistance)
+	}
+	return fmt.Sprintf("{%s:{%s:[%v, %v]}}", GEOWITHIN, geoBox, g.box.SouthWest, g.box.NorthEast)
+}
+
+func formatCoordinate(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func buildGeoMatcher(key string, input jsoniter.RawMessage, dataType jsonparser.ValueType, field *schema.QueryableField) (*GeoMatcher, error) {
+	if field.DataType != schema.GeoPointType {
+		return nil, errors.InvalidArgument("field '%s' of type '%s' is not supported for '%s' filter. Only 'geopoint' is supported",
+			field.FieldName, schema.FieldNames[field.DataType], key)
+	}
+	if dataType != jsonparser.Object {
+		return nil, errors.InvalidArgument("object is only supported type for '%s' filter", key)
+	}
+
+	return NewGeoMatcher(key, input)
+}
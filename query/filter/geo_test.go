@@ -0,0 +1,111 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/lib/geo"
+	tsort "github.com/tigrisdata/tigris/query/sort"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/value"
+)
+
+func TestGeoFilter(t *testing.T) {
+	fields := []*schema.QueryableField{
+		{FieldName: "location", InMemoryAlias: "location", DataType: schema.GeoPointType},
+		{FieldName: "name", DataType: schema.StringType},
+	}
+	factory := NewFactory(fields, nil)
+
+	cases := []struct {
+		filter       []byte
+		searchFilter string
+		matches      []bool
+	}{
+		{
+			[]byte(`{"location": {"$near": {"$point": [37.7749, -122.4194], "$maxDistance": 5000}}}`),
+			"location:(37.7749, -122.4194, 5 km)",
+			[]bool{true, true, false},
+		},
+		{
+			[]byte(`{"location": {"$near": {"$point": [37.7749, -122.4194], "$maxDistance": 600000}}}`),
+			"location:(37.7749, -122.4194, 600 km)",
+			[]bool{true, true, true},
+		},
+		{
+			[]byte(`{"location": {"$geoWithin": {"$box": [[37.70, -122.52], [37.82, -122.35]]}}}`),
+			"location:(37.82, -122.52, 37.82, -122.35, 37.7, -122.35, 37.7, -122.52)",
+			[]bool{true, true, false},
+		},
+	}
+	docs := [][]byte{
+		[]byte(`{"name": "sf", "location": [37.7749, -122.4194]}`),
+		[]byte(`{"name": "mission", "location": [37.7599, -122.4148]}`),
+		[]byte(`{"name": "la", "location": [34.0522, -118.2437]}`),
+	}
+	for _, c := range cases {
+		filters, err := factory.Factorize(c.filter)
+		require.NoError(t, err)
+		require.Len(t, filters, 1)
+		require.True(t, filters[0].IsSearchIndexed())
+		require.Equal(t, c.searchFilter, filters[0].ToSearchFilter())
+		for i, doc := range docs {
+			require.Equal(t, c.matches[i], filters[0].Matches(doc, nil), string(doc))
+		}
+	}
+
+	errCases := []struct {
+		filter []byte
+		err    string
+	}{
+		{[]byte(`{"name": {"$near": {"$point": [0, 0], "$maxDistance": 10}}}`), "field 'name' of type 'string' is not supported for '$near' filter"},
+		{[]byte(`{"location": {"$near": [0, 0]}}`), "object is only supported type for '$near' filter"},
+		{[]byte(`{"location": {"$near": {"$maxDistance": 10}}}`), "'$near' requires the '$point' as [lat, lng]"},
+		{[]byte(`{"location": {"$near": {"$point": [0, 0]}}}`), "'$near' requires a positive '$maxDistance' in meters"},
+		{[]byte(`{"location": {"$near": {"$point": [100, 0], "$maxDistance": 10}}}`), "invalid '$point'"},
+		{[]byte(`{"location": {"$geoWithin": {"$box": [[0, 0]]}}}`), "'$geoWithin' requires the '$box' as [[lat, lng], [lat, lng]]"},
+		{[]byte(`{"location": {"$geoWithin": {"$box": [[10, 10], [0, 0]]}}}`), "invalid '$box'"},
+	}
+	for _, c := range errCases {
+		_, err := factory.Factorize(c.filter)
+		require.ErrorContains(t, err, c.err, string(c.filter))
+	}
+}
+
+func TestGeoRangeKey(t *testing.T) {
+	userFields := []*schema.QueryableField{{FieldName: "location", DataType: schema.GeoPointType}}
+	userKeys := []*schema.Field{{FieldName: "location", DataType: schema.GeoPointType}}
+	filters := testFilters(t, userFields, []byte(`{"location": {"$geoWithin": {"$box": [[37.70, -122.52], [37.82, -122.35]]}}}`), true)
+
+	b := NewKeyBuilder[*schema.Field](NewRangeKeyComposer[*schema.Field](dummyEncodeFunc, dummyBuildIndexParts, SecondaryIndex), SecondaryIndex)
+	plans, err := b.Build(filters, userKeys)
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	require.Equal(t, RANGE, plans[0].QueryType)
+
+	order := value.ToSecondaryOrder(schema.GeoPointType, nil)
+	require.Equal(t, []keys.Key{
+		keys.NewKey(nil, order, "location", "9q8"),
+		keys.NewKey(nil, order, "location", "9q8~"),
+	}, plans[0].Keys)
+
+	// the index can't return the points ordered by the distance
+	_, err = QueryPlanFromSort(&[]tsort.SortField{{Name: "location", Near: &geo.Point{Lat: 37.7, Lng: -122.4}}},
+		userFields, dummyEncodeFunc, dummyBuildIndexParts, SecondaryIndex)
+	require.ErrorContains(t, err, "Sort by distance is not supported by the index")
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/tigrisdata/tigris/errors"
 	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/lib/geo"
 	tsort "github.com/tigrisdata/tigris/query/sort"
 	"github.com/tigrisdata/tigris/schema"
 	"github.com/tigrisdata/tigris/value"
@@ -362,6 +363,19 @@ func (s *RangeKeyComposer[F]) Compose(selectors []*Selector, userDefinedKeys []F
 		var begin, end keys.Key
 		rangeType := FULLRANGE
 		for _, sel := range selectors {
+			if geoMatcher, ok := sel.Matcher.(*GeoMatcher); ok && k.Name() == sel.Field.Name() {
+				// the points inside the area share the geohash prefix of the cell covering the area
+				prefix := geoMatcher.CoveringPrefix()
+				if begin, err = s.keyEncodingFunc(s.buildIndexPartsFunc(sel.Field.Name(), value.NewGeoHashValue(prefix))...); err != nil {
+					return nil, err
+				}
+				if end, err = s.keyEncodingFunc(s.buildIndexPartsFunc(sel.Field.Name(), value.NewGeoHashValue(geo.PrefixEnd(prefix)))...); err != nil {
+					return nil, err
+				}
+				rangeType = RANGE
+				break
+			}
+
 			if k.Name() == sel.Field.Name() && s.isRange(sel) {
 				indexParts := s.buildIndexPartsFunc(sel.Field.Name(), sel.Matcher.GetValue())
 				if s.isGreater(sel) {
@@ -451,6 +465,11 @@ func QueryPlanFromSort(sortFields *[]tsort.SortField, indexableFields []*schema.
 		return nil, nil
 	}
 
+	if (*sortFields)[0].Near != nil {
+		// the geohash order of the index is not the distance order
+		return nil, errors.InvalidArgument("Sort by distance is not supported by the index")
+	}
+
 	var field *schema.QueryableField
 	for _, idx := range indexableFields {
 		if idx.FieldName == (*sortFields)[0].Name {
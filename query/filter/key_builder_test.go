@@ -37,9 +37,9 @@ func testFilters(t testing.TB, fields []*schema.QueryableField, input []byte, st
 	}
 
 	factory := Factory{
-		fields,
-		collation,
-		buildForSecondaryIndex,
+		fields:                 fields,
+		collation:              collation,
+		buildForSecondaryIndex: buildForSecondaryIndex,
 	}
 	filters, err := factory.Factorize(input)
 	require.NoError(t, err)
@@ -133,6 +133,10 @@ func (s *Selector) Matches(doc []byte, metadata []byte) bool {
 }
 
 func (s *Selector) ToSearchFilter() string {
+	if g, ok := s.Matcher.(*GeoMatcher); ok {
+		return g.ToSearchFilter(s.Field.InMemoryName())
+	}
+
 	var op string
 	switch s.Matcher.Type() {
 	case EQ:
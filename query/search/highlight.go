@@ -0,0 +1,73 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/schema"
+)
+
+// Highlight is the highlighting requested in the search, the matched tokens of the fields are wrapped in the tags and
+// a snippet around them is returned with every hit.
+type Highlight struct {
+	// Fields are the fields to highlight, by default the fields that are searched.
+	Fields []string `json:"fields,omitempty"`
+	// SnippetLength is the number of tokens included in the snippet on each side of the matched tokens.
+	SnippetLength int    `json:"snippet_length,omitempty"`
+	PreTag        string `json:"pre_tag,omitempty"`
+	PostTag       string `json:"post_tag,omitempty"`
+}
+
+func UnmarshalHighlight(input jsoniter.RawMessage) (*Highlight, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	var h Highlight
+	if err := jsoniter.Unmarshal(input, &h); err != nil {
+		return nil, errors.InvalidArgument("unable to parse highlight: %s", err.Error())
+	}
+	if h.SnippetLength < 0 {
+		return nil, errors.InvalidArgument("highlight snippet_length can't be negative")
+	}
+
+	return &h, nil
+}
+
+// BuildHighlight unmarshals the highlight of the request and resolves its fields by the queryable fields of the
+// collection or the search index. Only the indexed text fields can be highlighted, the fields are replaced by their
+// names in the search backend.
+func BuildHighlight(input jsoniter.RawMessage, queryableField func(name string) (*schema.QueryableField, error)) (*Highlight, error) {
+	highlight, err := UnmarshalHighlight(input)
+	if err != nil || highlight == nil {
+		return nil, err
+	}
+
+	for i, hf := range highlight.Fields {
+		cf, err := queryableField(hf)
+		if err != nil {
+			return nil, err
+		}
+		if !cf.SearchIndexed || (cf.SearchType != "string" && cf.SearchType != "string[]") {
+			return nil, errors.InvalidArgument("`%s` can't be highlighted. Only indexed text fields can be highlighted", hf)
+		}
+		if cf.InMemoryName() != cf.Name() {
+			highlight.Fields[i] = cf.InMemoryName()
+		}
+	}
+
+	return highlight, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/schema"
+)
+
+func TestUnmarshalHighlight(t *testing.T) {
+	h, err := UnmarshalHighlight(nil)
+	require.NoError(t, err)
+	require.Nil(t, h)
+	require.False(t, NewBuilder().Highlight(h).Build().IsHighlightQuery())
+
+	h, err = UnmarshalHighlight([]byte(`{"fields": ["title", "body"], "snippet_length": 5, "pre_tag": "<b>", "post_tag": "</b>"}`))
+	require.NoError(t, err)
+	require.Equal(t, &Highlight{
+		Fields:        []string{"title", "body"},
+		SnippetLength: 5,
+		PreTag:        "<b>",
+		PostTag:       "</b>",
+	}, h)
+
+	q := NewBuilder().Highlight(h).Build()
+	require.True(t, q.IsHighlightQuery())
+	require.Equal(t, "title,body", q.ToHighlightFields())
+
+	_, err = UnmarshalHighlight([]byte(`{"snippet_length": -1}`))
+	require.Error(t, err)
+	_, err = UnmarshalHighlight([]byte(`{"fields": "title"}`))
+	require.Error(t, err)
+}
+
+func TestBuildHighlight(t *testing.T) {
+	fields := map[string]*schema.QueryableField{
+		"title": {FieldName: "title", InMemoryAlias: "title", SearchIndexed: true, SearchType: "string"},
+		"id":    {FieldName: "id", InMemoryAlias: "_tigris_id", SearchIndexed: true, SearchType: "string"},
+		"price": {FieldName: "price", InMemoryAlias: "price", SearchIndexed: true, SearchType: "float"},
+	}
+	queryableField := func(name string) (*schema.QueryableField, error) {
+		if f, ok := fields[name]; ok {
+			return f, nil
+		}
+		return nil, errors.InvalidArgument("field '%s' is not present in collection", name)
+	}
+
+	h, err := BuildHighlight(nil, queryableField)
+	require.NoError(t, err)
+	require.Nil(t, h)
+
+	h, err = BuildHighlight([]byte(`{"fields": ["title", "id"]}`), queryableField)
+	require.NoError(t, err)
+	require.Equal(t, []string{"title", "_tigris_id"}, h.Fields)
+
+	_, err = BuildHighlight([]byte(`{"fields": ["price"]}`), queryableField)
+	require.Error(t, err)
+	_, err = BuildHighlight([]byte(`{"fields": ["missing"]}`), queryableField)
+	require.Error(t, err)
+}
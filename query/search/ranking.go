@@ -0,0 +1,185 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tigrisdata/tigris/errors"
+)
+
+const (
+	textMatchField = "_text_match"
+	boostSeparator = "^"
+	// defaultDecay is the score at the scale distance from the origin.
+	defaultDecay = 0.5
+)
+
+// Ranking customizes how the hits of the text search are ranked on top of the text match score.
+type Ranking struct {
+	// Weights are the relative weights of the search fields, in the same order as the search fields.
+	Weights []int
+	// Buckets divides the hits by their text match score, the rules then rank the hits of a bucket.
+	Buckets int
+	// Rules are applied in order after the text match score.
+	Rules []RankingRule
+	// Pinned are the ids of the documents placed at the top of the results in the same order.
+	Pinned []string
+	// Hidden are the ids of the documents excluded from the results.
+	Hidden []string
+}
+
+// RankingRule ranks the hits by a numeric field, or by the decay score of the field when the decay is set.
+type RankingRule struct {
+	Field     string
+	Ascending bool
+	Decay     *Decay
+}
+
+// Decay scores a value by its distance from the origin, the score is 1 at the origin and "Decay" at the "Scale"
+// distance from it.
+type Decay struct {
+	Function string
+	Origin   float64
+	Scale    float64
+	Decay    float64
+}
+
+// Score returns the decay score of the value, the functions are the same as the decay functions of Elasticsearch.
+func (d *Decay) Score(value float64) float64 {
+	decay := d.Decay
+	if decay <= 0 {
+		decay = defaultDecay
+	}
+	distance := math.Abs(value-d.Origin) / d.Scale
+
+	switch d.Function {
+	case "linear":
+		return math.Max(0, 1-(1-decay)*distance)
+	case "exp":
+		return math.Pow(decay, distance)
+	default:
+		return math.Pow(decay, distance*distance)
+	}
+}
+
+// HasWeights returns true if any of the search fields has a weight other than the default.
+func (r *Ranking) HasWeights() bool {
+	if r == nil {
+		return false
+	}
+	for _, w := range r.Weights {
+		if w != 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRules returns true if the text match score is followed by the ranking rules.
+func (r *Ranking) HasRules() bool {
+	return r != nil && len(r.Rules) > 0
+}
+
+// HasDecay returns true if any of the rules is ranking by the decay score. The decay is computed by Tigris as the
+// search backend can only sort on the indexed values, so only the first candidates ranked by the text match are
+// re-ranked by the decay and the pages past them can't be served.
+func (r *Ranking) HasDecay() bool {
+	if r == nil {
+		return false
+	}
+	for _, rule := range r.Rules {
+		if rule.Decay != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseFieldBoost parses the search field in the "field^weight" form, the weight is 1 without the boost.
+func ParseFieldBoost(field string) (string, int, error) {
+	name, boost, found := strings.Cut(field, boostSeparator)
+	if !found {
+		return field, 1, nil
+	}
+
+	weight, err := strconv.Atoi(boost)
+	if err != nil || weight <= 0 {
+		return "", 0, errors.InvalidArgument("boost of the search field '%s' should be a positive integer", name)
+	}
+	return name, weight, nil
+}
+
+func (q *Query) ToSearchFieldWeights() string {
+	if !q.Ranking.HasWeights() || len(q.Ranking.Weights) != len(q.SearchFields) {
+		return ""
+	}
+
+	var weights string
+	for i, w := range q.Ranking.Weights {
+		if i != 0 {
+			weights += ","
+		}
+		weights += strconv.Itoa(w)
+	}
+	return weights
+}
+
+// ToRankingSortFields returns the sort of the ranking rules after the text match score. The rules with the decay can't
+// be pushed down to the search backend.
+func (q *Query) ToRankingSortFields() string {
+	if !q.Ranking.HasRules() || q.Ranking.HasDecay() {
+		return ""
+	}
+
+	sortBy := textMatchField
+	if q.Ranking.Buckets > 0 {
+		sortBy = fmt.Sprintf("%s(buckets: %d)", textMatchField, q.Ranking.Buckets)
+	}
+	sortBy += ":desc"
+	for _, rule := range q.Ranking.Rules {
+		order := "desc"
+		if rule.Ascending {
+			order = "asc"
+		}
+		sortBy += fmt.Sprintf(",%s(missing_values: last):%s", rule.Field, order)
+	}
+	return sortBy
+}
+
+func (q *Query) ToPinnedHits() string {
+	if q.Ranking == nil {
+		return ""
+	}
+
+	var pinned string
+	for i, id := range q.Ranking.Pinned {
+		if i != 0 {
+			pinned += ","
+		}
+		pinned += fmt.Sprintf("%s:%d", id, i+1)
+	}
+	return pinned
+}
+
+func (q *Query) ToHiddenHits() string {
+	if q.Ranking == nil {
+		return ""
+	}
+	return strings.Join(q.Ranking.Hidden, ",")
+}
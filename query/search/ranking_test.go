@@ -0,0 +1,85 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFieldBoost(t *testing.T) {
+	field, weight, err := ParseFieldBoost("title^3")
+	require.NoError(t, err)
+	require.Equal(t, "title", field)
+	require.Equal(t, 3, weight)
+
+	field, weight, err = ParseFieldBoost("body")
+	require.NoError(t, err)
+	require.Equal(t, "body", field)
+	require.Equal(t, 1, weight)
+
+	_, _, err = ParseFieldBoost("title^0")
+	require.Error(t, err)
+	_, _, err = ParseFieldBoost("title^high")
+	require.Error(t, err)
+}
+
+func TestDecayScore(t *testing.T) {
+	for _, fn := range []string{"gauss", "linear", "exp"} {
+		d := &Decay{Function: fn, Origin: 100, Scale: 10, Decay: 0.5}
+		require.Equal(t, 1.0, d.Score(100), fn)
+		require.InDelta(t, 0.5, d.Score(110), 1e-9, fn)
+		require.InDelta(t, 0.5, d.Score(90), 1e-9, fn)
+		require.Less(t, d.Score(120), d.Score(110), fn)
+	}
+
+	// the default decay is applied when not set
+	require.InDelta(t, 0.5, (&Decay{Scale: 1}).Score(1), 1e-9)
+	require.Equal(t, 0.0, (&Decay{Function: "linear", Scale: 1, Decay: 0.5}).Score(3))
+}
+
+func TestRankingSearchParams(t *testing.T) {
+	q := NewBuilder().
+		SearchFields([]string{"title", "body"}).
+		Ranking(&Ranking{
+			Weights: []int{3, 1},
+			Buckets: 5,
+			Rules:   []RankingRule{{Field: "popularity"}, {Field: "price", Ascending: true}},
+			Pinned:  []string{"a", "b"},
+			Hidden:  []string{"c", "d"},
+		}).
+		Build()
+
+	require.Equal(t, "3,1", q.ToSearchFieldWeights())
+	require.Equal(t, "_text_match(buckets: 5):desc,popularity(missing_values: last):desc,price(missing_values: last):asc", q.ToRankingSortFields())
+	require.Equal(t, "a:1,b:2", q.ToPinnedHits())
+	require.Equal(t, "c,d", q.ToHiddenHits())
+
+	// the decay is not pushed down
+	q.Ranking.Rules = []RankingRule{{Field: "created_at", Decay: &Decay{Scale: 10}}}
+	require.True(t, q.Ranking.HasDecay())
+	require.Empty(t, q.ToRankingSortFields())
+
+	// default weights are not sent
+	q.Ranking.Weights = []int{1, 1}
+	require.Empty(t, q.ToSearchFieldWeights())
+
+	q = NewBuilder().Build()
+	require.Empty(t, q.ToSearchFieldWeights())
+	require.Empty(t, q.ToRankingSortFields())
+	require.Empty(t, q.ToPinnedHits())
+	require.Empty(t, q.ToHiddenHits())
+}
@@ -36,6 +36,9 @@ type Query struct {
 	SortOrder    *sort.Ordering
 	GroupBy      GroupBy
 	VectorS      VectorSearch
+	Highlight    *Highlight
+	Typo         *TypoTolerance
+	Ranking      *Ranking
 }
 
 func (q *Query) ToSearchFacetSize() int {
@@ -99,6 +102,10 @@ func (q *Query) ToSortFields() string {
 			order = "asc"
 		}
 
+		if f.Near != nil {
+			sortBy += fmt.Sprintf("%s(%v, %v):%s", f.Name, f.Near.Lat, f.Near.Lng, order)
+			continue
+		}
 		sortBy += fmt.Sprintf("%s(missing_values: %s):%s", f.Name, missingValue, order)
 	}
 	return sortBy
@@ -131,6 +138,25 @@ func (q *Query) ToSearchVector() string {
 	return fmt.Sprintf("%s:(%s)", q.VectorS.VectorF, string(q.VectorS.RawVectorV))
 }
 
+func (q *Query) ToHighlightFields() string {
+	if q.Highlight == nil {
+		return ""
+	}
+
+	var fields string
+	for i, f := range q.Highlight.Fields {
+		if i != 0 {
+			fields += ","
+		}
+		fields += f
+	}
+	return fields
+}
+
+func (q *Query) IsHighlightQuery() bool {
+	return q.Highlight != nil
+}
+
 func (q *Query) IsGroupByQuery() bool {
 	return len(q.GroupBy.Fields) > 0
 }
@@ -139,10 +165,25 @@ func (q *Query) IsVectorSearch() bool {
 	return len(q.VectorS.VectorF) > 0
 }
 
+// IsQAndVectorBoth returns true for a hybrid search, the text and the vector ranking are fused.
 func (q *Query) IsQAndVectorBoth() bool {
 	return len(q.VectorS.VectorF) > 0 && len(q.Q) > 0 && q.Q != all
 }
 
+// TextQuery returns the copy of the query without the vector search.
+func (q *Query) TextQuery() *Query {
+	text := *q
+	text.VectorS = VectorSearch{}
+	return &text
+}
+
+// VectorQuery returns the copy of the query without the text search.
+func (q *Query) VectorQuery() *Query {
+	vector := *q
+	vector.Q = all
+	return &vector
+}
+
 type Builder struct {
 	query *Query
 }
@@ -200,6 +241,21 @@ func (b *Builder) VectorSearch(vecSearch VectorSearch) *Builder {
 	return b
 }
 
+func (b *Builder) Highlight(h *Highlight) *Builder {
+	b.query.Highlight = h
+	return b
+}
+
+func (b *Builder) Typo(t *TypoTolerance) *Builder {
+	b.query.Typo = t
+	return b
+}
+
+func (b *Builder) Ranking(r *Ranking) *Builder {
+	b.query.Ranking = r
+	return b
+}
+
 func (b *Builder) PageSize(s int) *Builder {
 	b.query.PageSize = s
 	return b
@@ -19,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/lib/geo"
 	"github.com/tigrisdata/tigris/query/filter"
 	"github.com/tigrisdata/tigris/query/sort"
 	"github.com/tigrisdata/tigris/schema"
@@ -77,4 +78,13 @@ func TestQuery_ToSortFields(t *testing.T) {
 		sortBy := q.ToSortFields()
 		assert.Equal(t, expected, sortBy)
 	})
+
+	t.Run("with sort by distance", func(t *testing.T) {
+		ordering := &sort.Ordering{
+			{Name: "location", Ascending: true, Near: &geo.Point{Lat: 37.7749, Lng: -122.4194}},
+			{Name: "field_1"},
+		}
+		q := NewBuilder().SortOrder(ordering).Build()
+		assert.Equal(t, "location(37.7749, -122.4194):asc,field_1(missing_values: last):desc", q.ToSortFields())
+	})
 }
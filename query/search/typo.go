@@ -0,0 +1,58 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TypoTolerance controls how the query words are matched, unset values fall back to the search backend defaults.
+type TypoTolerance struct {
+	// NumTypos is the maximum number of typos tolerated in a query word.
+	NumTypos *int
+	// Prefix is to match the last word of the query as a prefix.
+	Prefix *bool
+	// MinLen1Typo is the minimum length of a query word to tolerate one typo.
+	MinLen1Typo *int
+	// MinLen2Typo is the minimum length of a query word to tolerate two typos.
+	MinLen2Typo *int
+}
+
+// RemoveStopWords drops the stop words from the query, the comparison is case-insensitive. If the query only has stop
+// words then it is converted to a match all query.
+func RemoveStopWords(q string, stopWords []string) string {
+	if len(stopWords) == 0 || q == all {
+		return q
+	}
+
+	stop := make(map[string]struct{}, len(stopWords))
+	for _, w := range stopWords {
+		stop[strings.ToLower(w)] = struct{}{}
+	}
+
+	words := strings.FieldsFunc(q, unicode.IsSpace)
+	filtered := words[:0]
+	for _, w := range words {
+		if _, ok := stop[strings.ToLower(w)]; !ok {
+			filtered = append(filtered, w)
+		}
+	}
+	if len(filtered) == 0 {
+		return all
+	}
+
+	return strings.Join(filtered, " ")
+}
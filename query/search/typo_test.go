@@ -0,0 +1,39 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveStopWords(t *testing.T) {
+	stopWords := []string{"the", "A", "of"}
+
+	require.Equal(t, "quick fox", RemoveStopWords("the quick fox", stopWords))
+	require.Equal(t, "Lord Rings", RemoveStopWords("The  Lord of the Rings", stopWords))
+	require.Equal(t, "*", RemoveStopWords("the a of", stopWords))
+	require.Equal(t, "*", RemoveStopWords("*", stopWords))
+	require.Equal(t, "the quick fox", RemoveStopWords("the quick fox", nil))
+}
+
+func TestBuilderTypo(t *testing.T) {
+	typos, prefix := 1, false
+	q := NewBuilder().Typo(&TypoTolerance{NumTypos: &typos, Prefix: &prefix}).Build()
+	require.Equal(t, 1, *q.Typo.NumTypos)
+	require.False(t, *q.Typo.Prefix)
+	require.Nil(t, NewBuilder().Build().Typo)
+}
@@ -19,19 +19,87 @@ import (
 
 	"github.com/buger/jsonparser"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/tigrisdata/tigris/errors"
 )
 
 const (
-	topKJSTag = "top_k"
+	topKJSTag              = "top_k"
+	distanceThresholdJSTag = "distance_threshold"
+	fusionJSTag            = "fusion"
+)
+
+// The strategies to combine the text and the vector ranking of a hybrid search.
+const (
+	// FusionRRF is the reciprocal rank fusion, a hit scores 1/(k+rank) in each ranking it appears in.
+	FusionRRF = "rrf"
+	// FusionAlpha is the weighted fusion of the normalized scores, alpha is the weight of the vector score.
+	FusionAlpha = "alpha"
+
+	defaultRRFConstant = 60
+	defaultFusionAlpha = 0.5
 )
 
 type VectorSearch struct {
-	TopK       int `json:"top_k,omitempty"`
+	TopK int `json:"top_k,omitempty"`
+	// DistanceThreshold drops the hits farther than the threshold.
+	DistanceThreshold *float64
+	// Fusion is how the text and the vector ranking are combined when the search has both.
+	Fusion *Fusion
+	// Metric is the distance metric of the vector field, it is set from the schema.
+	Metric     string
 	VectorF    string
 	VectorV    []float64
 	RawVectorV []byte
 }
 
+// Fusion is the rank fusion of a hybrid search.
+type Fusion struct {
+	Method string `json:"method,omitempty"`
+	// K is the ranking constant of the reciprocal rank fusion.
+	K int `json:"k,omitempty"`
+	// Alpha is the weight of the vector score in the alpha fusion, the text score weighs 1-alpha.
+	Alpha *float64 `json:"alpha,omitempty"`
+}
+
+// GetMethod returns the fusion method, reciprocal rank fusion by default.
+func (f *Fusion) GetMethod() string {
+	if f == nil || len(f.Method) == 0 {
+		return FusionRRF
+	}
+	return f.Method
+}
+
+func (f *Fusion) GetK() int {
+	if f == nil || f.K == 0 {
+		return defaultRRFConstant
+	}
+	return f.K
+}
+
+func (f *Fusion) GetAlpha() float64 {
+	if f == nil || f.Alpha == nil {
+		return defaultFusionAlpha
+	}
+	return *f.Alpha
+}
+
+func (f *Fusion) Validate() error {
+	switch f.GetMethod() {
+	case FusionRRF:
+		if f.K < 0 {
+			return errors.InvalidArgument("fusion k can't be negative")
+		}
+	case FusionAlpha:
+		if alpha := f.GetAlpha(); alpha < 0 || alpha > 1 {
+			return errors.InvalidArgument("fusion alpha should be between 0 and 1")
+		}
+	default:
+		return errors.InvalidArgument("unsupported fusion method '%s', supported are '%s' and '%s'", f.Method, FusionRRF, FusionAlpha)
+	}
+
+	return nil
+}
+
 func UnmarshalVectorSearch(input jsoniter.RawMessage) (VectorSearch, error) {
 	if len(input) == 0 {
 		return VectorSearch{}, nil
@@ -44,13 +112,32 @@ func UnmarshalVectorSearch(input jsoniter.RawMessage) (VectorSearch, error) {
 			return err
 		}
 
-		if string(k) == topKJSTag {
+		switch string(k) {
+		case topKJSTag:
 			var val int64
 			if val, err = strconv.ParseInt(string(v), 10, 32); err != nil {
 				return err
 			}
 			g.TopK = int(val)
-		} else {
+		case distanceThresholdJSTag:
+			var val float64
+			if val, err = strconv.ParseFloat(string(v), 64); err != nil {
+				return err
+			}
+			if val < 0 {
+				return errors.InvalidArgument("distance_threshold can't be negative")
+			}
+			g.DistanceThreshold = &val
+		case fusionJSTag:
+			var f Fusion
+			if err = jsoniter.Unmarshal(v, &f); err != nil {
+				return err
+			}
+			if err = f.Validate(); err != nil {
+				return err
+			}
+			g.Fusion = &f
+		default:
 			if err = jsoniter.Unmarshal(v, &g.VectorV); err != nil {
 				return err
 			}
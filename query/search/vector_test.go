@@ -33,4 +33,22 @@ func TestUnmarshalVectorSearch(t *testing.T) {
 	vs, err = UnmarshalVectorSearch([]byte(`{"vec": ["a", "b"]}`))
 	require.Error(t, err)
 	require.Empty(t, vs.VectorF)
+
+	vs, err = UnmarshalVectorSearch([]byte(`{"vec": [1, 2], "distance_threshold": 0.4, "fusion": {"method": "alpha", "alpha": 0.7}}`))
+	require.NoError(t, err)
+	require.Equal(t, 0.4, *vs.DistanceThreshold)
+	require.Equal(t, FusionAlpha, vs.Fusion.GetMethod())
+	require.Equal(t, 0.7, vs.Fusion.GetAlpha())
+
+	vs, err = UnmarshalVectorSearch([]byte(`{"vec": [1, 2], "fusion": {}}`))
+	require.NoError(t, err)
+	require.Equal(t, FusionRRF, vs.Fusion.GetMethod())
+	require.Equal(t, 60, vs.Fusion.GetK())
+
+	_, err = UnmarshalVectorSearch([]byte(`{"vec": [1, 2], "fusion": {"method": "alpha", "alpha": 1.5}}`))
+	require.ErrorContains(t, err, "fusion alpha should be between 0 and 1")
+	_, err = UnmarshalVectorSearch([]byte(`{"vec": [1, 2], "fusion": {"method": "max"}}`))
+	require.ErrorContains(t, err, "unsupported fusion method 'max'")
+	_, err = UnmarshalVectorSearch([]byte(`{"vec": [1, 2], "distance_threshold": -1}`))
+	require.ErrorContains(t, err, "distance_threshold can't be negative")
 }
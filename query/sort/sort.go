@@ -18,6 +18,7 @@ import (
 	"github.com/buger/jsonparser"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/geo"
 )
 
 // TODO: Update this to 3 once https://github.com/typesense/typesense/issues/690 is resolved.
@@ -26,6 +27,10 @@ const maxSortOrders = 2
 const (
 	ASC  = "$asc"
 	DESC = "$desc"
+
+	// NEAR sorts a geopoint field by the distance from a point.
+	NEAR  = "$near"
+	ORDER = "$order"
 )
 
 type Ordering = []SortField
@@ -38,18 +43,27 @@ type SortField struct {
 	// Optional; True if missing/empty/null values to be presented at the top of sort order,
 	// else they are sorted to the end by default
 	MissingValuesFirst bool
+	// Optional; Set when a geopoint field is sorted by the distance from this point
+	Near *geo.Point
 }
 
 func newSortField(order jsoniter.RawMessage) (SortField, error) {
 	var s SortField
 	err := jsonparser.ObjectEach(order, func(k []byte, v []byte, vt jsonparser.ValueType, offset int) error {
-		switch string(v) {
-		case ASC:
-			s.Ascending = true
-		case DESC:
-			s.Ascending = false
-		default:
-			return errors.InvalidArgument("Sort order can only be `%s` or `%s`", ASC, DESC)
+		if vt == jsonparser.Object {
+			if err := s.parseNear(v); err != nil {
+				return err
+			}
+		} else {
+			switch string(v) {
+			case ASC:
+				s.Ascending = true
+			case DESC:
+				s.Ascending = false
+			default:
+				return errors.InvalidArgument("Sort order can only be `%s` or `%s`", ASC, DESC)
+			}
+			s.Near = nil
 		}
 		s.Name = string(k)
 		s.MissingValuesFirst = false // Forcing empty/null/missing values to the end
@@ -61,6 +75,32 @@ func newSortField(order jsoniter.RawMessage) (SortField, error) {
 	return s, nil
 }
 
+// parseNear parses the sort by distance i.e. {"$near": [lat, lng], "$order": "$desc"}, the nearest points are first
+// unless the order is descending.
+func (s *SortField) parseNear(v []byte) error {
+	raw, dt, _, _ := jsonparser.Get(v, NEAR)
+	if dt != jsonparser.Array {
+		return errors.InvalidArgument("Sort by distance requires `%s` as [lat, lng]", NEAR)
+	}
+	p, err := geo.ParsePointJSON(raw)
+	if err != nil {
+		return errors.InvalidArgument("Invalid `%s` point: %s", NEAR, err.Error())
+	}
+
+	s.Near = &p
+	s.Ascending = true
+	if order, err := jsonparser.GetString(v, ORDER); err == nil {
+		switch order {
+		case ASC:
+		case DESC:
+			s.Ascending = false
+		default:
+			return errors.InvalidArgument("Sort order can only be `%s` or `%s`", ASC, DESC)
+		}
+	}
+	return nil
+}
+
 // UnmarshalSort expects a json array input. Examples:
 //
 //	[{"field_1": "$asc"}, {"field_2": "$desc"}]
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tigrisdata/tigris/lib/geo"
 )
 
 func TestUnmarshalSort(t *testing.T) {
@@ -107,6 +108,23 @@ func TestUnmarshalSort(t *testing.T) {
 		assert.Nil(t, sort)
 	})
 
+	t.Run("with sort by distance", func(t *testing.T) {
+		sort, err := UnmarshalSort([]byte(`[{"location":{"$near":[37.7749,-122.4194]}},{"location":{"$near":[0,0],"$order":"$desc"}}]`))
+		assert.NoError(t, err)
+		assert.Len(t, *sort, 2)
+		assert.Equal(t, SortField{Name: "location", Ascending: true, Near: &geo.Point{Lat: 37.7749, Lng: -122.4194}}, (*sort)[0])
+		assert.Equal(t, SortField{Name: "location", Ascending: false, Near: &geo.Point{}}, (*sort)[1])
+
+		_, err = UnmarshalSort([]byte(`[{"location":{"$near":[137.7749,-122.4194]}}]`))
+		assert.ErrorContains(t, err, "Invalid `$near` point")
+
+		_, err = UnmarshalSort([]byte(`[{"location":{"$near":[0,0],"$order":"asc"}}]`))
+		assert.ErrorContains(t, err, "Sort order can only be `$asc` or `$desc`")
+
+		_, err = UnmarshalSort([]byte(`[{"location":{"$order":"$asc"}}]`))
+		assert.ErrorContains(t, err, "Sort by distance requires `$near`")
+	})
+
 	t.Run("with object instead of array", func(t *testing.T) {
 		sort, err := UnmarshalSort([]byte(`{"field_1":"$asc"}`))
 		assert.ErrorContains(t, err, "Invalid value for `sort`")
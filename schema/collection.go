@@ -21,10 +21,13 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/santhosh-tekuri/jsonschema/v5"
+	api "github.com/tigrisdata/tigris/api/server/v1"
 	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/geo"
 	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
 )
 
@@ -45,6 +48,9 @@ type DefaultCollection struct {
 	EncodedName []byte
 	// EncodedTableIndexName is the encoded name of the collection's Secondary Index.
 	EncodedTableIndexName []byte
+	// HistoryVersion is the version since which the changes of the collection are captured in the CDC history, zero
+	// if the history doesn't cover the collection.
+	HistoryVersion int64
 	// Fields are derived from the user schema.
 	Fields []*Field
 	// Indexes is a wrapper on the indexes part of this collection.
@@ -309,7 +315,8 @@ func (d *DefaultCollection) Validate(document any) error {
 			if len(field) > 0 && field[0] == '/' {
 				field = field[1:]
 			}
-			return errors.InvalidArgument("json schema validation failed for field '%s' reason '%s'", field, v.Causes[0].Message)
+			return api.Errorf(api.Code_INVALID_ARGUMENT, "json schema validation failed for field '%s' reason '%s'",
+				field, v.Causes[0].Message).WithField(strings.ReplaceAll(field, "/", ObjFlattenDelimiter))
 		}
 	}
 
@@ -391,6 +398,14 @@ func init() {
 		_, err := parseInt(i)
 		return err == nil
 	}
+	jsonschema.Formats[FieldNames[GeoPointType]] = func(i any) bool {
+		if i == nil {
+			return true
+		}
+
+		_, err := geo.ParsePoint(i)
+		return err == nil
+	}
 }
 
 func parseInt(i any) (int64, error) {
@@ -21,6 +21,7 @@ import (
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/require"
+	api "github.com/tigrisdata/tigris/api/server/v1"
 	"github.com/tigrisdata/tigris/lib/uuid"
 )
 
@@ -280,18 +281,22 @@ func TestCollection_AdditionalProperties(t *testing.T) {
 	cases := []struct {
 		document []byte
 		expError string
+		expField string
 	}{
 		{
 			document: []byte(`{"id": 1, "simple_object": {"name": "hello", "price": 1.01}}`),
 			expError: "json schema validation failed for field 'simple_object' reason 'additionalProperties 'price' not allowed'",
+			expField: "simple_object",
 		},
 		{
 			document: []byte(`{"id": 1, "complex_object": {"name": "hello", "price": 1.01}}`),
 			expError: "json schema validation failed for field 'complex_object' reason 'additionalProperties 'price' not allowed'",
+			expField: "complex_object",
 		},
 		{
 			document: []byte(`{"id": 1, "complex_object": {"name": "hello", "obj": {"name": "hello", "price": 1.01}}}`),
 			expError: "json schema validation failed for field 'complex_object/obj' reason 'additionalProperties 'price' not allowed'",
+			expField: "complex_object.obj",
 		},
 		{
 			document: []byte(`{"id": 1, "map": {"name": "hello", "some_prop" : "value1"}}`),
@@ -310,11 +315,14 @@ func TestCollection_AdditionalProperties(t *testing.T) {
 		var v any
 		require.NoError(t, dec.Decode(&v))
 
-		var resMsg string
+		var resMsg, resField string
 		if err = coll.Validate(v); err != nil {
 			resMsg = err.Error()
+			//nolint:errorlint
+			resField = err.(*api.TigrisError).Field()
 		}
 		require.Equal(t, c.expError, resMsg)
+		require.Equal(t, c.expField, resField)
 	}
 }
 
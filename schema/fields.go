@@ -49,6 +49,8 @@ const (
 	ArrayType
 	ObjectType
 	VectorType
+	// GeoPointType is a location represented as [lat, lng] in the documents.
+	GeoPointType
 	// For internal querying usage.
 	MaxType
 )
@@ -67,6 +69,20 @@ var FieldNames = [...]string{
 	ArrayType:    "array",
 	ObjectType:   "object",
 	VectorType:   "vector",
+	GeoPointType: "geopoint",
+}
+
+// The distance metrics supported on the vector fields. The search service only ranks by the cosine distance, the
+// vector searches on the other metrics re-rank the nearest candidates by the cosine distance, so they are
+// approximate and limited to the first candidates.
+const (
+	CosineDistance = "cosine"
+	DotDistance    = "dot"
+	L2Distance     = "l2"
+)
+
+func IsSupportedDistance(distance string) bool {
+	return distance == CosineDistance || distance == DotDistance || distance == L2Distance
 }
 
 var (
@@ -93,6 +109,7 @@ const (
 	jsonSpecFormatInt32    = "int32"
 	jsonSpecFormatInt64    = "int64"
 	jsonSpecFormatVector   = "vector"
+	jsonSpecFormatGeoPoint = "geopoint"
 )
 
 func ToFieldType(jsonType string, encoding string, format string) FieldType {
@@ -144,8 +161,11 @@ func ToFieldType(jsonType string, encoding string, format string) FieldType {
 
 		return StringType
 	case jsonSpecArray:
-		if format == jsonSpecFormatVector {
+		switch format {
+		case jsonSpecFormatVector:
 			return VectorType
+		case jsonSpecFormatGeoPoint:
+			return GeoPointType
 		}
 		return ArrayType
 	case jsonSpecObject:
@@ -174,7 +194,7 @@ func IsPrimitiveType(fieldType FieldType) bool {
 
 func SupportedIndexableType(fieldType FieldType) bool {
 	switch fieldType {
-	case BoolType, Int32Type, Int64Type, UUIDType, StringType, DateTimeType, DoubleType:
+	case BoolType, Int32Type, Int64Type, UUIDType, StringType, DateTimeType, DoubleType, GeoPointType:
 		return true
 	default:
 		return false
@@ -183,7 +203,7 @@ func SupportedIndexableType(fieldType FieldType) bool {
 
 func SupportedSearchIndexableType(fieldType FieldType, subType FieldType) bool {
 	switch fieldType {
-	case BoolType, Int32Type, Int64Type, UUIDType, StringType, DateTimeType, DoubleType, ArrayType, VectorType:
+	case BoolType, Int32Type, Int64Type, UUIDType, StringType, DateTimeType, DoubleType, ArrayType, VectorType, GeoPointType:
 		return true
 	case ObjectType:
 		return subType == UnknownType
@@ -239,6 +259,8 @@ func toSearchFieldType(fieldType FieldType, subType FieldType) string {
 		return FieldNames[ObjectType]
 	case VectorType:
 		return searchDoubleType + "[]"
+	case GeoPointType:
+		return FieldNames[GeoPointType]
 	case ArrayType:
 		switch subType {
 		case BoolType:
@@ -286,6 +308,7 @@ var SupportedFieldProperties = container.NewHashSet(
 	"maxItems",
 	"additionalProperties",
 	"dimensions",
+	"distance",
 	"id",
 )
 
@@ -492,6 +515,7 @@ type FieldBuilder struct {
 	ID                   *bool               `json:"id,omitempty"`
 	SearchIndex          *bool               `json:"searchIndex,omitempty"`
 	Dimensions           *int                `json:"dimensions,omitempty"`
+	Distance             *string             `json:"distance,omitempty"`
 	Items                *FieldBuilder       `json:"items,omitempty"`
 	Properties           jsoniter.RawMessage `json:"properties,omitempty"`
 	Primary              *bool
@@ -536,6 +560,7 @@ func (f *FieldBuilder) Build(setSearchDefaults bool) (*Field, error) {
 		PrimaryKeyField:      f.Primary,
 		AutoGenerated:        f.Auto,
 		Dimensions:           f.Dimensions,
+		Distance:             f.Distance,
 		AdditionalProperties: f.AdditionalProperties,
 		SearchIdField:        f.ID,
 	}
@@ -571,6 +596,8 @@ type Field struct {
 	SearchIndexed   *bool
 	SearchIdField   *bool
 	Dimensions      *int
+	// Distance is the metric used to compare the vectors of a vector field.
+	Distance *string
 	// Nested fields are the fields where we know the schema of nested attributes like if properties are
 	Fields               []*Field
 	AdditionalProperties *bool
@@ -663,6 +690,14 @@ func (f *Field) GetDimensions() int {
 	return 0
 }
 
+// GetDistance returns the distance metric of a vector field, cosine by default.
+func (f *Field) GetDistance() string {
+	if f.Distance != nil {
+		return *f.Distance
+	}
+	return CosineDistance
+}
+
 func GetField(fields []*Field, name string) *Field {
 	for _, r := range fields {
 		if r.FieldName == name {
@@ -32,6 +32,8 @@ func TestFieldBuilder_Build(t *testing.T) {
 		require.Equal(t, UUIDType, ToFieldType("string", "", jsonSpecFormatUUID))
 		require.Equal(t, DateTimeType, ToFieldType("string", "", jsonSpecFormatDateTime))
 		require.Equal(t, UnknownType, ToFieldType("string", "random", ""))
+		require.Equal(t, VectorType, ToFieldType("array", "", jsonSpecFormatVector))
+		require.Equal(t, GeoPointType, ToFieldType("array", "", jsonSpecFormatGeoPoint))
 	})
 	t.Run("test supported types", func(t *testing.T) {
 		cases := []struct {
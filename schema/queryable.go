@@ -38,6 +38,7 @@ type QueryableField struct {
 	packThis       bool
 	DoNotFlatten   bool
 	Dimensions     *int
+	Distance       string
 	SearchIdField  bool
 	// This is not stored in flattened form in search
 	// but will allow filtering on array of objects.
@@ -148,6 +149,9 @@ func (*QueryableFieldsBuilder) NewQueryableField(name string, f *Field, fieldsIn
 		Dimensions:     f.Dimensions,
 		UnFlattenName:  f.Name(),
 	}
+	if f.DataType == VectorType {
+		q.Distance = f.GetDistance()
+	}
 	if !packThis && f.DataType == ArrayType && len(f.Fields) > 0 && f.Fields[0].DataType == ObjectType {
 		// An array of objects stored in search, we need to allow filtering on nested fields inside this object
 		// but we are not flattening this array so we are just filling the parent with nested fields.
@@ -420,6 +420,12 @@ func validateFieldAttribute(f *Field, notSupported bool) error {
 		if f.IsIndexed() || f.IsFaceted() || f.IsSorted() {
 			return errors.InvalidArgument("only search index attribute is supported on vector field '%s'", f.FieldName)
 		}
+		if f.Distance != nil && !IsSupportedDistance(*f.Distance) {
+			return errors.InvalidArgument("unsupported distance '%s' on vector field '%s', supported are '%s', '%s' and '%s'",
+				*f.Distance, f.FieldName, CosineDistance, DotDistance, L2Distance)
+		}
+	} else if f.Distance != nil {
+		return errors.InvalidArgument("distance is only supported on vector field, found on field '%s'", f.FieldName)
 	}
 	if f.IsIndexed() && !f.IsIndexable() {
 		return errors.InvalidArgument("Cannot enable index on field '%s' of type '%s'. Only top level non-byte fields can be indexed.", f.FieldName, FieldNames[f.DataType])
@@ -20,6 +20,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	api "github.com/tigrisdata/tigris/api/server/v1"
 	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/geo"
 	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
 )
 
@@ -46,6 +47,7 @@ type SearchJSONSchema struct {
 	Description string              `json:"description,omitempty"`
 	Properties  jsoniter.RawMessage `json:"properties,omitempty"`
 	Source      *SearchSource       `json:"source,omitempty"`
+	Settings    *SearchSettings     `json:"settings,omitempty"`
 }
 
 // SearchFactory is used as an intermediate step so that collection can be initialized with properly encoded values.
@@ -58,6 +60,8 @@ type SearchFactory struct {
 	Schema jsoniter.RawMessage
 	Sub    string
 	Source SearchSource
+	// Settings are the relevance settings of the index.
+	Settings SearchSettings
 }
 
 func (fb *FactoryBuilder) BuildSearch(index string, reqSchema jsoniter.RawMessage) (*SearchFactory, error) {
@@ -81,6 +85,14 @@ func (fb *FactoryBuilder) BuildSearch(index string, reqSchema jsoniter.RawMessag
 		return nil, err
 	}
 
+	var settings SearchSettings
+	if schema.Settings != nil {
+		if err = schema.Settings.Validate(); err != nil {
+			return nil, err
+		}
+		settings = *schema.Settings
+	}
+
 	var source SearchSource
 	if schema.Source == nil {
 		source = SearchSource{
@@ -103,10 +115,11 @@ func (fb *FactoryBuilder) BuildSearch(index string, reqSchema jsoniter.RawMessag
 	}
 
 	factory := &SearchFactory{
-		Name:   index,
-		Fields: fields,
-		Schema: searchSchema,
-		Source: source,
+		Name:     index,
+		Fields:   fields,
+		Schema:   searchSchema,
+		Source:   source,
+		Settings: settings,
 	}
 
 	idFound := false
@@ -143,6 +156,13 @@ func (*FactoryBuilder) validateSearchSchema(factory *SearchFactory) error {
 		}
 	}
 
+	if factory.Settings.Ranking != nil {
+		queryableFields := NewQueryableFieldsBuilder().BuildQueryableFields(factory.Fields, nil)
+		if err := factory.Settings.Ranking.ValidateFields(queryableFields); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -165,6 +185,8 @@ type SearchIndex struct {
 	// Source of this index
 	Source        SearchSource
 	SearchIDField *QueryableField
+	// Settings are the relevance settings of the index.
+	Settings SearchSettings
 	// Track all the int64 paths in the collection. For example, if top level object has an int64 field then key would be
 	// obj.fieldName so that caller can easily navigate to this field.
 	int64FieldsPath *int64PathBuilder
@@ -185,6 +207,7 @@ func NewSearchIndex(ver uint32, searchStoreName string, factory *SearchFactory,
 		Fields:          factory.Fields,
 		Schema:          factory.Schema,
 		Source:          factory.Source,
+		Settings:        factory.Settings,
 		SearchIDField:   searchIdField,
 		QueryableFields: queryableFields,
 		int64FieldsPath: buildInt64Path(factory.Fields),
@@ -224,7 +247,7 @@ func (s *SearchIndex) GetQueryableField(name string) (*QueryableField, error) {
 
 func (s *SearchIndex) Validate(doc map[string]any) error {
 	for _, f := range s.QueryableFields {
-		if f.DataType == VectorType {
+		if f.DataType == VectorType || f.DataType == GeoPointType {
 			keys := f.KeyPath()
 			value, ok := doc[keys[0]]
 			if !ok {
@@ -252,7 +275,16 @@ func (s *SearchIndex) validateLow(value any, keys []string, field *Field) error
 			return s.validateLow(conv[nested.FieldName], keys[1:], nested)
 		}
 	}
-	if value == nil || field.DataType != VectorType {
+	if value == nil {
+		return nil
+	}
+	if field.DataType == GeoPointType {
+		if _, err := geo.ParsePoint(value); err != nil {
+			return errors.InvalidArgument("field '%s' of geopoint type is invalid: %s", field.FieldName, err.Error())
+		}
+		return nil
+	}
+	if field.DataType != VectorType {
 		return nil
 	}
 
@@ -0,0 +1,426 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"strconv"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tigrisdata/tigris/errors"
+)
+
+const (
+	maxNumTypos = 2
+	// maxRankingRules is the number of the rules that can follow the text match score, the search backend sorts on at
+	// most three fields.
+	maxRankingRules = 2
+)
+
+// The decay functions of a ranking rule.
+const (
+	DecayGauss  = "gauss"
+	DecayLinear = "linear"
+	DecayExp    = "exp"
+)
+
+// SearchSettings are the relevance settings of a search index. They are part of the index schema under the
+// "settings" key so that they are versioned and stored along with the schema. Except for the synonyms, all the
+// settings can be overridden in the search request.
+type SearchSettings struct {
+	// Synonyms are the synonym sets of the index.
+	Synonyms []SearchSynonym `json:"synonyms,omitempty"`
+	// StopWords are removed from the search query before it is executed.
+	StopWords []string `json:"stop_words,omitempty"`
+	// NumTypos is the maximum number of typos tolerated in a query word, 0 disables typo tolerance.
+	NumTypos *int `json:"num_typos,omitempty"`
+	// Prefix is to match the last word of the query as a prefix, enabled by default.
+	Prefix *bool `json:"prefix,omitempty"`
+	// MinLen1Typo is the minimum length of a query word to tolerate one typo.
+	MinLen1Typo *int `json:"min_len_1typo,omitempty"`
+	// MinLen2Typo is the minimum length of a query word to tolerate two typos.
+	MinLen2Typo *int `json:"min_len_2typo,omitempty"`
+	// Ranking customizes the ranking of the text search.
+	Ranking *SearchRanking `json:"ranking,omitempty"`
+}
+
+// SearchRanking customizes how the hits of the text search are ranked on top of the text match score.
+type SearchRanking struct {
+	// Weights are the relative weights of the search fields, a field without a weight has the weight 1.
+	Weights map[string]int `json:"weights,omitempty"`
+	// Buckets divides the hits in these many buckets by their text match score, so that the rules rank the hits of a
+	// bucket. Without the buckets the rules only break the ties of the same text match score.
+	Buckets int `json:"buckets,omitempty"`
+	// Rules are applied in order after the text match score.
+	Rules []SearchRankingRule `json:"rules,omitempty"`
+	// Pinned are the ids of the documents placed at the top of the results in the same order.
+	Pinned []string `json:"pinned,omitempty"`
+	// Hidden are the ids of the documents excluded from the results.
+	Hidden []string `json:"hidden,omitempty"`
+}
+
+// SearchRankingRule ranks the hits by a numeric or a datetime field. With the decay, the hits are ranked by the
+// closeness of the field to the origin instead, like the recency of a datetime field.
+type SearchRankingRule struct {
+	Field string       `json:"field"`
+	Order string       `json:"order,omitempty"`
+	Decay *SearchDecay `json:"decay,omitempty"`
+}
+
+// SearchDecay scores the field by its distance from the origin, the score is 1 at the origin and "decay" at the
+// "scale" distance. For a datetime field the origin is a RFC 3339 date, by default the time of the search, and the
+// scale is a duration like "168h". For a numeric field both are numbers.
+type SearchDecay struct {
+	Function string  `json:"function,omitempty"`
+	Origin   string  `json:"origin,omitempty"`
+	Scale    string  `json:"scale"`
+	Decay    float64 `json:"decay,omitempty"`
+}
+
+// SearchSynonym is a synonym set. Without a root all the words are synonyms of each other, with a root only the root
+// is expanded to the synonyms while searching.
+type SearchSynonym struct {
+	Id       string   `json:"id"`
+	Root     string   `json:"root,omitempty"`
+	Synonyms []string `json:"synonyms"`
+}
+
+func (s *SearchSettings) Validate() error {
+	if s.NumTypos != nil && (*s.NumTypos < 0 || *s.NumTypos > maxNumTypos) {
+		return errors.InvalidArgument("num_typos should be between 0 and %d", maxNumTypos)
+	}
+	if s.MinLen1Typo != nil && *s.MinLen1Typo < 0 {
+		return errors.InvalidArgument("min_len_1typo can't be negative")
+	}
+	if s.MinLen2Typo != nil && *s.MinLen2Typo < 0 {
+		return errors.InvalidArgument("min_len_2typo can't be negative")
+	}
+	if s.MinLen1Typo != nil && s.MinLen2Typo != nil && *s.MinLen1Typo > *s.MinLen2Typo {
+		return errors.InvalidArgument("min_len_1typo can't be greater than min_len_2typo")
+	}
+	for _, w := range s.StopWords {
+		if len(w) == 0 {
+			return errors.InvalidArgument("stop words can't be empty")
+		}
+	}
+
+	if s.Ranking != nil {
+		if err := s.Ranking.Validate(); err != nil {
+			return err
+		}
+	}
+
+	ids := make(map[string]struct{}, len(s.Synonyms))
+	for i := range s.Synonyms {
+		if err := s.Synonyms[i].Validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[s.Synonyms[i].Id]; ok {
+			return errors.InvalidArgument("duplicate synonym set '%s'", s.Synonyms[i].Id)
+		}
+		ids[s.Synonyms[i].Id] = struct{}{}
+	}
+
+	return nil
+}
+
+func (s *SearchSynonym) Validate() error {
+	if len(s.Id) == 0 {
+		return errors.InvalidArgument("synonym set id is required")
+	}
+	if len(s.Root) == 0 && len(s.Synonyms) < 2 {
+		return errors.InvalidArgument("synonym set '%s' needs at least two synonyms or a root", s.Id)
+	}
+	if len(s.Synonyms) == 0 {
+		return errors.InvalidArgument("synonym set '%s' needs at least one synonym", s.Id)
+	}
+	for _, w := range s.Synonyms {
+		if len(w) == 0 {
+			return errors.InvalidArgument("synonym set '%s' has an empty synonym", s.Id)
+		}
+	}
+
+	return nil
+}
+
+func (r *SearchRanking) Validate() error {
+	for field, weight := range r.Weights {
+		if weight <= 0 {
+			return errors.InvalidArgument("weight of the field '%s' should be positive", field)
+		}
+	}
+	if r.Buckets < 0 {
+		return errors.InvalidArgument("ranking buckets can't be negative")
+	}
+	if len(r.Rules) > maxRankingRules {
+		return errors.InvalidArgument("only %d ranking rules are supported, found %d", maxRankingRules, len(r.Rules))
+	}
+	for i := range r.Rules {
+		if err := r.Rules[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	pinned := make(map[string]struct{}, len(r.Pinned))
+	for _, id := range r.Pinned {
+		if len(id) == 0 {
+			return errors.InvalidArgument("pinned document id can't be empty")
+		}
+		pinned[id] = struct{}{}
+	}
+	for _, id := range r.Hidden {
+		if len(id) == 0 {
+			return errors.InvalidArgument("hidden document id can't be empty")
+		}
+		if _, ok := pinned[id]; ok {
+			return errors.InvalidArgument("document '%s' can't be both pinned and hidden", id)
+		}
+	}
+
+	return nil
+}
+
+func (r *SearchRankingRule) Validate() error {
+	if len(r.Field) == 0 {
+		return errors.InvalidArgument("ranking rule field is required")
+	}
+	if r.Order != "" && r.Order != "asc" && r.Order != "desc" {
+		return errors.InvalidArgument("unsupported order '%s' of the ranking rule on '%s'", r.Order, r.Field)
+	}
+	if r.Decay == nil {
+		return nil
+	}
+
+	switch r.Decay.Function {
+	case "", DecayGauss, DecayLinear, DecayExp:
+	default:
+		return errors.InvalidArgument("unsupported decay function '%s'", r.Decay.Function)
+	}
+	if r.Decay.Decay < 0 || r.Decay.Decay >= 1 {
+		return errors.InvalidArgument("decay of the field '%s' should be between 0 and 1", r.Field)
+	}
+	if len(r.Decay.Scale) == 0 {
+		return errors.InvalidArgument("decay scale of the field '%s' is required", r.Field)
+	}
+
+	return nil
+}
+
+// IsAscending returns true if the hits are ranked in the ascending order of the field.
+func (r *SearchRankingRule) IsAscending() bool {
+	return r.Order == "asc"
+}
+
+// ValidateFields checks the fields of the ranking against the fields of the index. The weighted fields need to be
+// searchable text fields and the rules need sortable numeric or datetime fields.
+func (r *SearchRanking) ValidateFields(fields []*QueryableField) error {
+	byName := make(map[string]*QueryableField, len(fields))
+	for _, f := range fields {
+		byName[f.Name()] = f
+	}
+
+	for name := range r.Weights {
+		f, ok := byName[name]
+		if !ok {
+			return errors.InvalidArgument("weighted field '%s' is not present in the index", name)
+		}
+		if !f.SearchIndexed || f.DataType == Int32Type || f.DataType == Int64Type || f.DataType == DoubleType {
+			return errors.InvalidArgument("`%s` is not a searchable field. Only indexed fields can be weighted", name)
+		}
+	}
+
+	for _, rule := range r.Rules {
+		f, ok := byName[rule.Field]
+		if !ok {
+			return errors.InvalidArgument("ranking rule field '%s' is not present in the index", rule.Field)
+		}
+		switch f.DataType {
+		case Int32Type, Int64Type, DoubleType, DateTimeType:
+		default:
+			return errors.InvalidArgument("ranking rule field '%s' should be a numeric or a datetime field", rule.Field)
+		}
+		if !f.Sortable {
+			return errors.InvalidArgument("Cannot rank on `%s` field", rule.Field)
+		}
+		if rule.Decay != nil {
+			if _, _, err := rule.Decay.Bounds(f.DataType, time.Now()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Bounds returns the origin and the scale of the decay in the indexed representation of the field type, the datetime
+// fields are indexed as Unix nanoseconds.
+func (d *SearchDecay) Bounds(fieldType FieldType, now time.Time) (float64, float64, error) {
+	if fieldType == DateTimeType {
+		origin := now
+		if len(d.Origin) > 0 && d.Origin != "now" {
+			var err error
+			if origin, err = time.Parse(time.RFC3339Nano, d.Origin); err != nil {
+				return 0, 0, errors.InvalidArgument("decay origin '%s' is not a RFC 3339 date", d.Origin)
+			}
+		}
+		scale, err := time.ParseDuration(d.Scale)
+		if err != nil || scale <= 0 {
+			return 0, 0, errors.InvalidArgument("decay scale '%s' is not a positive duration", d.Scale)
+		}
+
+		return float64(origin.UnixNano()), float64(scale.Nanoseconds()), nil
+	}
+
+	var origin float64
+	if len(d.Origin) > 0 {
+		var err error
+		if origin, err = strconv.ParseFloat(d.Origin, 64); err != nil {
+			return 0, 0, errors.InvalidArgument("decay origin '%s' is not a number", d.Origin)
+		}
+	}
+	scale, err := strconv.ParseFloat(d.Scale, 64)
+	if err != nil || scale <= 0 {
+		return 0, 0, errors.InvalidArgument("decay scale '%s' is not a positive number", d.Scale)
+	}
+
+	return origin, scale, nil
+}
+
+// GetSynonym returns the synonym set with the id.
+func (s *SearchSettings) GetSynonym(id string) (SearchSynonym, bool) {
+	for _, syn := range s.Synonyms {
+		if syn.Id == id {
+			return syn, true
+		}
+	}
+
+	return SearchSynonym{}, false
+}
+
+// WithSynonym returns a copy of the settings with the synonym set added or replaced.
+func (s SearchSettings) WithSynonym(synonym SearchSynonym) SearchSettings {
+	synonyms := make([]SearchSynonym, 0, len(s.Synonyms)+1)
+	replaced := false
+	for _, syn := range s.Synonyms {
+		if syn.Id == synonym.Id {
+			syn, replaced = synonym, true
+		}
+		synonyms = append(synonyms, syn)
+	}
+	if !replaced {
+		synonyms = append(synonyms, synonym)
+	}
+
+	s.Synonyms = synonyms
+	return s
+}
+
+// WithoutSynonym returns a copy of the settings without the synonym set.
+func (s SearchSettings) WithoutSynonym(id string) SearchSettings {
+	synonyms := make([]SearchSynonym, 0, len(s.Synonyms))
+	for _, syn := range s.Synonyms {
+		if syn.Id != id {
+			synonyms = append(synonyms, syn)
+		}
+	}
+
+	s.Synonyms = synonyms
+	return s
+}
+
+// Override returns the settings with the non-empty query settings applied on top of the index settings.
+func (s SearchSettings) Override(query *SearchSettings) SearchSettings {
+	if query == nil {
+		return s
+	}
+	if query.StopWords != nil {
+		s.StopWords = query.StopWords
+	}
+	if query.NumTypos != nil {
+		s.NumTypos = query.NumTypos
+	}
+	if query.Prefix != nil {
+		s.Prefix = query.Prefix
+	}
+	if query.MinLen1Typo != nil {
+		s.MinLen1Typo = query.MinLen1Typo
+	}
+	if query.MinLen2Typo != nil {
+		s.MinLen2Typo = query.MinLen2Typo
+	}
+	if query.Ranking != nil {
+		s.Ranking = s.Ranking.Override(query.Ranking)
+	}
+
+	return s
+}
+
+// Override returns the ranking with the non-empty query ranking applied on top of the index ranking.
+func (r *SearchRanking) Override(query *SearchRanking) *SearchRanking {
+	if r == nil {
+		return query
+	}
+
+	ranking := *r
+	if query.Weights != nil {
+		ranking.Weights = query.Weights
+	}
+	if query.Buckets > 0 {
+		ranking.Buckets = query.Buckets
+	}
+	if query.Rules != nil {
+		ranking.Rules = query.Rules
+	}
+	if query.Pinned != nil {
+		ranking.Pinned = query.Pinned
+	}
+	if query.Hidden != nil {
+		ranking.Hidden = query.Hidden
+	}
+
+	return &ranking
+}
+
+// UnmarshalQuerySettings parses the settings overridden in the search request, the synonyms can only be changed on
+// the index.
+func UnmarshalQuerySettings(input jsoniter.RawMessage) (*SearchSettings, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	var s SearchSettings
+	if err := jsoniter.Unmarshal(input, &s); err != nil {
+		return nil, errors.InvalidArgument("unable to parse search settings: %s", err.Error())
+	}
+	if len(s.Synonyms) > 0 {
+		return nil, errors.InvalidArgument("synonyms can't be set in the search request")
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// SetSearchSettings returns the index schema with the settings replaced.
+func SetSearchSettings(searchSchema jsoniter.RawMessage, settings SearchSettings) (jsoniter.RawMessage, error) {
+	var schema SearchJSONSchema
+	if err := jsoniter.Unmarshal(searchSchema, &schema); err != nil {
+		return nil, err
+	}
+	schema.Settings = &settings
+
+	return jsoniter.Marshal(schema)
+}
@@ -0,0 +1,146 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchSettings(t *testing.T) {
+	cases := []struct {
+		settings    string
+		expErrorMsg string
+	}{
+		{`{"synonyms": [{"id": "tv", "synonyms": ["tv", "television"]}], "stop_words": ["the"], "num_typos": 1, "prefix": false}`, ""},
+		{`{"synonyms": [{"id": "phone", "root": "phone", "synonyms": ["iphone"]}], "min_len_1typo": 3, "min_len_2typo": 6}`, ""},
+		{`{"num_typos": 3}`, "num_typos should be between 0 and 2"},
+		{`{"min_len_1typo": 7, "min_len_2typo": 4}`, "min_len_1typo can't be greater than min_len_2typo"},
+		{`{"synonyms": [{"id": "tv", "synonyms": ["tv"]}]}`, "needs at least two synonyms or a root"},
+		{`{"synonyms": [{"synonyms": ["tv", "television"]}]}`, "synonym set id is required"},
+		{`{"synonyms": [{"id": "a", "synonyms": ["x", "y"]}, {"id": "a", "synonyms": ["y", "z"]}]}`, "duplicate synonym set 'a'"},
+		{`{"stop_words": [""]}`, "stop words can't be empty"},
+	}
+	for _, c := range cases {
+		reqSchema := []byte(`{"title": "t1", "properties": {"a": {"type": "string"}}, "settings": ` + c.settings + `}`)
+		factory, err := NewFactoryBuilder(true).BuildSearch("t1", reqSchema)
+		if len(c.expErrorMsg) > 0 {
+			require.ErrorContains(t, err, c.expErrorMsg)
+			continue
+		}
+		require.NoError(t, err)
+
+		index := NewSearchIndex(1, "t1", factory, nil)
+		require.Equal(t, factory.Settings, index.Settings)
+		require.NotEmpty(t, index.Settings.Synonyms)
+	}
+}
+
+func TestSearchSettings_Synonyms(t *testing.T) {
+	factory, err := NewFactoryBuilder(true).BuildSearch("t1", []byte(`{"title": "t1", "properties": {"a": {"type": "string"}}}`))
+	require.NoError(t, err)
+
+	settings := factory.Settings.WithSynonym(SearchSynonym{Id: "tv", Synonyms: []string{"tv", "television"}})
+	settings = settings.WithSynonym(SearchSynonym{Id: "phone", Root: "phone", Synonyms: []string{"iphone"}})
+	settings = settings.WithSynonym(SearchSynonym{Id: "tv", Synonyms: []string{"tv", "telly"}})
+	require.Len(t, settings.Synonyms, 2)
+	tv, ok := settings.GetSynonym("tv")
+	require.True(t, ok)
+	require.Equal(t, []string{"tv", "telly"}, tv.Synonyms)
+	require.Empty(t, factory.Settings.Synonyms)
+
+	updated, err := SetSearchSettings(factory.Schema, settings.WithoutSynonym("phone"))
+	require.NoError(t, err)
+
+	factory, err = NewFactoryBuilder(true).BuildSearch("t1", updated)
+	require.NoError(t, err)
+	require.Equal(t, []SearchSynonym{{Id: "tv", Synonyms: []string{"tv", "telly"}}}, factory.Settings.Synonyms)
+	require.Len(t, factory.Fields, 2)
+}
+
+func TestSearchSettings_Override(t *testing.T) {
+	one, two, ptrFalse := 1, 2, false
+	index := SearchSettings{
+		StopWords: []string{"the"},
+		NumTypos:  &two,
+		Synonyms:  []SearchSynonym{{Id: "tv", Synonyms: []string{"tv", "television"}}},
+	}
+
+	query, err := UnmarshalQuerySettings([]byte(`{"num_typos": 1, "prefix": false}`))
+	require.NoError(t, err)
+	require.Equal(t, SearchSettings{
+		StopWords: []string{"the"},
+		NumTypos:  &one,
+		Prefix:    &ptrFalse,
+		Synonyms:  index.Synonyms,
+	}, index.Override(query))
+
+	query, err = UnmarshalQuerySettings(nil)
+	require.NoError(t, err)
+	require.Equal(t, index, index.Override(query))
+
+	_, err = UnmarshalQuerySettings([]byte(`{"synonyms": [{"id": "a", "synonyms": ["x", "y"]}]}`))
+	require.ErrorContains(t, err, "synonyms can't be set in the search request")
+}
+
+func TestSearchSettings_Ranking(t *testing.T) {
+	properties := `{"title": {"type": "string"}, "body": {"type": "string"}, "popularity": {"type": "integer"}, "published": {"type": "string", "format": "date-time"}, "tags": {"type": "array", "items": {"type": "string"}}}`
+	cases := []struct {
+		ranking     string
+		expErrorMsg string
+	}{
+		{`{"weights": {"title": 3, "body": 1}, "buckets": 5, "rules": [{"field": "popularity"}], "pinned": ["a"], "hidden": ["b"]}`, ""},
+		{`{"rules": [{"field": "published", "decay": {"scale": "168h", "decay": 0.5}}, {"field": "popularity", "order": "asc"}]}`, ""},
+		{`{"rules": [{"field": "popularity", "decay": {"function": "linear", "origin": "100", "scale": "10"}}]}`, ""},
+		{`{"weights": {"title": 0}}`, "weight of the field 'title' should be positive"},
+		{`{"weights": {"popularity": 2}}`, "`popularity` is not a searchable field"},
+		{`{"weights": {"missing": 2}}`, "weighted field 'missing' is not present in the index"},
+		{`{"rules": [{"field": "title"}]}`, "ranking rule field 'title' should be a numeric or a datetime field"},
+		{`{"rules": [{"field": "popularity", "order": "up"}]}`, "unsupported order 'up'"},
+		{`{"rules": [{"field": "popularity"}, {"field": "popularity"}, {"field": "published"}]}`, "only 2 ranking rules are supported"},
+		{`{"rules": [{"field": "published", "decay": {"scale": "a week"}}]}`, "decay scale 'a week' is not a positive duration"},
+		{`{"rules": [{"field": "published", "decay": {"origin": "yesterday", "scale": "24h"}}]}`, "decay origin 'yesterday' is not a RFC 3339 date"},
+		{`{"rules": [{"field": "popularity", "decay": {"function": "step", "scale": "10"}}]}`, "unsupported decay function 'step'"},
+		{`{"rules": [{"field": "popularity", "decay": {"scale": "10", "decay": 1}}]}`, "decay of the field 'popularity' should be between 0 and 1"},
+		{`{"pinned": ["a"], "hidden": ["a"]}`, "document 'a' can't be both pinned and hidden"},
+	}
+	for _, c := range cases {
+		reqSchema := []byte(`{"title": "t1", "properties": ` + properties + `, "settings": {"ranking": ` + c.ranking + `}}`)
+		factory, err := NewFactoryBuilder(true).BuildSearch("t1", reqSchema)
+		if len(c.expErrorMsg) > 0 {
+			require.ErrorContains(t, err, c.expErrorMsg, c.ranking)
+			continue
+		}
+		require.NoError(t, err, c.ranking)
+		require.NotNil(t, factory.Settings.Ranking)
+	}
+
+	index := SearchSettings{Ranking: &SearchRanking{Weights: map[string]int{"title": 3}, Pinned: []string{"a"}}}
+	query, err := UnmarshalQuerySettings([]byte(`{"ranking": {"hidden": ["b"]}}`))
+	require.NoError(t, err)
+	require.Equal(t, &SearchRanking{
+		Weights: map[string]int{"title": 3},
+		Pinned:  []string{"a"},
+		Hidden:  []string{"b"},
+	}, index.Override(query).Ranking)
+	require.Equal(t, &SearchRanking{Pinned: []string{"a"}}, (&SearchSettings{}).Override(&SearchSettings{Ranking: &SearchRanking{Pinned: []string{"a"}}}).Ranking)
+
+	origin, scale, err := (&SearchDecay{Origin: "2023-01-01T00:00:00Z", Scale: "1h"}).Bounds(DateTimeType, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, float64(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()), origin)
+	require.Equal(t, float64(time.Hour.Nanoseconds()), scale)
+}
@@ -153,6 +153,26 @@ func TestSearchIndex_Schema(t *testing.T) {
 			[]byte(`{"title": "t1", "properties": { "a": {"type": "string"}, "b": {"type": "array", "format": "vector", "dimensions": 4}}}`),
 			"",
 		},
+		{
+			[]byte(`{"title": "t1", "properties": { "a": {"type": "string"}, "b": {"type": "array", "format": "vector", "dimensions": 4, "distance": "l2"}}}`),
+			"",
+		},
+		{
+			[]byte(`{"title": "t1", "properties": { "a": {"type": "string"}, "b": {"type": "array", "format": "vector", "dimensions": 4, "distance": "hamming"}}}`),
+			"unsupported distance 'hamming' on vector field 'b'",
+		},
+		{
+			[]byte(`{"title": "t1", "properties": { "a": {"type": "string", "distance": "dot"}}}`),
+			"distance is only supported on vector field, found on field 'a'",
+		},
+		{
+			[]byte(`{"title": "t1", "properties": { "a": {"type": "string"}, "b": {"type": "array", "format": "geopoint"}}}`),
+			"",
+		},
+		{
+			[]byte(`{"title": "t1", "properties": { "a": {"type": "string"}, "b": {"type": "array", "format": "geopoint", "facet": true}}}`),
+			"Cannot enable faceting on field 'b'",
+		},
 		{
 			[]byte(`{"title": "t1", "properties": { "a": {"type": "string", "id": true}, "b": {"type": "array", "items": {"type": "integer"}}}}`),
 			"",
@@ -250,6 +270,10 @@ func TestSearchIndex_Validate(t *testing.T) {
       "type": "array",
       "format": "vector",
       "dimensions": 2
+    },
+    "g": {
+      "type": "array",
+      "format": "geopoint"
     }
   }
 }`)
@@ -293,6 +317,14 @@ func TestSearchIndex_Validate(t *testing.T) {
 			document: []byte(`{"a": 1, "b": "foo", "c": 1.01, "d": ["foo", "bar"], "e": {"a": 1, "b": "foo", "c": [0.12, 0.99], "d": {"a": [0.12, 0.99]}}, "f": [1.21, 2.93, 1]}`),
 			expError: "field 'f' of vector type should not have dimensions greater than the defined in schema, defined: '2' found: '3'",
 		},
+		{
+			document: []byte(`{"a": 1, "g": [37.7749, -122.4194]}`),
+			expError: "",
+		},
+		{
+			document: []byte(`{"a": 1, "g": [137.7749, -122.4194]}`),
+			expError: "field 'g' of geopoint type is invalid: latitude '137.7749' is out of range [-90, 90]",
+		},
 	}
 	for _, c := range cases {
 		mp, err := util.JSONToMap(c.document)
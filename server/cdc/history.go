@@ -0,0 +1,179 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/apple/foundationdb/bindings/go/src/fdb"
+	"github.com/apple/foundationdb/bindings/go/src/fdb/tuple"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/store/kv"
+	ulog "github.com/tigrisdata/tigris/util/log"
+)
+
+// HistoryEvent is a single change of a document captured by the publisher. The Key is kept in the JSON form it was
+// published with, so it can be compared with the keys of other events but not decoded back to the typed key.
+type HistoryEvent struct {
+	Op      string
+	Table   []byte
+	Key     jsoniter.RawMessage
+	Data    *internal.TableData
+	Version int64
+}
+
+// History reads the changes published for a database. It allows to reconstruct the state of a collection at a version
+// which is no longer available in the FoundationDB MVCC window, as long as the changes are retained in the CDC
+// keyspace. Only the changes captured by the publisher are visible, so a collection can be reconstructed only if CDC
+// was enabled when it was created.
+type History struct {
+	db       fdb.Database
+	cfg      config.CdcConfig
+	keySpace *PublisherKeySpace
+}
+
+func (p *Publisher) NewHistory(kvStore kv.TxStore) (*History, error) {
+	intDb, err := kvStore.GetInternalDatabase()
+	if ulog.E(err) {
+		return nil, err
+	}
+
+	return &History{
+		db:       intDb.(fdb.Database),
+		cfg:      config.DefaultConfig.Cdc,
+		keySpace: p.keySpace,
+	}, nil
+}
+
+// Replay calls fn for every change of the table committed after the since version and at or before the version, in
+// the commit order. The since version is the version the table was created at, so the scan skips the changes committed
+// before the table existed. The changes are read in batches, each batch in its own transaction to stay within the
+// transaction duration limit.
+func (h *History) Replay(ctx context.Context, table []byte, since int64, version int64, fn func(*HistoryEvent) error) error {
+	from := h.keySpace.versionKey(since)
+	to := h.keySpace.versionKey(version + 1)
+
+	for {
+		var (
+			events []*HistoryEvent
+			last   fdb.Key
+		)
+
+		_, err := h.db.ReadTransact(func(rtx fdb.ReadTransaction) (any, error) {
+			events, last = nil, nil
+
+			kr := fdb.KeyRange{Begin: from, End: to}
+			i := rtx.GetRange(kr, fdb.RangeOptions{Limit: h.cfg.StreamBatch}).Iterator()
+			for i.Advance() {
+				kv, err := i.Get()
+				if err != nil {
+					return nil, err
+				}
+				last = kv.Key
+
+				txEvents, err := h.decode(kv, table)
+				if err != nil {
+					return nil, err
+				}
+				events = append(events, txEvents...)
+			}
+
+			return nil, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if err = fn(e); err != nil {
+				return err
+			}
+		}
+
+		if last == nil {
+			return nil
+		}
+
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		// continue right after the last read key
+		from = append(append(fdb.Key{}, last...), 0x00)
+	}
+}
+
+func (h *History) decode(kv fdb.KeyValue, table []byte) ([]*HistoryEvent, error) {
+	version, err := h.keySpace.version(kv.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := internal.Decode(kv.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx struct {
+		Ops []*HistoryEvent
+	}
+	if err = jsoniter.Unmarshal(data.RawData, &tx); err != nil {
+		return nil, err
+	}
+
+	events := make([]*HistoryEvent, 0, len(tx.Ops))
+	for _, op := range tx.Ops {
+		if !bytes.Equal(op.Table, table) {
+			continue
+		}
+
+		op.Version = version
+		events = append(events, op)
+	}
+
+	return events, nil
+}
+
+// versionKey returns the first key of the transactions committed at the version.
+func (p *PublisherKeySpace) versionKey(version int64) fdb.Key {
+	var tv [10]byte
+	binary.BigEndian.PutUint64(tv[:8], uint64(version))
+
+	return getKey(p.cdcBytes, tv)
+}
+
+// version extracts the commit version from the versionstamp of the key.
+func (p *PublisherKeySpace) version(key fdb.Key) (int64, error) {
+	t, err := tuple.Unpack(key[len(p.cdcBytes):])
+	if err != nil {
+		return 0, err
+	}
+
+	if len(t) == 0 {
+		return 0, fmt.Errorf("unexpected cdc key %v", key)
+	}
+
+	vs, ok := t[0].(tuple.Versionstamp)
+	if !ok {
+		return 0, fmt.Errorf("unexpected cdc key %v", key)
+	}
+
+	return int64(binary.BigEndian.Uint64(vs.TransactionVersion[:8])), nil
+}
@@ -28,12 +28,14 @@ type DatabaseNameCtxKey struct{}
 type Manager struct {
 	sync.RWMutex
 
-	pubs map[string]*Publisher
+	pubs    map[string]*Publisher
+	kvStore kv.TxStore
 }
 
-func NewManager() *Manager {
+func NewManager(kvStore kv.TxStore) *Manager {
 	return &Manager{
-		pubs: make(map[string]*Publisher),
+		pubs:    make(map[string]*Publisher),
+		kvStore: kvStore,
 	}
 }
 
@@ -47,6 +49,11 @@ func (m *Manager) GetPublisher(dbName string) *Publisher {
 	return m.pubs[dbName]
 }
 
+// History returns the reader of the changes published for the database.
+func (m *Manager) History(dbName string) (*History, error) {
+	return m.GetPublisher(dbName).NewHistory(m.kvStore)
+}
+
 func (*Manager) WrapContext(ctx context.Context, dbName string) context.Context {
 	if len(dbName) == 0 {
 		return ctx
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/tigrisdata/tigris/lib/blob"
 	"github.com/tigrisdata/tigris/util/log"
 )
 
@@ -54,6 +55,9 @@ type Config struct {
 	Management      ManagementConfig    `yaml:"management" json:"management"`
 	GlobalStatus    GlobalStatusConfig  `yaml:"global_status" json:"global_status"`
 	Schema          SchemaConfig
+	Import          ImportConfig      `yaml:"import" json:"import"`
+	Export          ExportConfig      `yaml:"export" json:"export"`
+	Transaction     TransactionConfig `yaml:"transaction" json:"transaction"`
 }
 
 type Gotrue struct {
@@ -97,6 +101,16 @@ type AuthConfig struct {
 	Authz                      AuthzConfig           `mapstructure:"authz" yaml:"authz" json:"authz"`
 }
 
+// ValidatorKey returns the key the tokens of the validator are signed with. It is only known for the HS256 validators,
+// the keys of the other validators are fetched from their issuer.
+func (a *AuthConfig) ValidatorKey(v *ValidatorConfig) []byte {
+	if v.Algorithm != validator.HS256 || len(a.Gotrue.SharedSecret) == 0 {
+		return nil
+	}
+
+	return []byte(a.Gotrue.SharedSecret)
+}
+
 type Invitation struct {
 	ExpireAfterSec int64 `mapstructure:"expire_after_sec" yaml:"expire_after_sec" json:"expire_after_sec"`
 }
@@ -120,6 +134,12 @@ type CdcConfig struct {
 	StreamInterval time.Duration
 	StreamBatch    int
 	StreamBuffer   int
+	// MaxHistoryRows is the maximum number of the documents matching the filter that an as_of read older than the
+	// MVCC window reconstructs from the history, the reconstructed documents are kept in memory.
+	MaxHistoryRows int `mapstructure:"max_history_rows" yaml:"max_history_rows" json:"max_history_rows"`
+	// HistoryRetention is how far back the as_of reads older than the MVCC window are served from the history, the
+	// older versions are rejected. Zero doesn't limit the age of the as_of reads.
+	HistoryRetention time.Duration `mapstructure:"history_retention" yaml:"history_retention" json:"history_retention"`
 }
 
 type TracingConfig struct {
@@ -325,10 +345,12 @@ var DefaultConfig = Config{
 		},
 	},
 	Cdc: CdcConfig{
-		Enabled:        false,
-		StreamInterval: 500 * time.Millisecond,
-		StreamBatch:    100,
-		StreamBuffer:   200,
+		Enabled:          false,
+		StreamInterval:   500 * time.Millisecond,
+		StreamBatch:      100,
+		StreamBuffer:     200,
+		MaxHistoryRows:   100000,
+		HistoryRetention: 24 * time.Hour,
 	},
 	Search: SearchConfig{
 		Host:              "localhost",
@@ -339,6 +361,26 @@ var DefaultConfig = Config{
 		Chunking:          true,
 		Compression:       false,
 		IgnoreExtraFields: false,
+		Outbox: SearchOutboxConfig{
+			Enabled:      false,
+			Partitions:   16,
+			BatchSize:    256,
+			ReadBytes:    4 * 1024 * 1024,
+			PollInterval: 100 * time.Millisecond,
+			LeaseTime:    30 * time.Second,
+			RetryBackoff: 500 * time.Millisecond,
+			MaxBackoff:   30 * time.Second,
+		},
+		Embedded: EmbeddedSearchConfig{
+			Enabled:         false,
+			DataDir:         "/var/lib/tigris/search",
+			CompactionRatio: 2,
+		},
+		Reindex: SearchReindexConfig{
+			WorkerEnabled: true,
+			PollInterval:  time.Second,
+			LeaseTime:     time.Minute,
+		},
 	},
 	KV: KVConfig{
 		Chunking:    false,
@@ -353,6 +395,13 @@ var DefaultConfig = Config{
 		Host:    "0.0.0.0",
 		Port:    6379,
 		MaxScan: 500,
+		FillTTL: time.Minute,
+		Deletion: CacheDeletionConfig{
+			WorkerEnabled: true,
+			PollInterval:  time.Second,
+			LeaseTime:     time.Minute,
+			BatchSize:     500,
+		},
 	},
 	Tracing: TracingConfig{
 		Enabled: false,
@@ -498,6 +547,23 @@ var DefaultConfig = Config{
 	Schema: SchemaConfig{
 		AllowIncompatible: false,
 	},
+	Import: ImportConfig{
+		BatchSize:  500,
+		BatchBytes: 2 * 1024 * 1024,
+		SampleSize: 1000,
+		LeaseTime:  5 * time.Minute,
+	},
+	Export: ExportConfig{
+		WorkerEnabled: true,
+		PollInterval:  5 * time.Second,
+		LeaseTime:     time.Minute,
+		BatchSize:     1000,
+		PartRows:      1000000,
+	},
+	Transaction: TransactionConfig{
+		ReapInterval:  time.Second,
+		GoneRetention: 10 * time.Minute,
+	},
 	GlobalStatus: GlobalStatusConfig{
 		Enabled:       true,
 		EmitMetrics:   true,
@@ -525,6 +591,52 @@ type SchemaConfig struct {
 	AllowIncompatible bool `mapstructure:"allow_incompatible" json:"allow_incompatible" yaml:"allow_incompatible"`
 }
 
+// ImportConfig controls how streaming bulk import splits the incoming data into transactions.
+type ImportConfig struct {
+	// BatchSize is the maximum number of documents committed in a single transaction.
+	BatchSize int `mapstructure:"batch_size" yaml:"batch_size" json:"batch_size"`
+	// BatchBytes is the maximum total size of the documents committed in a single transaction.
+	BatchBytes int `mapstructure:"batch_bytes" yaml:"batch_bytes" json:"batch_bytes"`
+	// SampleSize is the number of leading documents used to infer the schema of the collection.
+	SampleSize int `mapstructure:"sample_size" yaml:"sample_size" json:"sample_size"`
+	// LeaseTime is how long a running import is owned by its stream after the last committed batch, a resume with the
+	// same job id is rejected until then.
+	LeaseTime time.Duration `mapstructure:"lease_time" yaml:"lease_time" json:"lease_time"`
+}
+
+// ExportConfig controls the bulk export jobs.
+type ExportConfig struct {
+	// WorkerEnabled starts the background worker which runs the export jobs scheduled through the API.
+	WorkerEnabled bool          `mapstructure:"worker_enabled" yaml:"worker_enabled" json:"worker_enabled"`
+	PollInterval  time.Duration `mapstructure:"poll_interval" yaml:"poll_interval" json:"poll_interval"`
+	// LeaseTime is how long a job stays claimed by a worker without renewal, after this time another worker resumes
+	// the job.
+	LeaseTime time.Duration `mapstructure:"lease_time" yaml:"lease_time" json:"lease_time"`
+	// BatchSize is the maximum number of documents read in a single transaction.
+	BatchSize int `mapstructure:"batch_size" yaml:"batch_size" json:"batch_size"`
+	// PartRows is the default maximum number of documents in a single output file, the progress of the job is
+	// persisted after every file.
+	PartRows int64 `mapstructure:"part_rows" yaml:"part_rows" json:"part_rows"`
+	// S3 is the object storage used for the "s3://" destinations.
+	S3 blob.Config `mapstructure:"s3" yaml:"s3" json:"s3"`
+	// Destination is the location the exports scheduled through the API are written to, every namespace writes
+	// under its own prefix and the destination of the request is a path under it. The API exports are disabled when
+	// it is empty.
+	Destination string `mapstructure:"destination" yaml:"destination" json:"destination"`
+}
+
+// TransactionConfig controls the explicit transactions tracked by the server.
+type TransactionConfig struct {
+	// IdleTimeout is how long an explicit transaction can stay without requests before the server rolls it back.
+	// Zero disables the timeout, which is the default.
+	IdleTimeout time.Duration `mapstructure:"idle_timeout" yaml:"idle_timeout" json:"idle_timeout"`
+	// ReapInterval is how often the idle transactions are looked up.
+	ReapInterval time.Duration `mapstructure:"reap_interval" yaml:"reap_interval" json:"reap_interval"`
+	// GoneRetention is how long the server remembers the transactions it rolled back, to return the reason to the
+	// client on its next call instead of the generic "session is gone" error.
+	GoneRetention time.Duration `mapstructure:"gone_retention" yaml:"gone_retention" json:"gone_retention"`
+}
+
 // KVConfig keeps KV store configuration parameters.
 type KVConfig struct {
 	// Chunking allows us to persist bigger payload in storage.
@@ -551,6 +663,52 @@ type SearchConfig struct {
 	// Compression allows us to compress payload before storing in storage.
 	Compression       bool `mapstructure:"compression" yaml:"compression" json:"compression"`
 	IgnoreExtraFields bool `mapstructure:"ignore_extra_fields" yaml:"ignore_extra_fields" json:"ignore_extra_fields"`
+	// Outbox indexes the collection writes through the transactional outbox instead of after the commit.
+	Outbox SearchOutboxConfig `mapstructure:"outbox" yaml:"outbox" json:"outbox"`
+	// Embedded serves search from an index inside the Tigris process instead of the external search service.
+	Embedded EmbeddedSearchConfig `mapstructure:"embedded" yaml:"embedded" json:"embedded"`
+	// Reindex runs the reindex jobs which build a search index and swap an alias to it.
+	Reindex SearchReindexConfig `mapstructure:"reindex" yaml:"reindex" json:"reindex"`
+}
+
+type SearchReindexConfig struct {
+	WorkerEnabled bool          `mapstructure:"worker_enabled" yaml:"worker_enabled" json:"worker_enabled"`
+	PollInterval  time.Duration `mapstructure:"poll_interval" yaml:"poll_interval" json:"poll_interval"`
+	// LeaseTime is how long a job stays claimed by a worker without renewal, after this time another worker resumes
+	// the job from the last persisted position.
+	LeaseTime time.Duration `mapstructure:"lease_time" yaml:"lease_time" json:"lease_time"`
+}
+
+// EmbeddedSearchConfig configures the embedded search store. The documents of every search index are kept in an
+// append-only log under DataDir and the inverted index is rebuilt from it on startup. The index is derived data, a
+// lost tail of the log can be restored by the search consistency verification with repair.
+type EmbeddedSearchConfig struct {
+	Enabled bool   `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+	DataDir string `mapstructure:"data_dir" yaml:"data_dir" json:"data_dir"`
+	// CompactionRatio is the ratio of the log entries to the live documents after which the log is rewritten.
+	CompactionRatio int `mapstructure:"compaction_ratio" yaml:"compaction_ratio" json:"compaction_ratio"`
+}
+
+// SearchOutboxConfig controls the durable indexing of the collections. The keys of the written documents are stored
+// in the outbox in the same transaction as the documents and a background worker indexes them in batches, so the
+// search index is eventually consistent even if the search store is unavailable or the server crashes after the commit.
+type SearchOutboxConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled" json:"enabled"`
+	// Partitions is the number of outbox partitions, the writes of a document always go to the same partition and
+	// a partition is processed by a single worker at a time. Changing it reorders the pending writes.
+	Partitions int `mapstructure:"partitions" yaml:"partitions" json:"partitions"`
+	// BatchSize is the maximum number of outbox items indexed in a single batch.
+	BatchSize int `mapstructure:"batch_size" yaml:"batch_size" json:"batch_size"`
+	// ReadBytes is the maximum size of the documents read in a single transaction, the documents of a larger batch
+	// are read in multiple transactions.
+	ReadBytes    int           `mapstructure:"read_bytes" yaml:"read_bytes" json:"read_bytes"`
+	PollInterval time.Duration `mapstructure:"poll_interval" yaml:"poll_interval" json:"poll_interval"`
+	// LeaseTime is how long a partition stays claimed by a worker without processing, after this time another
+	// worker takes it over.
+	LeaseTime time.Duration `mapstructure:"lease_time" yaml:"lease_time" json:"lease_time"`
+	// RetryBackoff is the delay before retrying a failed partition, it doubles on every failure up to MaxBackoff.
+	RetryBackoff time.Duration `mapstructure:"retry_backoff" yaml:"retry_backoff" json:"retry_backoff"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff" yaml:"max_backoff" json:"max_backoff"`
 }
 
 type SecondaryIndexConfig struct {
@@ -563,6 +721,35 @@ type CacheConfig struct {
 	Host    string `mapstructure:"host" json:"host" yaml:"host"`
 	Port    int16  `mapstructure:"port" json:"port" yaml:"port"`
 	MaxScan int64  `mapstructure:"max_scan" json:"max_scan" yaml:"max_scan"`
+	// InMemory keeps the cache and the realtime streams inside the Tigris process instead of Redis. The data is not
+	// persisted and is not shared between the servers, so it is meant for single node and test deployments.
+	InMemory bool `mapstructure:"in_memory" json:"in_memory" yaml:"in_memory"`
+	// Deletion controls the background removal of the keys of the deleted caches.
+	Deletion CacheDeletionConfig `mapstructure:"deletion" json:"deletion" yaml:"deletion"`
+	// Retention is the default retention of the realtime channels that don't set their own.
+	Retention StreamRetentionConfig `mapstructure:"retention" json:"retention" yaml:"retention"`
+	// FillTTL is the expiry of the documents cached from the backing collection of a cache. A fill racing with a
+	// write to the collection can cache the previous document, the expiry bounds how long it is served.
+	FillTTL time.Duration `mapstructure:"fill_ttl" json:"fill_ttl" yaml:"fill_ttl"`
+}
+
+// StreamRetentionConfig limits the messages kept in a realtime channel, the oldest messages are trimmed once a limit
+// is exceeded. A zero limit is disabled, so by default the channels keep all the messages.
+type StreamRetentionConfig struct {
+	MaxMessages int64         `mapstructure:"max_messages" yaml:"max_messages" json:"max_messages"`
+	MaxAge      time.Duration `mapstructure:"max_age" yaml:"max_age" json:"max_age"`
+}
+
+// CacheDeletionConfig controls the worker which removes the keys of the deleted caches in batches, so that deleting
+// a large cache doesn't block the cache server.
+type CacheDeletionConfig struct {
+	WorkerEnabled bool          `mapstructure:"worker_enabled" yaml:"worker_enabled" json:"worker_enabled"`
+	PollInterval  time.Duration `mapstructure:"poll_interval" yaml:"poll_interval" json:"poll_interval"`
+	// LeaseTime is how long a job stays claimed by a worker without renewal, after this time another worker resumes
+	// the job from the last persisted cursor.
+	LeaseTime time.Duration `mapstructure:"lease_time" yaml:"lease_time" json:"lease_time"`
+	// BatchSize is the number of keys scanned and unlinked at once, the cursor is persisted after every batch.
+	BatchSize int64 `mapstructure:"batch_size" yaml:"batch_size" json:"batch_size"`
 }
 
 type LimitsConfig struct {
@@ -23,6 +23,7 @@ import (
 	"github.com/tigrisdata/tigris/internal"
 	"github.com/tigrisdata/tigris/keys"
 	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/config"
 	"github.com/tigrisdata/tigris/server/transaction"
 	ulog "github.com/tigrisdata/tigris/util/log"
 )
@@ -31,6 +32,10 @@ import (
 type CollectionMetadata struct {
 	ID      uint32          `json:"id,omitempty"`
 	Indexes []*schema.Index `json:"indexes"`
+	// HistoryVersion is the version since which all the changes of the collection are captured in the CDC history. It
+	// is the read version of the transaction which created the collection if the CDC was enabled at that time,
+	// otherwise it is zero as the history doesn't cover the collection.
+	HistoryVersion int64 `json:"history_version,omitempty"`
 }
 
 // CollectionSubspace is used to store metadata about Tigris collections.
@@ -66,8 +71,15 @@ func (c *CollectionSubspace) Create(ctx context.Context, tx transaction.Tx, nsID
 	}
 
 	meta := &CollectionMetadata{
-		id,
-		indexes,
+		ID:      id,
+		Indexes: indexes,
+	}
+
+	if config.DefaultConfig.Cdc.Enabled {
+		var err error
+		if meta.HistoryVersion, err = tx.GetReadVersion(ctx); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := c.insert(ctx, tx, nsID, dbID, name, meta); err != nil {
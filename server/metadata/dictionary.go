@@ -301,6 +301,9 @@ type Dictionary struct {
 
 	schemaStore       *SchemaSubspace
 	searchSchemaStore *SearchSchemaSubspace
+	jobStore          *JobSubspace
+	queueStore        *QueueSubspace
+	searchOutbox      *SearchOutboxSubspace
 }
 
 func NewMetadataDictionary(mdNameRegistry *NameRegistry) *Dictionary {
@@ -315,6 +318,9 @@ func NewMetadataDictionary(mdNameRegistry *NameRegistry) *Dictionary {
 		dbStore:           newDatabaseStore(mdNameRegistry),
 		schemaStore:       NewSchemaStore(mdNameRegistry),
 		searchSchemaStore: NewSearchSchemaStore(mdNameRegistry),
+		jobStore:          newJobStore(mdNameRegistry),
+		queueStore:        newQueueStore(mdNameRegistry),
+		searchOutbox:      newSearchOutboxStore(mdNameRegistry),
 	}
 }
 
@@ -342,6 +348,18 @@ func (k *Dictionary) SearchSchema() *SearchSchemaSubspace {
 	return k.searchSchemaStore
 }
 
+func (k *Dictionary) Job() *JobSubspace {
+	return k.jobStore
+}
+
+func (k *Dictionary) Queue() *QueueSubspace {
+	return k.queueStore
+}
+
+func (k *Dictionary) SearchOutbox() *SearchOutboxSubspace {
+	return k.searchOutbox
+}
+
 func (k *Dictionary) Namespace() *NamespaceSubspace {
 	return k.nsStore
 }
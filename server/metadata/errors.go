@@ -32,6 +32,10 @@ const (
 
 	ErrDBMismatch     ErrorCode = 0x0A
 	ErrBranchMismatch ErrorCode = 0x0B
+
+	ErrCodeSearchAliasExists   ErrorCode = 0x0C
+	ErrCodeSearchAliasNotFound ErrorCode = 0x0D
+	ErrCodeNamespaceNotFound   ErrorCode = 0x0E
 )
 
 type Error struct {
@@ -62,6 +66,10 @@ func NewBranchNotFoundErr(name string) error {
 	return NewMetadataError(ErrCodeBranchNotFound, "database branch doesn't exist '%s'", name)
 }
 
+func NewNamespaceNotFoundErr(name string) error {
+	return NewMetadataError(ErrCodeNamespaceNotFound, "namespace not found: %s", name)
+}
+
 func NewProjectNotFoundErr(name string) error {
 	return NewMetadataError(ErrCodeProjectNotFound, "project doesn't exist '%s'", name)
 }
@@ -74,10 +82,22 @@ func NewSearchIndexNotFoundErr(name string) error {
 	return NewMetadataError(ErrCodeSearchIndexNotFound, "search index not found '%s'", name)
 }
 
+func NewSearchAliasExistsErr(name string) error {
+	return NewMetadataError(ErrCodeSearchAliasExists, "search alias already exist '%s'", name)
+}
+
+func NewSearchAliasNotFoundErr(name string) error {
+	return NewMetadataError(ErrCodeSearchAliasNotFound, "search alias not found '%s'", name)
+}
+
 func NewCacheExistsErr(name string) error {
 	return NewMetadataError(ErrCodeCacheExists, "cache already exist '%s'", name)
 }
 
+func NewCacheDeletingErr(name string) error {
+	return NewMetadataError(ErrCodeCacheExists, "cache '%s' is being deleted", name)
+}
+
 func NewCacheNotFoundErr(name string) error {
 	return NewMetadataError(ErrCodeCacheNotFound, "cache not found '%s'", name)
 }
@@ -0,0 +1,253 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/lib/uuid"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/store/kv"
+	ulog "github.com/tigrisdata/tigris/util/log"
+)
+
+// JobSubspace is used to store the state of the long-running data jobs like bulk import and export. The progress of
+// the job is updated in the same transaction which is writing or reading the data, so that a job can be resumed
+// from the last committed position after a failure.
+//
+// The structure of the subspace is:
+//
+//	["job", 0x01, x, "job-id", "created"] = JobMetadata
+//
+// where,
+//   - job is the keyword for this table.
+//   - 0x01 is the key version.
+//   - x is the namespace id.
+type JobSubspace struct {
+	metadataSubspace
+}
+
+type (
+	JobType  string
+	JobState string
+)
+
+const (
+	ImportJobType JobType = "import"
+	ExportJobType JobType = "export"
+	VerifyJobType JobType = "verify"
+	// CacheDeleteJobType removes the keys of the dropped cache, the position is the cursor of the cache scan.
+	CacheDeleteJobType JobType = "cache_delete"
+	// ReindexJobType builds a search index and swaps an alias to it, the position is the progress of the copy.
+	ReindexJobType JobType = "reindex"
+
+	JobRunning   JobState = "running"
+	JobCompleted JobState = "completed"
+	JobFailed    JobState = "failed"
+	JobCancelled JobState = "cancelled"
+)
+
+const (
+	jobMetaValueVersion int32 = 1
+	jobMetaKeyVersion   byte  = 1
+
+	// MaxJobRowErrors is the maximum number of per-row errors kept in the job metadata.
+	MaxJobRowErrors = 100
+)
+
+// JobRowError describes a row which was not processed by the job.
+type JobRowError struct {
+	Row     int64  `json:"row"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+// JobMetadata contains the state and the progress of the job.
+type JobMetadata struct {
+	Id         string   `json:"id"`
+	Type       JobType  `json:"type"`
+	State      JobState `json:"state"`
+	Project    string   `json:"project"`
+	Branch     string   `json:"branch,omitempty"`
+	Collection string   `json:"collection"`
+	Format     string   `json:"format,omitempty"`
+	// Spec is the job specific request payload.
+	Spec []byte `json:"spec,omitempty"`
+	// Owner identifies the stream running the job, when the job is resumed the previous stream can't update it.
+	Owner string `json:"owner,omitempty"`
+
+	// Processed is the number of rows read from the input (import) or written to the output (export).
+	Processed int64 `json:"processed"`
+	// Committed is the number of input rows durably handled, a resumed job skips this many rows.
+	Committed int64         `json:"committed"`
+	Failed    int64         `json:"failed"`
+	Errors    []JobRowError `json:"errors,omitempty"`
+	// Position is the job specific resume position, for example the last exported key.
+	Position []byte `json:"position,omitempty"`
+	Message  string `json:"message,omitempty"`
+	// Result is the job specific outcome, for example the report of the consistency check.
+	Result json.RawMessage `json:"result,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewJobMetadata returns metadata for a new running job.
+func NewJobMetadata(typ JobType, project string, branch string, collection string) *JobMetadata {
+	now := time.Now().UTC()
+
+	return &JobMetadata{
+		Id:         uuid.NewUUIDAsString(),
+		Type:       typ,
+		State:      JobRunning,
+		Project:    project,
+		Branch:     branch,
+		Collection: collection,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// AddRowError records the row failure, only the first MaxJobRowErrors are retained.
+func (j *JobMetadata) AddRowError(rowErr JobRowError) {
+	j.Failed++
+	if len(j.Errors) < MaxJobRowErrors {
+		j.Errors = append(j.Errors, rowErr)
+	}
+}
+
+// IsTerminal returns true if the job is not running anymore.
+func (j *JobMetadata) IsTerminal() bool {
+	return j.State != JobRunning
+}
+
+func newJobStore(nameRegistry *NameRegistry) *JobSubspace {
+	return &JobSubspace{
+		metadataSubspace{
+			SubspaceName: nameRegistry.JobSubspaceName(),
+			KeyVersion:   []byte{jobMetaKeyVersion},
+		},
+	}
+}
+
+func (j *JobSubspace) getKey(nsID uint32, id string) keys.Key {
+	if id == "" {
+		return keys.NewKey(j.SubspaceName, j.KeyVersion, UInt32ToByte(nsID))
+	}
+
+	return keys.NewKey(j.SubspaceName, j.KeyVersion, UInt32ToByte(nsID), id, keyEnd)
+}
+
+func (j *JobSubspace) Create(ctx context.Context, tx transaction.Tx, nsID uint32, job *JobMetadata) error {
+	if err := j.validateArgs(nsID, job); err != nil {
+		return err
+	}
+
+	return j.insertMetadata(ctx, tx,
+		nil,
+		j.getKey(nsID, job.Id),
+		jobMetaValueVersion,
+		job,
+	)
+}
+
+func (j *JobSubspace) Update(ctx context.Context, tx transaction.Tx, nsID uint32, job *JobMetadata) error {
+	if err := j.validateArgs(nsID, job); err != nil {
+		return err
+	}
+
+	job.UpdatedAt = time.Now().UTC()
+
+	return j.updateMetadata(ctx, tx,
+		nil,
+		j.getKey(nsID, job.Id),
+		jobMetaValueVersion,
+		job,
+	)
+}
+
+func (j *JobSubspace) Get(ctx context.Context, tx transaction.Tx, nsID uint32, id string) (*JobMetadata, error) {
+	var job JobMetadata
+
+	if err := j.getMetadata(ctx, tx,
+		j.validateArgs(nsID, &JobMetadata{Id: id}),
+		j.getKey(nsID, id),
+		&job,
+	); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (j *JobSubspace) Delete(ctx context.Context, tx transaction.Tx, nsID uint32, id string) error {
+	return j.deleteMetadata(ctx, tx,
+		j.validateArgs(nsID, &JobMetadata{Id: id}),
+		j.getKey(nsID, id),
+	)
+}
+
+// List returns the jobs of the namespace.
+func (j *JobSubspace) List(ctx context.Context, tx transaction.Tx, nsID uint32) ([]*JobMetadata, error) {
+	it, err := tx.Read(ctx, j.getKey(nsID, ""), false)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		jobs []*JobMetadata
+		v    kv.KeyValue
+	)
+	for it.Next(&v) {
+		job, err := j.decode(v.Data)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, it.Err()
+}
+
+func (*JobSubspace) decode(data *internal.TableData) (*JobMetadata, error) {
+	var job JobMetadata
+	if err := jsoniter.Unmarshal(data.RawData, &job); ulog.E(err) {
+		return nil, errors.Internal("failed to unmarshal job metadata")
+	}
+
+	return &job, nil
+}
+
+func (*JobSubspace) validateArgs(nsID uint32, job *JobMetadata) error {
+	if nsID == 0 {
+		return errors.InvalidArgument("invalid namespace id")
+	}
+
+	if job == nil {
+		return errors.InvalidArgument("invalid nil payload")
+	}
+
+	if job.Id == "" {
+		return errors.InvalidArgument("empty job id")
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/server/transaction"
+)
+
+func initJobTest(t *testing.T) (*JobSubspace, transaction.Tx, func()) {
+	j := newJobStore(newTestNameRegistry(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_ = kvStore.DropTable(ctx, j.SubspaceName)
+
+	tm := transaction.NewManager(kvStore)
+	tx, err := tm.StartTx(ctx)
+	require.NoError(t, err)
+
+	return j, tx, func() {
+		assert.NoError(t, tx.Rollback(ctx))
+
+		_ = kvStore.DropTable(ctx, j.SubspaceName)
+	}
+}
+
+func TestJobSubspace(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		j, tx, cleanup := initJobTest(t)
+		defer cleanup()
+
+		require.Equal(t, errors.InvalidArgument("invalid nil payload"), j.Create(ctx, tx, 1, nil))
+		require.Equal(t, errors.InvalidArgument("invalid namespace id"), j.Create(ctx, tx, 0, &JobMetadata{Id: "a"}))
+		require.Equal(t, errors.InvalidArgument("empty job id"), j.Create(ctx, tx, 1, &JobMetadata{}))
+	})
+
+	t.Run("create_update_list_delete", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		j, tx, cleanup := initJobTest(t)
+		defer cleanup()
+
+		job1 := NewJobMetadata(ImportJobType, "p1", "", "c1")
+		job2 := NewJobMetadata(ExportJobType, "p1", "", "c2")
+		require.NoError(t, j.Create(ctx, tx, 1, job1))
+		require.NoError(t, j.Create(ctx, tx, 1, job2))
+		require.NoError(t, j.Create(ctx, tx, 2, NewJobMetadata(ImportJobType, "p2", "", "c1")))
+
+		// already exists
+		require.Error(t, j.Create(ctx, tx, 1, job1))
+
+		job1.Committed = 10
+		job1.AddRowError(JobRowError{Row: 3, Message: "invalid"})
+		job1.State = JobCompleted
+		require.NoError(t, j.Update(ctx, tx, 1, job1))
+
+		job, err := j.Get(ctx, tx, 1, job1.Id)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), job.Committed)
+		require.Equal(t, int64(1), job.Failed)
+		require.Equal(t, []JobRowError{{Row: 3, Message: "invalid"}}, job.Errors)
+		require.True(t, job.IsTerminal())
+
+		jobs, err := j.List(ctx, tx, 1)
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+
+		require.NoError(t, j.Delete(ctx, tx, 1, job1.Id))
+		_, err = j.Get(ctx, tx, 1, job1.Id)
+		require.Equal(t, errors.ErrNotFound, err)
+	})
+}
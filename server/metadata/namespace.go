@@ -60,12 +60,26 @@ type ProjectMetadata struct {
 	CreatedAt      int64
 	CachesMetadata []CacheMetadata
 	SearchMetadata []SearchMetadata
+	SearchAliases  []SearchAlias
 }
 
 type CacheMetadata struct {
 	Name      string
 	Creator   string
 	CreatedAt int64
+	// MaxKeys and MaxBytes are the limits of the cache enforced by the cache store, zero means unlimited. The
+	// EvictionPolicy selects the keys evicted once the cache reaches its limits.
+	MaxKeys        int64
+	MaxBytes       int64
+	EvictionPolicy string
+	// Collection is set for a cache backed by a collection of the main database of the project. The missing keys are
+	// loaded from the collection by the primary key and the writes to the collection invalidate the cached documents,
+	// or refresh them if WriteThrough is set.
+	Collection   string
+	WriteThrough bool
+	// Deleting is set once the cache is dropped, the cache is hidden while its keys are removed in the background
+	// and the entry is purged once the keys are gone.
+	Deleting bool
 }
 
 type SearchMetadata struct {
@@ -74,6 +88,16 @@ type SearchMetadata struct {
 	CreatedAt int64
 }
 
+// SearchAlias is a stable name that points to a search index. Swapping the index of an alias is how an index is
+// rebuilt without any downtime for the clients that are querying the alias.
+type SearchAlias struct {
+	Name      string
+	Index     string
+	Creator   string
+	CreatedAt int64
+	UpdatedAt int64
+}
+
 // StrId returns id assigned to the namespace.
 func (*DefaultNamespace) StrId() string {
 	return defaults.DefaultNamespaceName
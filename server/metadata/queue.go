@@ -137,6 +137,29 @@ func (q *QueueSubspace) Peek(ctx context.Context, tx transaction.Tx, max int) ([
 	return items, iter.Err()
 }
 
+// PeekMatching is similar to Peek, but returns only the ready items accepted by the match function. The items of
+// the other workers sharing the queue are skipped, so they can't starve the worker of its items.
+func (q *QueueSubspace) PeekMatching(ctx context.Context, tx transaction.Tx, max int, match func(*QueueItem) bool) ([]QueueItem, error) {
+	var items []QueueItem
+	endKey := q.getKey(time.Now(), maxPriority, maxId)
+	iter, err := tx.ReadRange(ctx, nil, endKey, false, false)
+	if err != nil {
+		return items, err
+	}
+	var v kv.KeyValue
+	for len(items) < max && iter.Next(&v) {
+		item, err := q.decodeItem(v.Data)
+		if err != nil {
+			return nil, err
+		}
+		if match(item) {
+			items = append(items, *item)
+		}
+	}
+
+	return items, iter.Err()
+}
+
 func (q *QueueSubspace) Find(ctx context.Context, tx transaction.Tx, item *QueueItem) (*QueueItem, error) {
 	startKey := q.getKey(item.Vesting, 0, item.Id)
 	endKey := q.getKey(item.Vesting, maxPriority, item.Id)
@@ -134,6 +134,30 @@ func TestPriorityInPeek(t *testing.T) {
 	})
 }
 
+func TestPeekMatching(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	queue, tx, cleanup := initQueueTest(t)
+	defer cleanup()
+
+	// the items of the other workers are ahead in the queue
+	for i := 0; i < 20; i++ {
+		assert.NoError(t, queue.Enqueue(ctx, tx, NewQueueItem(0, []byte("other")), 0))
+	}
+	mine := NewQueueItem(0, []byte("mine"))
+	assert.NoError(t, queue.Enqueue(ctx, tx, mine, time.Millisecond))
+
+	time.Sleep(10 * time.Millisecond)
+
+	items, err := queue.PeekMatching(ctx, tx, 2, func(item *QueueItem) bool {
+		return string(item.Data) == "mine"
+	})
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, mine.Id, items[0].Id)
+}
+
 func TestEnqueueObtainComplete(t *testing.T) {
 	item := NewQueueItem(0, []byte("one-item"))
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
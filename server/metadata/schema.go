@@ -125,6 +125,19 @@ func (s *SearchSchemaSubspace) Put(ctx context.Context, tx transaction.Tx, names
 	return schemaPut(ctx, tx, s.getKey(namespaceId, dbId, search, revision), schema, revision)
 }
 
+// Replace is to overwrite an already stored revision of the schema for a given namespace, database and search index.
+func (s *SearchSchemaSubspace) Replace(ctx context.Context, tx transaction.Tx, namespaceId uint32, dbId uint32, search string, schema []byte, revision uint32) error {
+	if revision <= 0 {
+		return errors.InvalidArgument("invalid schema version %d", revision)
+	}
+
+	if len(schema) == 0 {
+		return errors.InvalidArgument("empty schema")
+	}
+
+	return tx.Replace(ctx, s.getKey(namespaceId, dbId, search, revision), internal.NewTableData(schema), false)
+}
+
 // GetLatest returns the latest version stored for a collection inside a given namespace and database.
 func (s *SearchSchemaSubspace) GetLatest(ctx context.Context, tx transaction.Tx, namespaceId uint32, dbId uint32, index string) (*schema.Version, error) {
 	return schemaGetLatest(ctx, tx, s.getKey(namespaceId, dbId, index, 0))
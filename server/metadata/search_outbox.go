@@ -0,0 +1,191 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"hash/fnv"
+	"time"
+
+	"github.com/apple/foundationdb/bindings/go/src/fdb/subspace"
+	"github.com/apple/foundationdb/bindings/go/src/fdb/tuple"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/store/kv"
+	ulog "github.com/tigrisdata/tigris/util/log"
+)
+
+// SearchOutboxSubspace is the transactional outbox of the collection search indexing. The keys of the documents
+// written by a transaction are added to the outbox by the same transaction, the outbox worker then indexes the
+// current state of these documents. The outbox only has the keys and not the documents, so the outbox items are
+// small and an item processed late never indexes an outdated document.
+//
+// The structure of the subspace is:
+//
+//	["search_outbox", 0x01, "item", partition, versionstamp] = SearchOutboxItem
+//	["search_outbox", 0x01, "lease", partition] = SearchOutboxLease
+//
+// where,
+//   - partition is the hash of the table and the primary key, all the writes of a document go to the same partition.
+//   - versionstamp is the commit version of the transaction, the items of a partition are ordered by it.
+//
+// A partition is processed by the worker holding its lease, which makes the writes of a document to be indexed in
+// the commit order.
+type SearchOutboxSubspace struct {
+	metadataSubspace
+}
+
+const (
+	searchOutboxValueVersion int32  = 1
+	searchOutboxKeyVersion   byte   = 1
+	searchOutboxItemKey      string = "item"
+	searchOutboxLeaseKey     string = "lease"
+)
+
+// SearchOutboxEntry is a document written by the transaction.
+type SearchOutboxEntry struct {
+	Namespace string `json:"namespace"`
+	Table     []byte `json:"table"`
+	// Key is the packed primary key of the document.
+	Key []byte `json:"key"`
+}
+
+// SearchOutboxItem is the list of the documents written by a transaction to a single partition.
+type SearchOutboxItem struct {
+	Entries   []SearchOutboxEntry `json:"entries"`
+	CreatedAt time.Time           `json:"created_at"`
+
+	version tuple.Versionstamp
+}
+
+// SearchOutboxLease is the owner of the partition.
+type SearchOutboxLease struct {
+	Owner     string    `json:"owner"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func newSearchOutboxStore(nameRegistry *NameRegistry) *SearchOutboxSubspace {
+	return &SearchOutboxSubspace{
+		metadataSubspace{
+			SubspaceName: nameRegistry.SearchOutboxSubspaceName(),
+			KeyVersion:   []byte{searchOutboxKeyVersion},
+		},
+	}
+}
+
+// Partition returns the partition of the document.
+func (*SearchOutboxSubspace) Partition(table []byte, key []byte, partitions int) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write(table)
+	_, _ = h.Write(key)
+
+	return int64(h.Sum64() % uint64(partitions))
+}
+
+// Add adds the item to the partition, the item becomes visible to the worker once the transaction is committed.
+// It must be called at most once per partition in a transaction as the items are keyed by the commit version.
+func (s *SearchOutboxSubspace) Add(ctx context.Context, tx transaction.Tx, partition int64, item *SearchOutboxItem) error {
+	if len(item.Entries) == 0 {
+		return nil
+	}
+
+	item.CreatedAt = time.Now().UTC()
+	payload, err := jsoniter.Marshal(item)
+	if ulog.E(err) {
+		return errors.Internal("failed to marshal search outbox item")
+	}
+
+	key, err := subspace.FromBytes(s.SubspaceName).PackWithVersionstamp(tuple.Tuple{
+		s.KeyVersion, searchOutboxItemKey, partition, tuple.IncompleteVersionstamp(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	enc, err := internal.Encode(internal.NewTableDataWithVersion(payload, searchOutboxValueVersion))
+	if err != nil {
+		return err
+	}
+
+	return tx.SetVersionstampedKey(ctx, key, enc)
+}
+
+// Peek returns up to max oldest items of the partition.
+func (s *SearchOutboxSubspace) Peek(ctx context.Context, tx transaction.Tx, partition int64, max int) ([]*SearchOutboxItem, error) {
+	it, err := tx.Read(ctx, keys.NewKey(s.SubspaceName, s.KeyVersion, searchOutboxItemKey, partition), false)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		items []*SearchOutboxItem
+		v     kv.KeyValue
+	)
+	for len(items) < max && it.Next(&v) {
+		var item SearchOutboxItem
+		if err = jsoniter.Unmarshal(v.Data.RawData, &item); ulog.E(err) {
+			return nil, errors.Internal("failed to unmarshal search outbox item")
+		}
+
+		version, ok := v.Key[len(v.Key)-1].(tuple.Versionstamp)
+		if !ok {
+			return nil, errors.Internal("unexpected search outbox key '%v'", v.Key)
+		}
+		item.version = version
+
+		items = append(items, &item)
+	}
+
+	return items, it.Err()
+}
+
+// Remove removes the processed items from the partition.
+func (s *SearchOutboxSubspace) Remove(ctx context.Context, tx transaction.Tx, partition int64, items []*SearchOutboxItem) error {
+	for _, item := range items {
+		if err := tx.Delete(ctx, keys.NewKey(s.SubspaceName, s.KeyVersion, searchOutboxItemKey, partition, item.version)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AcquireLease claims or renews the partition for the owner. It returns false if the partition is leased by another
+// owner. The lease is read in the transaction, so if two workers try to claim the partition at the same time only
+// one of the transactions commits.
+func (s *SearchOutboxSubspace) AcquireLease(ctx context.Context, tx transaction.Tx, partition int64, owner string,
+	leaseTime time.Duration,
+) (bool, error) {
+	key := keys.NewKey(s.SubspaceName, s.KeyVersion, searchOutboxLeaseKey, partition)
+
+	var lease SearchOutboxLease
+	err := s.getMetadata(ctx, tx, nil, key, &lease)
+	if err != nil && err != errors.ErrNotFound {
+		return false, err
+	}
+
+	now := time.Now().UTC()
+	if err == nil && lease.Owner != owner && lease.ExpiresAt.After(now) {
+		return false, nil
+	}
+
+	lease.Owner = owner
+	lease.ExpiresAt = now.Add(leaseTime)
+
+	return true, s.updateMetadata(ctx, tx, nil, key, searchOutboxValueVersion, &lease)
+}
@@ -0,0 +1,111 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/server/transaction"
+)
+
+func TestSearchOutbox(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	s := newSearchOutboxStore(newTestNameRegistry(t))
+	_ = kvStore.DropTable(ctx, s.SubspaceName)
+	defer func() {
+		_ = kvStore.DropTable(ctx, s.SubspaceName)
+	}()
+
+	tm := transaction.NewManager(kvStore)
+	withTx := func(fn func(tx transaction.Tx)) {
+		tx, err := tm.StartTx(ctx)
+		require.NoError(t, err)
+		fn(tx)
+		require.NoError(t, tx.Commit(ctx))
+	}
+
+	t.Run("partition", func(t *testing.T) {
+		p := s.Partition([]byte("table"), []byte("key"), 16)
+		require.Equal(t, p, s.Partition([]byte("table"), []byte("key"), 16))
+		require.True(t, p >= 0 && p < 16)
+	})
+
+	t.Run("add_peek_remove", func(t *testing.T) {
+		for _, k := range []string{"k1", "k2", "k3"} {
+			withTx(func(tx transaction.Tx) {
+				require.NoError(t, s.Add(ctx, tx, 1, &SearchOutboxItem{
+					Entries: []SearchOutboxEntry{{Namespace: "ns", Table: []byte("t"), Key: []byte(k)}},
+				}))
+				// empty items are not stored
+				require.NoError(t, s.Add(ctx, tx, 2, &SearchOutboxItem{}))
+			})
+		}
+
+		withTx(func(tx transaction.Tx) {
+			items, err := s.Peek(ctx, tx, 2, 10)
+			require.NoError(t, err)
+			require.Empty(t, items)
+
+			items, err = s.Peek(ctx, tx, 1, 2)
+			require.NoError(t, err)
+			require.Len(t, items, 2)
+			// in the commit order
+			require.Equal(t, []byte("k1"), items[0].Entries[0].Key)
+			require.Equal(t, []byte("k2"), items[1].Entries[0].Key)
+
+			require.NoError(t, s.Remove(ctx, tx, 1, items))
+		})
+
+		withTx(func(tx transaction.Tx) {
+			items, err := s.Peek(ctx, tx, 1, 10)
+			require.NoError(t, err)
+			require.Len(t, items, 1)
+			require.Equal(t, []byte("k3"), items[0].Entries[0].Key)
+		})
+	})
+
+	t.Run("lease", func(t *testing.T) {
+		withTx(func(tx transaction.Tx) {
+			ok, err := s.AcquireLease(ctx, tx, 3, "w1", time.Minute)
+			require.NoError(t, err)
+			require.True(t, ok)
+		})
+
+		withTx(func(tx transaction.Tx) {
+			ok, err := s.AcquireLease(ctx, tx, 3, "w2", time.Minute)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			// renew
+			ok, err = s.AcquireLease(ctx, tx, 3, "w1", time.Millisecond)
+			require.NoError(t, err)
+			require.True(t, ok)
+		})
+
+		time.Sleep(5 * time.Millisecond)
+
+		withTx(func(tx transaction.Tx) {
+			// expired lease is taken over
+			ok, err := s.AcquireLease(ctx, tx, 3, "w2", time.Minute)
+			require.NoError(t, err)
+			require.True(t, ok)
+		})
+	})
+}
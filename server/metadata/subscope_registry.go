@@ -48,6 +48,9 @@ type NameRegistry struct {
 	ClusterSB   string
 	VersionKey  string
 	QueueSB     string
+	JobSB       string
+	// SearchOutboxSB is the outbox of the collection writes which are not yet indexed in the search.
+	SearchOutboxSB string
 
 	BaseCounterValue uint32
 }
@@ -61,6 +64,9 @@ var DefaultNameRegistry = &NameRegistry{
 	NamespaceSB: "namespace",
 	ClusterSB:   "cluster",
 	QueueSB:     "queue",
+	JobSB:       "job",
+
+	SearchOutboxSB: "search_outbox",
 
 	BaseCounterValue: reservedBaseValue,
 }
@@ -97,6 +103,14 @@ func (d *NameRegistry) QueueSubspaceName() []byte {
 	return []byte(d.QueueSB)
 }
 
+func (d *NameRegistry) JobSubspaceName() []byte {
+	return []byte(d.JobSB)
+}
+
+func (d *NameRegistry) SearchOutboxSubspaceName() []byte {
+	return []byte(d.SearchOutboxSB)
+}
+
 func (d *NameRegistry) GetVersionKey() []byte {
 	return []byte(d.VersionKey)
 }
@@ -114,8 +128,11 @@ func newTestNameRegistry(t *testing.T) *NameRegistry {
 		NamespaceSB: "test_namespace_" + s,
 		ClusterSB:   "test_cluster_" + s,
 		QueueSB:     "test_queue_" + s,
+		JobSB:       "test_job_" + s,
 		VersionKey:  "test_version_key" + s,
 
+		SearchOutboxSB: "test_search_outbox_" + s,
+
 		BaseCounterValue: r.Uint32(),
 	}
 }
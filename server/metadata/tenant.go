@@ -203,6 +203,10 @@ func (m *TenantManager) GetEncoder() Encoder {
 	return m.encoder
 }
 
+func (m *TenantManager) GetTxManager() *transaction.Manager {
+	return m.txMgr
+}
+
 // CreateTenant is a thread safe implementation of creating a new tenant. It returns an error if it already exists.
 func (m *TenantManager) CreateTenant(ctx context.Context, tx transaction.Tx, namespace Namespace) (Namespace, error) {
 	m.Lock()
@@ -357,7 +361,7 @@ func (m *TenantManager) GetTenant(ctx context.Context, namespaceId string) (*Ten
 	}
 	metadata, ok := namespaces[namespaceId]
 	if !ok {
-		return nil, fmt.Errorf("namespace not found: %s", namespaceId)
+		return nil, NewNamespaceNotFoundErr(namespaceId)
 	}
 
 	currentVersion, err := m.versionH.Read(ctx, tx, false)
@@ -696,6 +700,12 @@ func (tenant *Tenant) reload(ctx context.Context, tx transaction.Tx, currentVers
 				}
 			}
 		}
+
+		// caches backed by a collection are tracked in memory so that the reads and the writes of the collection don't
+		// need to read the project metadata
+		if p.caches, p.deletingCaches, err = tenant.reloadCaches(ctx, tx, p); err != nil {
+			return err
+		}
 	}
 
 	tenant.version = currentVersion
@@ -756,6 +766,7 @@ func (tenant *Tenant) reloadDatabase(ctx context.Context, tx transaction.Tx, dbN
 			return nil, err
 		}
 		collection.EncodedTableIndexName = encIdxName
+		collection.HistoryVersion = meta.HistoryVersion
 
 		database.collections[coll] = newCollectionHolder(meta.ID, coll, collection, primaryIdxMeta)
 		database.idToCollectionMap[meta.ID] = coll
@@ -764,6 +775,29 @@ func (tenant *Tenant) reloadDatabase(ctx context.Context, tx transaction.Tx, dbN
 	return database, nil
 }
 
+// reloadCaches returns the caches of the project that are backed by a collection and the names of the caches being
+// deleted.
+func (tenant *Tenant) reloadCaches(ctx context.Context, tx transaction.Tx, project *Project) (map[string]*CacheMetadata, map[string]struct{}, error) {
+	projMetadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project.Name())
+	if err != nil {
+		return nil, nil, errors.Internal("failed to get project metadata for project %s", project.Name())
+	}
+
+	caches := make(map[string]*CacheMetadata)
+	deleting := make(map[string]struct{})
+	for i := range projMetadata.CachesMetadata {
+		c := &projMetadata.CachesMetadata[i]
+		switch {
+		case c.Deleting:
+			deleting[c.Name] = struct{}{}
+		case len(c.Collection) > 0:
+			caches[c.Name] = c
+		}
+	}
+
+	return caches, deleting, nil
+}
+
 // reloadSearch is responsible for reloading all the search indexes inside a single project.
 func (tenant *Tenant) reloadSearch(ctx context.Context, tx transaction.Tx, project *Project, searchSchemasSnapshot map[string]*tsApi.CollectionResponse) (*Search, error) {
 	projMetadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project.Name())
@@ -793,6 +827,14 @@ func (tenant *Tenant) reloadSearch(ctx context.Context, tx transaction.Tx, proje
 		searchObj.indexes[searchMD.Name] = schema.NewSearchIndex(schV.Version, searchStoreIndexName, searchFactory, fieldsInSearchStore)
 	}
 
+	for _, alias := range projMetadata.SearchAliases {
+		if _, ok := searchObj.indexes[alias.Index]; !ok {
+			log.Error().Msgf("skipping alias %s, index %s is not found", alias.Name, alias.Index)
+			continue
+		}
+		searchObj.aliases[alias.Name] = alias.Index
+	}
+
 	return searchObj, nil
 }
 
@@ -820,6 +862,10 @@ func (tenant *Tenant) createSearchIndex(ctx context.Context, tx transaction.Tx,
 		return tenant.updateSearchIndex(ctx, tx, project, factory, index)
 	}
 
+	if _, ok := project.search.GetAlias(factory.Name); ok {
+		return NewSearchAliasExistsErr(factory.Name)
+	}
+
 	metadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project.Name())
 	if err != nil {
 		return errors.Internal("failed to get project metadata for project %s", project.Name())
@@ -886,11 +932,78 @@ func (tenant *Tenant) updateSearchIndex(ctx context.Context, tx transaction.Tx,
 	return nil
 }
 
-func (tenant *Tenant) GetSearchIndex(_ context.Context, _ transaction.Tx, project *Project, indexName string) (*schema.SearchIndex, error) {
+// UpdateSearchIndexSettings stores the settings in the schema of the index without creating a new schema version as
+// the settings don't change the fields of the index. The synonyms are not pushed to the search store, the caller
+// needs to call SyncSearchSynonyms once the transaction is committed.
+func (tenant *Tenant) UpdateSearchIndexSettings(ctx context.Context, tx transaction.Tx, project *Project, indexName string, settings schema.SearchSettings) error {
 	tenant.Lock()
 	defer tenant.Unlock()
 
 	index, ok := project.search.GetIndex(indexName)
+	if !ok {
+		return NewSearchIndexNotFoundErr(indexName)
+	}
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	updatedSchema, err := schema.SetSearchSettings(index.Schema, settings)
+	if err != nil {
+		return err
+	}
+	if err = tenant.searchSchemaStore.Replace(ctx, tx, tenant.namespace.Id(), project.id, index.Name, updatedSchema, index.Version); err != nil {
+		return err
+	}
+
+	updatedIndex := *index
+	updatedIndex.Schema = updatedSchema
+	updatedIndex.Settings = settings
+
+	project.search.AddIndex(&updatedIndex)
+	return nil
+}
+
+// SyncSearchSynonyms pushes the changed synonym sets of the index to the search store and deletes the removed ones.
+// It is called after the transaction that changed the index is committed so that the search store never has the
+// synonyms of a change that is rolled back.
+func (tenant *Tenant) SyncSearchSynonyms(ctx context.Context, storeIndexName string, previous []schema.SearchSynonym, current []schema.SearchSynonym) error {
+	existing := make(map[string]schema.SearchSynonym, len(previous))
+	for _, syn := range previous {
+		existing[syn.Id] = syn
+	}
+
+	for _, syn := range current {
+		if prev, ok := existing[syn.Id]; ok && reflect.DeepEqual(prev, syn) {
+			delete(existing, syn.Id)
+			continue
+		}
+		delete(existing, syn.Id)
+
+		synonymSchema := &tsApi.SearchSynonymSchema{Synonyms: syn.Synonyms}
+		if len(syn.Root) > 0 {
+			root := syn.Root
+			synonymSchema.Root = &root
+		}
+		if err := tenant.searchStore.UpsertSynonym(ctx, storeIndexName, syn.Id, synonymSchema); err != nil {
+			return err
+		}
+	}
+
+	for id := range existing {
+		if err := tenant.searchStore.DeleteSynonym(ctx, storeIndexName, id); err != nil && !search.IsErrNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSearchIndex returns the search index, the name can either be the name of the index or an alias of it.
+func (tenant *Tenant) GetSearchIndex(_ context.Context, _ transaction.Tx, project *Project, indexName string) (*schema.SearchIndex, error) {
+	tenant.Lock()
+	defer tenant.Unlock()
+
+	index, ok := project.search.ResolveIndex(indexName)
 	if !ok {
 		return nil, NewSearchIndexNotFoundErr(indexName)
 	}
@@ -906,6 +1019,9 @@ func (tenant *Tenant) DeleteSearchIndex(ctx context.Context, tx transaction.Tx,
 	if !ok {
 		return NewSearchIndexNotFoundErr(indexName)
 	}
+	if aliases := project.search.GetAliasesOf(indexName); len(aliases) > 0 {
+		return errors.InvalidArgument("search index '%s' is used by the alias '%s'", indexName, aliases[0])
+	}
 
 	return tenant.deleteSearchIndex(ctx, tx, project, index)
 }
@@ -929,9 +1045,22 @@ func (tenant *Tenant) deleteSearchIndex(ctx context.Context, tx transaction.Tx,
 
 	metadata.SearchMetadata[foundIdx] = metadata.SearchMetadata[len(metadata.SearchMetadata)-1]
 	metadata.SearchMetadata = metadata.SearchMetadata[:len(metadata.SearchMetadata)-1]
+
+	// the aliases can't outlive the index, this is only possible when the index is deleted as part of the source cleanup
+	aliases := metadata.SearchAliases[:0]
+	for _, alias := range metadata.SearchAliases {
+		if alias.Index != index.Name {
+			aliases = append(aliases, alias)
+		}
+	}
+	metadata.SearchAliases = aliases
+
 	if err = tenant.namespaceStore.UpdateProjectMetadata(ctx, tx, tenant.namespace.Id(), project.name, metadata); err != nil {
 		return errors.Internal("failed to update project metadata for cache deletion")
 	}
+	for _, alias := range project.search.GetAliasesOf(index.Name) {
+		project.search.RemoveAlias(alias)
+	}
 
 	// cleanup all the schemas
 	if err = tenant.searchSchemaStore.Delete(ctx, tx, tenant.namespace.Id(), project.Id(), index.Name); err != nil {
@@ -963,10 +1092,104 @@ func (tenant *Tenant) ListSearchIndexes(_ context.Context, _ transaction.Tx, pro
 	return indexes, nil
 }
 
-func (tenant *Tenant) CreateCache(ctx context.Context, tx transaction.Tx, project string, cache string, currentSub string) (bool, error) {
+// CreateOrUpdateSearchAlias points the alias to the index. If the alias already exists then it is atomically swapped
+// to the index, the previous index is retained and needs to be explicitly dropped.
+func (tenant *Tenant) CreateOrUpdateSearchAlias(ctx context.Context, tx transaction.Tx, project *Project, alias string, indexName string, sub string) error {
+	tenant.Lock()
+	defer tenant.Unlock()
+
+	if _, ok := project.search.GetIndex(alias); ok {
+		return NewSearchIndexExistsErr(alias)
+	}
+	if _, ok := project.search.GetIndex(indexName); !ok {
+		return NewSearchIndexNotFoundErr(indexName)
+	}
+
+	metadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project.Name())
+	if err != nil {
+		return errors.Internal("failed to get project metadata for project %s", project.Name())
+	}
+
+	now := time.Now().Unix()
+	found := false
+	for i := range metadata.SearchAliases {
+		if metadata.SearchAliases[i].Name == alias {
+			metadata.SearchAliases[i].Index = indexName
+			metadata.SearchAliases[i].UpdatedAt = now
+			found = true
+			break
+		}
+	}
+	if !found {
+		metadata.SearchAliases = append(metadata.SearchAliases, SearchAlias{
+			Name:      alias,
+			Index:     indexName,
+			Creator:   sub,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+
+	if err = tenant.namespaceStore.UpdateProjectMetadata(ctx, tx, tenant.namespace.Id(), project.Name(), metadata); err != nil {
+		return errors.Internal("failed to update project metadata for alias update")
+	}
+
+	project.search.SetAlias(alias, indexName)
+
+	return nil
+}
+
+// DeleteSearchAlias removes the alias, the index it was pointing to is not affected.
+func (tenant *Tenant) DeleteSearchAlias(ctx context.Context, tx transaction.Tx, project *Project, alias string) error {
+	tenant.Lock()
+	defer tenant.Unlock()
+
+	metadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project.Name())
+	if err != nil {
+		return errors.Internal("failed to get project metadata for project %s", project.Name())
+	}
+
+	foundIdx := -1
+	for i := range metadata.SearchAliases {
+		if metadata.SearchAliases[i].Name == alias {
+			foundIdx = i
+			break
+		}
+	}
+	if foundIdx == -1 {
+		return NewSearchAliasNotFoundErr(alias)
+	}
+
+	metadata.SearchAliases = append(metadata.SearchAliases[:foundIdx], metadata.SearchAliases[foundIdx+1:]...)
+	if err = tenant.namespaceStore.UpdateProjectMetadata(ctx, tx, tenant.namespace.Id(), project.Name(), metadata); err != nil {
+		return errors.Internal("failed to update project metadata for alias deletion")
+	}
+
+	project.search.RemoveAlias(alias)
+
+	return nil
+}
+
+// ListSearchAliases returns all the aliases of the project.
+func (tenant *Tenant) ListSearchAliases(ctx context.Context, tx transaction.Tx, project *Project) ([]SearchAlias, error) {
 	tenant.Lock()
 	defer tenant.Unlock()
 
+	metadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project.Name())
+	if err != nil {
+		return nil, errors.Internal("failed to get project metadata for project %s", project.Name())
+	}
+
+	return metadata.SearchAliases, nil
+}
+
+// CreateCache adds the cache to the project metadata, the creation time of the cache is set by the method.
+func (tenant *Tenant) CreateCache(ctx context.Context, tx transaction.Tx, project string, cacheMetadata *CacheMetadata) (bool, error) {
+	tenant.Lock()
+	defer tenant.Unlock()
+
+	cache := cacheMetadata.Name
+
 	projMetadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project)
 	if err != nil {
 		return false, errors.Internal("Failed to get project metadata for project %s", project)
@@ -976,14 +1199,16 @@ func (tenant *Tenant) CreateCache(ctx context.Context, tx transaction.Tx, projec
 	}
 	for i := range projMetadata.CachesMetadata {
 		if projMetadata.CachesMetadata[i].Name == cache {
+			if projMetadata.CachesMetadata[i].Deleting {
+				return false, NewCacheDeletingErr(cache)
+			}
 			return false, NewCacheExistsErr(cache)
 		}
 	}
-	projMetadata.CachesMetadata = append(projMetadata.CachesMetadata, CacheMetadata{
-		Name:      cache,
-		Creator:   currentSub,
-		CreatedAt: time.Now().Unix(),
-	})
+	created := *cacheMetadata
+	created.CreatedAt = time.Now().Unix()
+	created.Deleting = false
+	projMetadata.CachesMetadata = append(projMetadata.CachesMetadata, created)
 	err = tenant.namespaceStore.UpdateProjectMetadata(ctx, tx, tenant.namespace.Id(), project, projMetadata)
 	if err != nil {
 		return false, errors.Internal("Failed to update project metadata for cache creation")
@@ -991,23 +1216,41 @@ func (tenant *Tenant) CreateCache(ctx context.Context, tx transaction.Tx, projec
 	return true, nil
 }
 
-func (tenant *Tenant) ListCaches(ctx context.Context, tx transaction.Tx, project string) ([]string, error) {
+// ListCaches returns the metadata of the caches of the project, the caches being deleted are skipped.
+func (tenant *Tenant) ListCaches(ctx context.Context, tx transaction.Tx, project string) ([]CacheMetadata, error) {
 	tenant.Lock()
 	defer tenant.Unlock()
 	projMetadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project)
 	if err != nil {
 		return nil, errors.Internal("Failed to get project metadata for project %s", project)
 	}
-	if projMetadata.CachesMetadata == nil {
-		return []string{}, nil
-	}
-	caches := make([]string, len(projMetadata.CachesMetadata))
+	caches := []CacheMetadata{}
 	for i := range projMetadata.CachesMetadata {
-		caches[i] = projMetadata.CachesMetadata[i].Name
+		if !projMetadata.CachesMetadata[i].Deleting {
+			caches = append(caches, projMetadata.CachesMetadata[i])
+		}
 	}
 	return caches, nil
 }
 
+// GetCache returns the metadata of the cache, a cache being deleted is not found.
+func (tenant *Tenant) GetCache(ctx context.Context, tx transaction.Tx, project string, cache string) (*CacheMetadata, error) {
+	caches, err := tenant.ListCaches(ctx, tx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range caches {
+		if caches[i].Name == cache {
+			return &caches[i], nil
+		}
+	}
+
+	return nil, NewCacheNotFoundErr(cache)
+}
+
+// DeleteCache hides the cache, the cache stays in the project metadata marked as deleting until its keys are removed
+// and PurgeCache is called, so the cache can't be created again while the old keys are still around.
 func (tenant *Tenant) DeleteCache(ctx context.Context, tx transaction.Tx, project string, cache string) (bool, error) {
 	tenant.Lock()
 	defer tenant.Unlock()
@@ -1016,26 +1259,54 @@ func (tenant *Tenant) DeleteCache(ctx context.Context, tx transaction.Tx, projec
 	if err != nil {
 		return false, errors.Internal("Failed to get project metadata for project %s", project)
 	}
-	if projMetadata.CachesMetadata == nil {
-		projMetadata.CachesMetadata = []CacheMetadata{}
+
+	var found bool
+	for i := range projMetadata.CachesMetadata {
+		if projMetadata.CachesMetadata[i].Name == cache && !projMetadata.CachesMetadata[i].Deleting {
+			projMetadata.CachesMetadata[i].Deleting = true
+			found = true
+		}
 	}
+	if !found {
+		return false, NewCacheNotFoundErr(cache)
+	}
+
+	err = tenant.namespaceStore.UpdateProjectMetadata(ctx, tx, tenant.namespace.Id(), project, projMetadata)
+	if err != nil {
+		return false, errors.Internal("Failed to update project metadata for cache deletion")
+	}
+
+	return true, nil
+}
+
+// PurgeCache removes the deleted cache from the project metadata. It returns "false" if the cache is not in the
+// deleting state, for example if the project was dropped meanwhile.
+func (tenant *Tenant) PurgeCache(ctx context.Context, tx transaction.Tx, project string, cache string) (bool, error) {
+	tenant.Lock()
+	defer tenant.Unlock()
+
+	projMetadata, err := tenant.namespaceStore.GetProjectMetadata(ctx, tx, tenant.namespace.Id(), project)
+	if err != nil {
+		return false, errors.Internal("Failed to get project metadata for project %s", project)
+	}
+
 	var tempCachesMetadata []CacheMetadata
 	var found bool
 	for i := range projMetadata.CachesMetadata {
-		if projMetadata.CachesMetadata[i].Name != cache {
-			tempCachesMetadata = append(tempCachesMetadata, projMetadata.CachesMetadata[i])
-		} else {
+		if projMetadata.CachesMetadata[i].Name == cache && projMetadata.CachesMetadata[i].Deleting {
 			found = true
+		} else {
+			tempCachesMetadata = append(tempCachesMetadata, projMetadata.CachesMetadata[i])
 		}
 	}
 	if !found {
-		return false, NewCacheNotFoundErr(cache)
+		return false, nil
 	}
 	projMetadata.CachesMetadata = tempCachesMetadata
 
 	err = tenant.namespaceStore.UpdateProjectMetadata(ctx, tx, tenant.namespace.Id(), project, projMetadata)
 	if err != nil {
-		return false, errors.Internal("Failed to update project metadata for cache deletion")
+		return false, errors.Internal("Failed to update project metadata for cache purge")
 	}
 
 	return true, nil
@@ -1376,6 +1647,7 @@ func (tenant *Tenant) createCollection(ctx context.Context, tx transaction.Tx, d
 	if err != nil {
 		return err
 	}
+	collection.HistoryVersion = collMeta.HistoryVersion
 
 	encName, err := tenant.Encoder.EncodeTableName(tenant.namespace, database, collection)
 	if err != nil {
@@ -1475,6 +1747,7 @@ func (tenant *Tenant) updateCollection(ctx context.Context, tx transaction.Tx, d
 	if err != nil {
 		return err
 	}
+	collection.HistoryVersion = collMeta.HistoryVersion
 
 	encName, err := tenant.Encoder.EncodeTableName(tenant.namespace, database, collection)
 	if err != nil {
@@ -1792,6 +2065,10 @@ type Project struct {
 	search           *Search
 	database         *Database
 	databaseBranches map[string]*Database
+	// caches keeps the caches of the project that are backed by a collection
+	caches map[string]*CacheMetadata
+	// deletingCaches keeps the names of the caches whose keys are being removed
+	deletingCaches map[string]struct{}
 }
 
 // NewProject is to create a project, this is only done during reloading from the database as tenant attaches the main
@@ -1801,6 +2078,8 @@ func NewProject(id uint32, name string) *Project {
 		id:               id,
 		name:             name,
 		databaseBranches: make(map[string]*Database),
+		caches:           make(map[string]*CacheMetadata),
+		deletingCaches:   make(map[string]struct{}),
 	}
 }
 
@@ -1840,6 +2119,29 @@ func (p *Project) GetSearch() *Search {
 	return p.search
 }
 
+// GetCollectionCache returns the metadata of the cache if the cache is backed by a collection, otherwise nil.
+func (p *Project) GetCollectionCache(cache string) *CacheMetadata {
+	return p.caches[cache]
+}
+
+// IsCacheDeleting returns true if the cache is deleted and its keys are not yet removed.
+func (p *Project) IsCacheDeleting(cache string) bool {
+	_, ok := p.deletingCaches[cache]
+	return ok
+}
+
+// GetCachesForCollection returns the caches backed by the collection of the main database.
+func (p *Project) GetCachesForCollection(collection string) []*CacheMetadata {
+	var caches []*CacheMetadata
+	for _, c := range p.caches {
+		if c.Collection == collection {
+			caches = append(caches, c)
+		}
+	}
+
+	return caches
+}
+
 // GetDatabase returns either the main database or a database branch. This depends on the DatabaseName object.
 func (p *Project) GetDatabase(databaseName *DatabaseName) (*Database, error) {
 	if databaseName.IsMainBranch() {
@@ -2056,16 +2358,19 @@ func createCollection(id uint32, name string, schemas schema.Versions, idxMeta m
 	return c, nil
 }
 
-// Search is to manage all the search indexes that are explicitly created by the user.
+// Search is to manage all the search indexes that are explicitly created by the user and the aliases pointing to
+// these indexes.
 type Search struct {
 	sync.RWMutex
 
 	indexes map[string]*schema.SearchIndex
+	aliases map[string]string
 }
 
 func NewSearch() *Search {
 	return &Search{
 		indexes: make(map[string]*schema.SearchIndex),
+		aliases: make(map[string]string),
 	}
 }
 
@@ -2084,6 +2389,60 @@ func (s *Search) GetIndex(name string) (*schema.SearchIndex, bool) {
 	return index, ok
 }
 
+// ResolveIndex returns the index either by its name or by an alias pointing to it.
+func (s *Search) ResolveIndex(name string) (*schema.SearchIndex, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if index, ok := s.indexes[name]; ok {
+		return index, true
+	}
+	if indexName, ok := s.aliases[name]; ok {
+		index, ok := s.indexes[indexName]
+		return index, ok
+	}
+
+	return nil, false
+}
+
+func (s *Search) SetAlias(alias string, indexName string) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.aliases[alias] = indexName
+}
+
+func (s *Search) RemoveAlias(alias string) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.aliases, alias)
+}
+
+// GetAlias returns the name of the index the alias is pointing to.
+func (s *Search) GetAlias(alias string) (string, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	indexName, ok := s.aliases[alias]
+	return indexName, ok
+}
+
+// GetAliasesOf returns all the aliases pointing to the index.
+func (s *Search) GetAliasesOf(indexName string) []string {
+	s.RLock()
+	defer s.RUnlock()
+
+	var aliases []string
+	for alias, name := range s.aliases {
+		if name == indexName {
+			aliases = append(aliases, alias)
+		}
+	}
+
+	return aliases
+}
+
 func (s *Search) GetIndexes() []*schema.SearchIndex {
 	s.RLock()
 	defer s.RUnlock()
@@ -585,6 +585,17 @@ func TestTenantManager_SearchIndexes(t *testing.T) {
 	require.Equal(t, "test_index", index.Name)
 	require.Equal(t, 1, len(proj1.search.GetIndexes()))
 
+	settings := index.Settings.WithSynonym(schema.SearchSynonym{Id: "tv", Synonyms: []string{"tv", "television"}})
+	require.NoError(t, tenant.UpdateSearchIndexSettings(ctx, tx, proj1, "test_index", settings))
+	require.NoError(t, tenant.reload(ctx, tx, nil, indexesInSearchStore))
+
+	proj1, err = tenant.GetProject(tenantProj1)
+	require.NoError(t, err)
+	updated, ok := proj1.search.GetIndex("test_index")
+	require.True(t, ok)
+	require.Equal(t, index.Version, updated.Version)
+	require.Equal(t, settings.Synonyms, updated.Settings.Synonyms)
+
 	require.NoError(t, tenant.DeleteSearchIndex(ctx, tx, proj1, "test_index"))
 	indexesInSearchStore, err = tenant.searchStore.AllCollections(ctx)
 	require.NoError(t, err)
@@ -595,6 +606,91 @@ func TestTenantManager_SearchIndexes(t *testing.T) {
 	testClearDictionary(ctx, m.metaStore, m.kvStore)
 }
 
+func TestTenantManager_SearchAliases(t *testing.T) {
+	tm := transaction.NewManager(kvStore)
+	m, ctx, cancel := NewTestTenantMgr(t, kvStore)
+	defer cancel()
+
+	var err error
+	searchConfig := config.GetTestSearchConfig()
+	searchConfig.AuthKey = "ts_test_key"
+	m.searchStore, err = search.NewStore(searchConfig)
+	require.NoError(t, err)
+
+	_, err = m.CreateOrGetTenant(ctx, &TenantNamespace{"ns-test1", 2, NewNamespaceMetadata(2, "ns-test1", "ns-test1-display_name")})
+	require.NoError(t, err)
+
+	tenant := m.tenants["ns-test1"]
+	tx, err := tm.StartTx(ctx)
+	require.NoError(t, err)
+	err = tenant.CreateProject(ctx, tx, tenantProj1, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, tenant.reload(ctx, tx, nil, nil))
+
+	proj1, err := tenant.GetProject(tenantProj1)
+	require.NoError(t, err)
+
+	jsSchema := []byte(`{
+		"properties": {
+			"K1": {
+				"type": "string"
+			}
+		}
+	}`)
+
+	for _, name := range []string{"products_v1", "products_v2"} {
+		factory, err := schema.NewFactoryBuilder(true).BuildSearch(name, jsSchema)
+		require.NoError(t, err)
+		require.NoError(t, tenant.CreateSearchIndex(ctx, tx, proj1, factory))
+	}
+
+	require.Equal(t, NewSearchIndexNotFoundErr("products_v3"), tenant.CreateOrUpdateSearchAlias(ctx, tx, proj1, "products", "products_v3", ""))
+	require.Equal(t, NewSearchIndexExistsErr("products_v2"), tenant.CreateOrUpdateSearchAlias(ctx, tx, proj1, "products_v2", "products_v1", ""))
+
+	require.NoError(t, tenant.CreateOrUpdateSearchAlias(ctx, tx, proj1, "products", "products_v1", ""))
+	index, err := tenant.GetSearchIndex(ctx, tx, proj1, "products")
+	require.NoError(t, err)
+	require.Equal(t, "products_v1", index.Name)
+
+	// an index can't be created with the name of an alias
+	factory, err := schema.NewFactoryBuilder(true).BuildSearch("products", jsSchema)
+	require.NoError(t, err)
+	require.Equal(t, NewSearchAliasExistsErr("products"), tenant.CreateSearchIndex(ctx, tx, proj1, factory))
+
+	// swap the alias, the previous index is retained
+	require.NoError(t, tenant.CreateOrUpdateSearchAlias(ctx, tx, proj1, "products", "products_v2", ""))
+	require.NoError(t, tenant.reload(ctx, tx, nil, nil))
+	proj1, err = tenant.GetProject(tenantProj1)
+	require.NoError(t, err)
+
+	index, err = tenant.GetSearchIndex(ctx, tx, proj1, "products")
+	require.NoError(t, err)
+	require.Equal(t, "products_v2", index.Name)
+	_, ok := proj1.search.GetIndex("products_v1")
+	require.True(t, ok)
+
+	aliases, err := tenant.ListSearchAliases(ctx, tx, proj1)
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+	require.Equal(t, "products", aliases[0].Name)
+	require.Equal(t, "products_v2", aliases[0].Index)
+
+	// the index used by an alias can't be dropped
+	require.Error(t, tenant.DeleteSearchIndex(ctx, tx, proj1, "products_v2"))
+	require.NoError(t, tenant.DeleteSearchIndex(ctx, tx, proj1, "products_v1"))
+
+	require.NoError(t, tenant.DeleteSearchAlias(ctx, tx, proj1, "products"))
+	require.Equal(t, NewSearchAliasNotFoundErr("products"), tenant.DeleteSearchAlias(ctx, tx, proj1, "products"))
+	_, err = tenant.GetSearchIndex(ctx, tx, proj1, "products")
+	require.Equal(t, NewSearchIndexNotFoundErr("products"), err)
+	require.NoError(t, tenant.DeleteSearchIndex(ctx, tx, proj1, "products_v2"))
+
+	require.NoError(t, tx.Commit(ctx))
+
+	testClearDictionary(ctx, m.metaStore, m.kvStore)
+}
+
 func TestTenantManager_SecondaryIndexes(t *testing.T) {
 	tm := transaction.NewManager(kvStore)
 	t.Run("create_collections", func(t *testing.T) {
@@ -209,6 +209,10 @@ func (m *Measurement) GetSearchIndexSizeTagKeys() map[string]string {
 	return filterTags(standardizeTags(m.tags, getSearchIndexSizeTagKeys()), config.DefaultConfig.Metrics.Size.FilteredTags)
 }
 
+func (m *Measurement) GetCacheSizeTagKeys() map[string]string {
+	return filterTags(standardizeTags(m.tags, getCacheSizeTagKeys()), config.DefaultConfig.Metrics.Size.FilteredTags)
+}
+
 func (m *Measurement) GetNetworkTags() map[string]string {
 	return filterTags(standardizeTags(m.tags, getNetworkTagKeys()), config.DefaultConfig.Metrics.Network.FilteredTags)
 }
@@ -231,6 +231,12 @@ func TestTracing(t *testing.T) {
 			assert.NotNil(t, searchIndexSizeTags[key])
 		}
 		assert.Equal(t, len(getSearchIndexSizeTagKeys()), len(searchIndexSizeTags))
+
+		cacheSizeTags := measurement.GetCacheSizeTagKeys()
+		for _, key := range getCacheSizeTagKeys() {
+			assert.NotNil(t, cacheSizeTags[key])
+		}
+		assert.Equal(t, len(getCacheSizeTagKeys()), len(cacheSizeTags))
 	})
 
 	t.Run("Test network tags", func(t *testing.T) {
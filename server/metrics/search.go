@@ -15,6 +15,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/uber-go/tally"
 )
 
@@ -23,6 +25,7 @@ var (
 	SearchErrorCount    tally.Scope
 	SearchRespTime      tally.Scope
 	SearchErrorRespTime tally.Scope
+	SearchOutbox        tally.Scope
 )
 
 func getSearchOkTagKeys() []string {
@@ -58,6 +61,25 @@ func initializeSearchScopes() {
 	SearchErrorCount = SearchMetrics.SubScope("count")
 	SearchRespTime = SearchMetrics.SubScope("response")
 	SearchErrorRespTime = SearchMetrics.SubScope("error_response")
+	SearchOutbox = SearchMetrics.SubScope("outbox")
+}
+
+// SearchOutboxIndexed records the batch indexed by the outbox worker. The lag is the time since the oldest write
+// of the batch was committed.
+func SearchOutboxIndexed(indexed int, dropped int, lag time.Duration) {
+	if SearchOutbox != nil {
+		SearchOutbox.Counter("indexed").Inc(int64(indexed))
+		SearchOutbox.Counter("dropped").Inc(int64(dropped))
+		SearchOutbox.Gauge("lag_seconds").Update(lag.Seconds())
+		SearchOutbox.Timer("lag").Record(lag)
+	}
+}
+
+// SearchOutboxRetry records the failed outbox batch which is retried later.
+func SearchOutboxRetry() {
+	if SearchOutbox != nil {
+		SearchOutbox.Counter("retries").Inc(1)
+	}
 }
 
 func GetSearchTags(reqMethodName string) map[string]string {
@@ -16,6 +16,7 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tigrisdata/tigris/server/config"
@@ -47,6 +48,11 @@ func TestSearchMetrics(t *testing.T) {
 		}
 	})
 
+	t.Run("Test Search outbox", func(t *testing.T) {
+		SearchOutboxIndexed(10, 1, time.Second)
+		SearchOutboxRetry()
+	})
+
 	t.Run("Test Search timers", func(t *testing.T) {
 		testTimerTags := GetSearchTags("IndexDocuments")
 		defer SearchRespTime.Tagged(testTimerTags).Timer("time").Start().Stop()
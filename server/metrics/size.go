@@ -24,6 +24,7 @@ var (
 	CollectionSize  tally.Scope
 	SearchSize      tally.Scope
 	SearchIndexSize tally.Scope
+	CacheSize       tally.Scope
 )
 
 func initializeSizeScopes() {
@@ -32,6 +33,7 @@ func initializeSizeScopes() {
 	CollectionSize = SizeMetrics.SubScope("collection")
 	SearchSize = SizeMetrics.SubScope("search")
 	SearchIndexSize = SizeMetrics.SubScope("search_index")
+	CacheSize = SizeMetrics.SubScope("cache")
 }
 
 func getNameSpaceSizeTagKeys() []string {
@@ -94,6 +96,18 @@ func getSearchIndexSizeTagKeys() []string {
 	}
 }
 
+func getCacheSizeTagKeys() []string {
+	return []string{
+		"env",
+		"service",
+		"tigris_tenant",
+		"tigris_tenant_name",
+		"version",
+		"project",
+		"cache",
+	}
+}
+
 func getNamespaceSizeTags(namespace string, namespaceName string) map[string]string {
 	return map[string]string{
 		"tigris_tenant":      namespace,
@@ -168,3 +182,25 @@ func UpdateSearchIndexSizeMetrics(namespace string, namespaceName string, projec
 		SearchIndexSize.Tagged(getSearchIndexSizeTags(namespace, namespaceName, projectName, indexName)).Gauge("bytes").Update(float64(size))
 	}
 }
+
+func getCacheSizeTags(namespace string, namespaceName string, projectName string, cacheName string) map[string]string {
+	return map[string]string{
+		"tigris_tenant":      namespace,
+		"tigris_tenant_name": GetTenantNameTagValue(namespace, namespaceName),
+		"project":            projectName,
+		"cache":              cacheName,
+	}
+}
+
+// UpdateCacheSizeMetrics reports the usage of the cache, the hits, misses and evictions are the totals since the cache
+// was created.
+func UpdateCacheSizeMetrics(namespace string, namespaceName string, projectName string, cacheName string, keys int64, size int64, hits int64, misses int64, evictions int64) {
+	if NamespaceSize != nil {
+		scope := CacheSize.Tagged(getCacheSizeTags(namespace, namespaceName, projectName, cacheName))
+		scope.Gauge("bytes").Update(float64(size))
+		scope.Gauge("keys").Update(float64(keys))
+		scope.Gauge("hits").Update(float64(hits))
+		scope.Gauge("misses").Update(float64(misses))
+		scope.Gauge("evictions").Update(float64(evictions))
+	}
+}
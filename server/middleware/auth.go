@@ -44,6 +44,16 @@ var (
 		api.HealthMethodName,
 		api.GetAccessTokenMethodName,
 	)
+	// the methods allowed to the tokens issued to the realtime clients, their channel capabilities are checked by the
+	// realtime service
+	realtimeTokenMethods = container.NewHashSet(
+		api.PresenceMethodName,
+		api.GetRTChannelMethodName,
+		api.ReadMessagesMethodName,
+		api.MessagesMethodName,
+		api.GetPresenceMethodName,
+		api.HistoryMethodName,
+	)
 )
 
 type Namespace struct {
@@ -72,6 +82,8 @@ type TigrisClaims struct {
 	NamespaceDisplayName string `json:"nd"`
 	Project              string `json:"-"`
 	UserEmail            string `json:"ue"`
+	// Realtime is only set in the tokens issued to the realtime clients
+	Realtime *types.RealtimeAccess `json:"rt,omitempty"`
 }
 
 func AuthFromMD(ctx context.Context, expectedScheme string) (string, error) {
@@ -107,8 +119,9 @@ func GetJWTValidators(config *config.Config) []*validator.Validator {
 			provider := jwks.NewCachingProvider(issuerURL, config.Auth.JWKSCacheTimeout)
 			keyFunc = provider.KeyFunc
 		case validator.HS256:
+			key := config.Auth.ValidatorKey(&config.Auth.Validators[i])
 			keyFunc = func(ctx context.Context) (any, error) {
-				return []byte(config.Auth.Gotrue.SharedSecret), nil
+				return key, nil
 			}
 		default:
 			panic(fmt.Sprintf("Unsupported Token signature algorithm: %s", validatorCfg.Algorithm))
@@ -224,10 +237,16 @@ func authFunction(ctx context.Context, jwtValidators []*validator.Validator, con
 				return ctx, errors.Unauthenticated("You are not authorized to perform this action")
 			}
 
+			realtimeAccess := customClaims.TigrisClaims.Realtime
+			if realtimeAccess != nil && fullMethodNameFound && !realtimeTokenMethods.Contains(fullMethodName) {
+				return ctx, errors.PermissionDenied("realtime token is not allowed to perform this action")
+			}
+
 			log.Debug().Msg("Valid token received")
 			token := &types.AccessToken{
 				Namespace: namespaceCode,
 				Sub:       validatedClaims.RegisteredClaims.Subject,
+				Realtime:  realtimeAccess,
 			}
 			reqMetadata.SetAccessToken(token)
 			// update cache
@@ -40,6 +40,8 @@ var (
 		api.CountMethodName,
 		api.ExplainMethodName,
 		api.SearchMethodName,
+		api.GetImportJobMethodName,
+		api.GetExportJobMethodName,
 		api.ListProjectsMethodName,
 		api.DescribeDatabaseMethodName,
 		api.DescribeCollectionMethodName,
@@ -51,8 +53,14 @@ var (
 
 		// cache
 		api.ListCachesMethodName,
+		api.DescribeCacheMethodName,
 		api.GetMethodName,
 		api.KeysMethodName,
+		api.MGetMethodName,
+		api.HGetMethodName,
+		api.HGetAllMethodName,
+		api.LRangeMethodName,
+		api.ZRangeMethodName,
 
 		// health
 		api.HealthMethodName,
@@ -68,6 +76,8 @@ var (
 
 		// realtime
 		api.ReadMessagesMethodName,
+		api.GetPresenceMethodName,
+		api.HistoryMethodName,
 	)
 
 	// editor.
@@ -86,6 +96,10 @@ var (
 		api.ExplainMethodName,
 		api.SearchMethodName,
 		api.ImportMethodName,
+		api.ImportStreamMethodName,
+		api.GetImportJobMethodName,
+		api.ExportMethodName,
+		api.GetExportJobMethodName,
 		api.CreateOrUpdateCollectionMethodName,
 		api.CreateOrUpdateCollectionsMethodName,
 		api.DropCollectionMethodName,
@@ -115,12 +129,29 @@ var (
 		// cache
 		api.CreateCacheMethodName,
 		api.ListCachesMethodName,
+		api.DescribeCacheMethodName,
 		api.DeleteCacheMethodName,
 		api.SetMethodName,
 		api.GetSetMethodName,
 		api.GetMethodName,
 		api.DelMethodName,
 		api.KeysMethodName,
+		api.MGetMethodName,
+		api.MSetMethodName,
+		api.IncrMethodName,
+		api.DecrMethodName,
+		api.CompareAndSetMethodName,
+		api.HSetMethodName,
+		api.HGetMethodName,
+		api.HGetAllMethodName,
+		api.HDelMethodName,
+		api.LPushMethodName,
+		api.RPushMethodName,
+		api.LPopMethodName,
+		api.RPopMethodName,
+		api.LRangeMethodName,
+		api.ZAddMethodName,
+		api.ZRangeMethodName,
 
 		// health
 		api.HealthMethodName,
@@ -144,6 +175,10 @@ var (
 		api.ReadMessagesMethodName,
 		api.MessagesMethodName,
 		api.ListSubscriptionsMethodName,
+		api.GetPresenceMethodName,
+		api.UpdateRTChannelMethodName,
+		api.HistoryMethodName,
+		api.CreateRTTokenMethodName,
 	)
 
 	ownerMethods = container.NewHashSet(
@@ -161,6 +196,10 @@ var (
 		api.ExplainMethodName,
 		api.SearchMethodName,
 		api.ImportMethodName,
+		api.ImportStreamMethodName,
+		api.GetImportJobMethodName,
+		api.ExportMethodName,
+		api.GetExportJobMethodName,
 		api.CreateOrUpdateCollectionMethodName,
 		api.CreateOrUpdateCollectionsMethodName,
 
@@ -200,12 +239,29 @@ var (
 		// cache
 		api.CreateCacheMethodName,
 		api.ListCachesMethodName,
+		api.DescribeCacheMethodName,
 		api.DeleteCacheMethodName,
 		api.SetMethodName,
 		api.GetSetMethodName,
 		api.GetMethodName,
 		api.DelMethodName,
 		api.KeysMethodName,
+		api.MGetMethodName,
+		api.MSetMethodName,
+		api.IncrMethodName,
+		api.DecrMethodName,
+		api.CompareAndSetMethodName,
+		api.HSetMethodName,
+		api.HGetMethodName,
+		api.HGetAllMethodName,
+		api.HDelMethodName,
+		api.LPushMethodName,
+		api.RPushMethodName,
+		api.LPopMethodName,
+		api.RPopMethodName,
+		api.LRangeMethodName,
+		api.ZAddMethodName,
+		api.ZRangeMethodName,
 
 		// health
 		api.HealthMethodName,
@@ -229,6 +285,10 @@ var (
 		api.ReadMessagesMethodName,
 		api.MessagesMethodName,
 		api.ListSubscriptionsMethodName,
+		api.GetPresenceMethodName,
+		api.UpdateRTChannelMethodName,
+		api.HistoryMethodName,
+		api.CreateRTTokenMethodName,
 	)
 	clusterAdminMethods = container.NewHashSet(
 		// db
@@ -245,6 +305,10 @@ var (
 		api.ExplainMethodName,
 		api.SearchMethodName,
 		api.ImportMethodName,
+		api.ImportStreamMethodName,
+		api.GetImportJobMethodName,
+		api.ExportMethodName,
+		api.GetExportJobMethodName,
 		api.CreateOrUpdateCollectionMethodName,
 		api.DropCollectionMethodName,
 		api.ListProjectsMethodName,
@@ -276,12 +340,29 @@ var (
 		// cache
 		api.CreateCacheMethodName,
 		api.ListCachesMethodName,
+		api.DescribeCacheMethodName,
 		api.DeleteCacheMethodName,
 		api.SetMethodName,
 		api.GetSetMethodName,
 		api.GetMethodName,
 		api.DelMethodName,
 		api.KeysMethodName,
+		api.MGetMethodName,
+		api.MSetMethodName,
+		api.IncrMethodName,
+		api.DecrMethodName,
+		api.CompareAndSetMethodName,
+		api.HSetMethodName,
+		api.HGetMethodName,
+		api.HGetAllMethodName,
+		api.HDelMethodName,
+		api.LPushMethodName,
+		api.RPushMethodName,
+		api.LPopMethodName,
+		api.RPopMethodName,
+		api.LRangeMethodName,
+		api.ZAddMethodName,
+		api.ZRangeMethodName,
 
 		// health
 		api.HealthMethodName,
@@ -296,6 +377,8 @@ var (
 		api.ListNamespacesMethodName,
 		api.CreateNamespaceMethodName,
 		api.DeleteNamespaceMethodName,
+		api.ListTransactionsMethodName,
+		api.KillTransactionMethodName,
 
 		// observability
 		api.QueryTimeSeriesMetricsMethodName,
@@ -308,6 +391,10 @@ var (
 		api.ReadMessagesMethodName,
 		api.MessagesMethodName,
 		api.ListSubscriptionsMethodName,
+		api.GetPresenceMethodName,
+		api.UpdateRTChannelMethodName,
+		api.HistoryMethodName,
+		api.CreateRTTokenMethodName,
 	)
 )
 
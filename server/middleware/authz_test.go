@@ -73,12 +73,29 @@ func TestAuthzOwnerRole(t *testing.T) {
 	// cache
 	require.True(t, isAuthorized(api.CreateCacheMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.ListCachesMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.DescribeCacheMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.DeleteCacheMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.SetMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.GetSetMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.GetMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.DelMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.KeysMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.MGetMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.MSetMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.IncrMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.DecrMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.CompareAndSetMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.HSetMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.HGetMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.HGetAllMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.HDelMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.LPushMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.RPushMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.LPopMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.RPopMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.LRangeMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.ZAddMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.ZRangeMethodName, ownerRoleName))
 
 	// health
 	require.True(t, isAuthorized(api.HealthMethodName, ownerRoleName))
@@ -102,6 +119,10 @@ func TestAuthzOwnerRole(t *testing.T) {
 	require.True(t, isAuthorized(api.ReadMessagesMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.MessagesMethodName, ownerRoleName))
 	require.True(t, isAuthorized(api.ListSubscriptionsMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.GetPresenceMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.UpdateRTChannelMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.HistoryMethodName, ownerRoleName))
+	require.True(t, isAuthorized(api.CreateRTTokenMethodName, ownerRoleName))
 
 	// negative
 	require.False(t, isAuthorized(api.VerifyInvitationMethodName, ownerRoleName))
@@ -154,12 +175,29 @@ func TestAuthzEditorRole(t *testing.T) {
 	// cache
 	require.True(t, isAuthorized(api.CreateCacheMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.ListCachesMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.DescribeCacheMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.DeleteCacheMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.SetMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.GetSetMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.GetMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.DelMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.KeysMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.MGetMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.MSetMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.IncrMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.DecrMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.CompareAndSetMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.HSetMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.HGetMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.HGetAllMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.HDelMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.LPushMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.RPushMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.LPopMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.RPopMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.LRangeMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.ZAddMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.ZRangeMethodName, editorRoleName))
 
 	// health
 	require.True(t, isAuthorized(api.HealthMethodName, editorRoleName))
@@ -183,6 +221,10 @@ func TestAuthzEditorRole(t *testing.T) {
 	require.True(t, isAuthorized(api.ReadMessagesMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.MessagesMethodName, editorRoleName))
 	require.True(t, isAuthorized(api.ListSubscriptionsMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.GetPresenceMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.UpdateRTChannelMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.HistoryMethodName, editorRoleName))
+	require.True(t, isAuthorized(api.CreateRTTokenMethodName, editorRoleName))
 
 	// negative
 	require.False(t, isAuthorized(api.ListUsersMethodName, editorRoleName))
@@ -215,8 +257,14 @@ func TestAuthzReadOnlyRole(t *testing.T) {
 
 	// cache
 	require.True(t, isAuthorized(api.ListCachesMethodName, readOnlyRoleName))
+	require.True(t, isAuthorized(api.DescribeCacheMethodName, readOnlyRoleName))
 	require.True(t, isAuthorized(api.GetMethodName, readOnlyRoleName))
 	require.True(t, isAuthorized(api.KeysMethodName, readOnlyRoleName))
+	require.True(t, isAuthorized(api.MGetMethodName, readOnlyRoleName))
+	require.True(t, isAuthorized(api.HGetMethodName, readOnlyRoleName))
+	require.True(t, isAuthorized(api.HGetAllMethodName, readOnlyRoleName))
+	require.True(t, isAuthorized(api.LRangeMethodName, readOnlyRoleName))
+	require.True(t, isAuthorized(api.ZRangeMethodName, readOnlyRoleName))
 
 	// health
 	require.True(t, isAuthorized(api.HealthMethodName, readOnlyRoleName))
@@ -232,6 +280,8 @@ func TestAuthzReadOnlyRole(t *testing.T) {
 
 	// realtime
 	require.True(t, isAuthorized(api.ReadMessagesMethodName, readOnlyRoleName))
+	require.True(t, isAuthorized(api.GetPresenceMethodName, readOnlyRoleName))
+	require.True(t, isAuthorized(api.HistoryMethodName, readOnlyRoleName))
 
 	// negative
 	require.False(t, isAuthorized(api.BeginTransactionMethodName, readOnlyRoleName))
@@ -249,6 +299,19 @@ func TestAuthzReadOnlyRole(t *testing.T) {
 	require.False(t, isAuthorized(api.SetMethodName, readOnlyRoleName))
 	require.False(t, isAuthorized(api.GetSetMethodName, readOnlyRoleName))
 	require.False(t, isAuthorized(api.DelMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.MSetMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.IncrMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.DecrMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.CompareAndSetMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.HSetMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.HDelMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.LPushMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.RPushMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.LPopMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.RPopMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.ZAddMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.UpdateRTChannelMethodName, readOnlyRoleName))
+	require.False(t, isAuthorized(api.CreateRTTokenMethodName, readOnlyRoleName))
 	require.False(t, isAuthorized(api.CreateBranchMethodName, readOnlyRoleName))
 	require.False(t, isAuthorized(api.DeleteBranchMethodName, readOnlyRoleName))
 	require.False(t, isAuthorized(api.CreateAppKeyMethodName, readOnlyRoleName))
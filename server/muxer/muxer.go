@@ -35,7 +35,8 @@ type Server interface {
 }
 
 type Muxer struct {
-	servers []Server
+	servers  []Server
+	services []v1.Service
 }
 
 func NewMuxer(cfg *config.Config) *Muxer {
@@ -49,6 +50,7 @@ func (m *Muxer) RegisterServices(cfg *config.ServerConfig, kvStore kv.TxStore, s
 	} else {
 		services = v1.GetRegisteredServices(kvStore, searchStore, tenantMgr, txMgr, forSearchTxMgr, biller)
 	}
+	m.services = services
 	for _, r := range services {
 		for _, v := range m.servers {
 			if s, ok := v.(*GRPCServer); ok {
@@ -77,5 +79,17 @@ func (m *Muxer) Start(host string, port int16) error {
 		_ = s.Start(cm)
 	}
 	log.Info().Msg("server started, servicing requests")
-	return cm.Serve()
+	err = cm.Serve()
+	m.stopServices()
+
+	return err
+}
+
+// stopServices stops the background workers of the services.
+func (m *Muxer) stopServices() {
+	for _, s := range m.services {
+		if stopper, ok := s.(v1.Stopper); ok {
+			stopper.Stop()
+		}
+	}
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/tigrisdata/tigris/server/config"
 	"github.com/tigrisdata/tigris/server/metadata"
 	"github.com/tigrisdata/tigris/server/metrics"
+	"github.com/tigrisdata/tigris/store/cache"
 )
 
 var (
@@ -81,7 +82,7 @@ func initManager(tm *metadata.TenantManager, cfg *config.Config) *Manager {
 	// metrics calculation is piggybacked to storage quota, so initialize
 	// storage quota manager even when quota is disabled, but metrics are enabled
 	if cfg.Quota.Storage.Enabled || cfg.Metrics.Size.Enabled {
-		q = append(q, initStorage(tm, &cfg.Quota))
+		q = append(q, initStorage(tm, cache.NewCache(&cfg.Cache), &cfg.Quota))
 	}
 
 	if cfg.Quota.Node.Enabled {
@@ -26,6 +26,7 @@ import (
 	"github.com/tigrisdata/tigris/server/metadata"
 	"github.com/tigrisdata/tigris/server/metrics"
 	"github.com/tigrisdata/tigris/server/request"
+	"github.com/tigrisdata/tigris/store/cache"
 	ulog "github.com/tigrisdata/tigris/util/log"
 	"go.uber.org/atomic"
 )
@@ -34,6 +35,8 @@ type storage struct {
 	tenantQuota sync.Map
 	cfg         *config.QuotaConfig
 	tenantMgr   *metadata.TenantManager
+	cacheStore  cache.Cache
+	encoder     metadata.CacheEncoder
 
 	wg     sync.WaitGroup
 	ctx    context.Context
@@ -107,12 +110,20 @@ func (s *storage) checkStorage(namespace string, ss *storageState, size int) err
 	return nil
 }
 
-func initStorage(tm *metadata.TenantManager, cfg *config.QuotaConfig) *storage {
+// initStorage starts the storage quota manager, the usage of the caches is accounted only if the cache store is set.
+func initStorage(tm *metadata.TenantManager, cacheStore cache.Cache, cfg *config.QuotaConfig) *storage {
 	log.Debug().Msg("Initializing storage quota manager")
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	s := &storage{tenantMgr: tm, ctx: ctx, cancel: cancel, cfg: cfg}
+	s := &storage{
+		tenantMgr:  tm,
+		cacheStore: cacheStore,
+		encoder:    metadata.NewCacheEncoder(),
+		ctx:        ctx,
+		cancel:     cancel,
+		cfg:        cfg,
+	}
 
 	s.wg.Add(1)
 
@@ -158,6 +169,43 @@ func getSearchIndexSize(ctx context.Context, tenant *metadata.Tenant, index *sch
 	return searchIndexSize.StoredBytes
 }
 
+// updateCacheMetrics reports the usage of the caches of the project and returns the memory used by them.
+func (s *storage) updateCacheMetrics(ctx context.Context, namespace string, tenantName string, tenant *metadata.Tenant, project *metadata.Project) int64 {
+	if s.cacheStore == nil {
+		return 0
+	}
+
+	tx, err := s.tenantMgr.GetTxManager().StartTx(ctx)
+	if ulog.E(err) {
+		return 0
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	caches, err := tenant.ListCaches(ctx, tx, project.Name())
+	if ulog.E(err) {
+		return 0
+	}
+
+	var size int64
+	for _, c := range caches {
+		tableName, err := s.encoder.EncodeCacheTableName(tenant.GetNamespace().Id(), project.Id(), c.Name)
+		if ulog.E(err) {
+			continue
+		}
+
+		stats, err := s.cacheStore.Stats(ctx, tableName)
+		if ulog.E(err) {
+			continue
+		}
+
+		metrics.UpdateCacheSizeMetrics(namespace, tenantName, project.Name(), c.Name,
+			stats.Keys, stats.Bytes, stats.Hits, stats.Misses, stats.Evictions)
+		size += stats.Bytes
+	}
+
+	return size
+}
+
 func (s *storage) getTenantSize(ctx context.Context, namespace string) int64 {
 	tenant, err := s.tenantMgr.GetTenant(ctx, namespace)
 	if ulog.E(err) {
@@ -179,6 +227,8 @@ func (s *storage) updateMetricsForNamespace(ctx context.Context, namespace strin
 	}
 	tenantName := tenant.GetNamespace().Metadata().Name
 
+	// the memory used by the caches counts towards the storage quota of the namespace
+	var cacheSize int64
 	for _, projName := range tenant.ListProjects(ctx) {
 		project, err := tenant.GetProject(projName)
 		if err != nil {
@@ -200,9 +250,11 @@ func (s *storage) updateMetricsForNamespace(ctx context.Context, namespace strin
 		}
 
 		metrics.UpdateSearchSizeMetrics(namespace, tenantName, projName, getProjectSearchSize(ctx, tenant, project))
+
+		cacheSize += s.updateCacheMetrics(ctx, namespace, tenantName, tenant, project)
 	}
 
-	dsz := s.getTenantSize(ctx, namespace)
+	dsz := s.getTenantSize(ctx, namespace) + cacheSize
 
 	metrics.UpdateNameSpaceSizeMetrics(namespace, tenantName, dsz)
 
@@ -75,7 +75,7 @@ func TestStorageQuota(t *testing.T) {
 	table, err := metadata.NewEncoder().EncodeTableName(tenant.GetNamespace(), proj1.GetMainDatabase(), coll1)
 	require.NoError(t, err)
 
-	m := initStorage(tenants, &config.QuotaConfig{
+	m := initStorage(tenants, nil, &config.QuotaConfig{
 		Storage: config.StorageLimitsConfig{
 			Enabled:         true,
 			RefreshInterval: 50 * time.Millisecond,
@@ -47,7 +47,7 @@ const (
 )
 
 var (
-	adminMethods = container.NewHashSet(api.CreateNamespaceMethodName, api.ListNamespacesMethodName, api.DeleteNamespaceMethodName, api.VerifyInvitationMethodName)
+	adminMethods = container.NewHashSet(api.CreateNamespaceMethodName, api.ListNamespacesMethodName, api.DeleteNamespaceMethodName, api.VerifyInvitationMethodName, api.ListTransactionsMethodName, api.KillTransactionMethodName)
 	tenantGetter metadata.TenantGetter
 )
 
@@ -415,7 +415,7 @@ func isRead(name string) bool {
 	}
 
 	switch name {
-	case api.ReadMethodName, api.SearchMethodName:
+	case api.ReadMethodName, api.SearchMethodName, api.GetImportJobMethodName, api.GetExportJobMethodName:
 		return true
 	case api.ListCollectionsMethodName, api.ListProjectsMethodName:
 		return true
@@ -24,6 +24,7 @@ import (
 
 const (
 	matchedTokensKey = "matched_tokens"
+	snippetKey       = "snippet"
 )
 
 type Hits struct {
@@ -95,12 +96,22 @@ func NewSearchHit(tsHit *tsApi.SearchResultHit) *Hit {
 		fromHighlights(*tsHit.Highlights, &fields)
 	}
 
+	// the distance in meters from the point of the sort by distance, keyed by the geopoint field
+	var geoDistance map[string]float64
+	if tsHit.GeoDistanceMeters != nil {
+		geoDistance = make(map[string]float64, len(*tsHit.GeoDistanceMeters))
+		for field, meters := range *tsHit.GeoDistanceMeters {
+			geoDistance[field] = float64(meters)
+		}
+	}
+
 	return &Hit{
 		Document: *tsHit.Document,
 		Match: &api.Match{
 			Fields:         fields,
 			Score:          score,
 			VectorDistance: tsHit.VectorDistance,
+			GeoDistance:    geoDistance,
 		},
 	}
 }
@@ -111,9 +122,16 @@ func fromHighlights(highlights []tsApi.SearchHighlight, fields *[]*api.MatchFiel
 		if f.Field != nil {
 			name = *f.Field
 		}
-		*fields = append(*fields, &api.MatchField{
+		field := &api.MatchField{
 			Name: name,
-		})
+		}
+		if f.Snippet != nil {
+			field.Snippet = *f.Snippet
+		}
+		if f.Snippets != nil {
+			field.Snippets = *f.Snippets
+		}
+		*fields = append(*fields, field)
 	}
 }
 
@@ -124,22 +142,23 @@ func fromHighlight(highlight map[string]any, fields *[]*api.MatchField) {
 			// any non array match should just fall in this "if"
 			if isMatchedToken(mp) {
 				*fields = append(*fields, &api.MatchField{
-					Name: name,
+					Name:    name,
+					Snippet: snippetOf(mp),
 				})
 			}
 			continue
 		}
 
 		if mArr, ok := value.([]any); ok {
+			var snippets []string
 			for _, each := range mArr {
 				if mp, ok := each.(map[string]any); ok {
-					matchedToken := isMatchedToken(mp)
-					if matchedToken {
-						// simple array
-						*fields = append(*fields, &api.MatchField{
-							Name: name,
-						})
-						break
+					if _, isToken := mp[matchedTokensKey]; isToken {
+						// simple array, collect the snippets of all the matched elements
+						if isMatchedToken(mp) {
+							snippets = append(snippets, snippetOf(mp))
+						}
+						continue
 					}
 
 					// now this means we can have an array of objects, we iterate one level
@@ -147,6 +166,12 @@ func fromHighlight(highlight map[string]any, fields *[]*api.MatchField) {
 					buildMatchedForArrObjects(name, mp, fields)
 				}
 			}
+			if len(snippets) > 0 {
+				*fields = append(*fields, &api.MatchField{
+					Name:     name,
+					Snippets: snippets,
+				})
+			}
 		}
 	}
 }
@@ -157,21 +182,26 @@ func buildMatchedForArrObjects(parent string, obj map[string]any, fields *[]*api
 			// nested element in an array of object is not an array
 			if isMatchedToken(mpNested) {
 				*fields = append(*fields, &api.MatchField{
-					Name: parent + "." + k,
+					Name:    parent + "." + k,
+					Snippet: snippetOf(mpNested),
 				})
 			}
 		} else if mpNestedArr, ok := v.([]any); ok {
 			// nested element in an array of object is an array
+			var snippets []string
 			for _, eachNestedArr := range mpNestedArr {
 				if eachNested, ok := eachNestedArr.(map[string]any); ok {
 					if isMatchedToken(eachNested) {
-						*fields = append(*fields, &api.MatchField{
-							Name: parent + "." + k,
-						})
-						break
+						snippets = append(snippets, snippetOf(eachNested))
 					}
 				}
 			}
+			if len(snippets) > 0 {
+				*fields = append(*fields, &api.MatchField{
+					Name:     parent + "." + k,
+					Snippets: snippets,
+				})
+			}
 		}
 	}
 }
@@ -185,3 +215,11 @@ func isMatchedToken(mp map[string]any) bool {
 
 	return false
 }
+
+func snippetOf(mp map[string]any) string {
+	if snippet, ok := mp[snippetKey].(string); ok {
+		return snippet
+	}
+
+	return ""
+}
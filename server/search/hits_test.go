@@ -24,6 +24,7 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	api "github.com/tigrisdata/tigris/api/server/v1"
 	"github.com/tigrisdata/tigris/lib/date"
 	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
 )
@@ -70,6 +71,15 @@ func TestNewSearchHit(t *testing.T) {
 		assert.Empty(t, hit.Document)
 	})
 
+	t.Run("with geo distance", func(t *testing.T) {
+		d := make(map[string]any)
+		hit := NewSearchHit(&tsApi.SearchResultHit{
+			Document:          &d,
+			GeoDistanceMeters: &map[string]int{"location": 1520},
+		})
+		assert.Equal(t, map[string]float64{"location": 1520}, hit.Match.GeoDistance)
+	})
+
 	t.Run("with nil text match score", func(t *testing.T) {
 		d := make(map[string]any)
 		hit := NewSearchHit(&tsApi.SearchResultHit{
@@ -228,6 +238,31 @@ func TestMatchedFields(t *testing.T) {
 	}
 }
 
+func TestMatchedFieldSnippets(t *testing.T) {
+	resp := []byte(`{
+	"hits": [{
+		"document": {},
+		"highlight": {
+			"title": {"matched_tokens": ["red"], "snippet": "a <mark>red</mark> shoe"},
+			"body": {"matched_tokens": [], "snippet": "nothing"},
+			"tags": [{"matched_tokens": ["red"], "snippet": "<mark>red</mark>"}, {"matched_tokens": [], "snippet": "blue"}, {"matched_tokens": ["red"], "snippet": "dark <mark>red</mark>"}]
+		}
+	}]
+}`)
+
+	var dest tsApi.SearchResult
+	require.NoError(t, jsoniter.Unmarshal(resp, &dest))
+	h := NewSearchHit(&(*dest.Hits)[0])
+	require.Len(t, h.Match.Fields, 2)
+
+	fields := map[string]*api.MatchField{}
+	for _, f := range h.Match.Fields {
+		fields[f.Name] = f
+	}
+	require.Equal(t, "a <mark>red</mark> shoe", fields["title"].Snippet)
+	require.Equal(t, []string{"<mark>red</mark>", "dark <mark>red</mark>"}, fields["tags"].Snippets)
+}
+
 func dateFrom(dateStr string) int64 {
 	// swallowing error as the parameters are not expected to cause side effects
 	d, _ := date.ToUnixNano(time.RFC3339Nano, dateStr)
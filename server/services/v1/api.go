@@ -33,8 +33,10 @@ import (
 	"github.com/tigrisdata/tigris/server/metrics"
 	"github.com/tigrisdata/tigris/server/request"
 	"github.com/tigrisdata/tigris/server/services/v1/auth"
+	"github.com/tigrisdata/tigris/server/services/v1/cache"
 	"github.com/tigrisdata/tigris/server/services/v1/database"
 	"github.com/tigrisdata/tigris/server/transaction"
+	cache2 "github.com/tigrisdata/tigris/store/cache"
 	"github.com/tigrisdata/tigris/store/kv"
 	"github.com/tigrisdata/tigris/store/search"
 	ulog "github.com/tigrisdata/tigris/util/log"
@@ -64,6 +66,9 @@ type apiService struct {
 	versionH      *metadata.VersionHandler
 	searchStore   search.Store
 	authProvider  auth.Provider
+	outbox        *database.SearchOutboxWorker
+	exportWorker  *database.ExportWorker
+	stopReaper    func()
 }
 
 func newApiService(kv kv.TxStore, searchStore search.Store, tenantMgr *metadata.TenantManager, txMgr *transaction.Manager, authProvider auth.Provider) *apiService {
@@ -72,7 +77,7 @@ func newApiService(kv kv.TxStore, searchStore search.Store, tenantMgr *metadata.
 		txMgr:        txMgr,
 		versionH:     tenantMgr.GetVersionHandler(),
 		searchStore:  searchStore,
-		cdcMgr:       cdc.NewManager(),
+		cdcMgr:       cdc.NewManager(kv),
 		tenantMgr:    tenantMgr,
 		authProvider: authProvider,
 	}
@@ -94,10 +99,18 @@ func newApiService(kv kv.TxStore, searchStore search.Store, tenantMgr *metadata.
 		txListeners = append(txListeners, u.cdcMgr)
 	}
 	if config.DefaultConfig.Search.WriteEnabled {
+		if config.DefaultConfig.Search.Outbox.Enabled {
+			u.outbox = database.NewSearchOutboxWorker(txMgr, tenantMgr, searchStore, &config.DefaultConfig.Search.Outbox)
+			u.outbox.Start()
+		}
+
 		// just for testing so that we can disable it if needed
-		txListeners = append(txListeners, database.NewSearchIndexer(searchStore, tenantMgr))
+		txListeners = append(txListeners, database.NewSearchIndexer(searchStore, tenantMgr, u.outbox))
 	}
 
+	// keeps the caches backed by a collection in sync with the writes to the collection
+	txListeners = append(txListeners, cache.NewCollectionListener(tenantMgr, cache2.NewCache(&config.DefaultConfig.Cache)))
+
 	if config.DefaultConfig.Tracing.Enabled {
 		u.sessions = database.NewSessionManagerWithMetrics(u.txMgr, u.tenantMgr, txListeners, metadata.NewCacheTracker(tenantMgr, txMgr))
 	} else {
@@ -105,9 +118,27 @@ func newApiService(kv kv.TxStore, searchStore search.Store, tenantMgr *metadata.
 	}
 	u.runnerFactory = database.NewQueryRunnerFactory(u.txMgr, u.cdcMgr, u.searchStore)
 
+	if config.DefaultConfig.Export.WorkerEnabled {
+		u.exportWorker = database.NewExportWorker(u.txMgr, u.tenantMgr, &config.DefaultConfig.Export)
+		u.exportWorker.Start()
+	}
+
+	u.stopReaper = u.sessions.StartIdleReaper(&config.DefaultConfig.Transaction)
+
 	return u
 }
 
+// Stop stops the background workers of the service.
+func (s *apiService) Stop() {
+	if s.exportWorker != nil {
+		s.exportWorker.Stop()
+	}
+	if s.outbox != nil {
+		s.outbox.Stop()
+	}
+	s.stopReaper()
+}
+
 func (s *apiService) RegisterHTTP(router chi.Router, inproc *inprocgrpc.Channel) error {
 	mux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &api.CustomMarshaler{JSONBuiltin: &runtime.JSONBuiltin{}}),
@@ -130,6 +161,8 @@ func (s *apiService) RegisterHTTP(router chi.Router, inproc *inprocgrpc.Channel)
 			mux.ServeHTTP(w, r)
 		})
 	}
+	// raw body upload, the gateway only supports client streaming of JSON encoded messages
+	router.Post(apiPathPrefix+importStreamPath, importStreamHandler(mux, api.NewTigrisClient(inproc)))
 	router.HandleFunc(apiPathPrefix+databasePathPattern, func(w http.ResponseWriter, r *http.Request) {
 		// to handle all the database related stuff
 		mux.ServeHTTP(w, r)
@@ -170,8 +203,12 @@ func (s *apiService) BeginTransaction(ctx context.Context, _ *api.BeginTransacti
 }
 
 func (s *apiService) CommitTransaction(ctx context.Context, _ *api.CommitTransactionRequest) (*api.CommitTransactionResponse, error) {
-	session, _ := s.sessions.Get(ctx)
+	session, err := s.sessions.Get(ctx)
 	if session == nil {
+		if err != transaction.ErrSessionIsGone {
+			// the session was rolled back by the server, report the reason
+			return nil, err
+		}
 		return nil, errors.NotFound("session not found")
 	}
 	defer func() {
@@ -182,7 +219,7 @@ func (s *apiService) CommitTransaction(ctx context.Context, _ *api.CommitTransac
 
 	db, _ := session.GetTx().Context().GetStagedDatabase().(*metadata.Database)
 
-	if err := session.Commit(s.versionH, db != nil && db.MetadataChange, nil); err != nil {
+	if err = session.Commit(s.versionH, db != nil && db.MetadataChange, nil); err != nil {
 		return nil, database.CreateApiError(err)
 	}
 
@@ -190,8 +227,12 @@ func (s *apiService) CommitTransaction(ctx context.Context, _ *api.CommitTransac
 }
 
 func (s *apiService) RollbackTransaction(ctx context.Context, _ *api.RollbackTransactionRequest) (*api.RollbackTransactionResponse, error) {
-	session, _ := s.sessions.Get(ctx)
+	session, err := s.sessions.Get(ctx)
 	if session == nil {
+		if err != transaction.ErrSessionIsGone {
+			// the session was rolled back by the server, report the reason
+			return nil, err
+		}
 		return nil, errors.NotFound("session not found")
 	}
 	defer func() {
@@ -222,7 +263,8 @@ func (s *apiService) Insert(ctx context.Context, r *api.InsertRequest) (*api.Ins
 		Metadata: &api.ResponseMetadata{
 			CreatedAt: resp.CreatedAt.GetProtoTS(),
 		},
-		Keys: resp.AllKeys,
+		Keys:   resp.AllKeys,
+		Errors: resp.DocErrors,
 	}, nil
 }
 
@@ -246,6 +288,77 @@ func (s *apiService) Import(ctx context.Context, r *api.ImportRequest) (*api.Imp
 	}, nil
 }
 
+func (s *apiService) ImportStream(stream api.Tigris_ImportStreamServer) error {
+	qm := metrics.WriteQueryMetrics{}
+	accessToken, _ := request.GetAccessToken(stream.Context())
+
+	job, err := database.NewImportStreamer(s.sessions, s.runnerFactory, stream, &qm, accessToken).Import(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&api.ImportStreamResponse{
+		Job: database.ImportJobToAPI(job),
+	})
+}
+
+func (s *apiService) GetImportJob(ctx context.Context, r *api.GetImportJobRequest) (*api.GetImportJobResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	resp, err := s.sessions.Execute(ctx, s.runnerFactory.GetImportJobQueryRunner(r, accessToken), database.ReqOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Response.(*api.GetImportJobResponse), nil
+}
+
+func (s *apiService) Export(ctx context.Context, r *api.ExportRequest) (*api.ExportResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	namespace, err := request.GetNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API exports are confined to the configured destination, arbitrary locations are only allowed for the
+	// admin command
+	destination, err := database.APIExportDestination(config.DefaultConfig.Export.Destination, namespace, r.GetDestination())
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := database.NewExportJob(r.GetProject(), r.GetBranch(), r.GetCollection(), r.GetFormat(), &database.ExportSpec{
+		Filter:       r.GetFilter(),
+		Fields:       r.GetFields(),
+		Destination:  destination,
+		PartRows:     r.GetPartRows(),
+		CSVDelimiter: r.GetCsvDelimiter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.sessions.Execute(ctx, s.runnerFactory.GetExportJobRunner(job, accessToken), database.ReqOptions{}); err != nil {
+		return nil, err
+	}
+
+	return &api.ExportResponse{
+		Job: database.ExportJobToAPI(job),
+	}, nil
+}
+
+func (s *apiService) GetExportJob(ctx context.Context, r *api.GetExportJobRequest) (*api.GetExportJobResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	resp, err := s.sessions.Execute(ctx, s.runnerFactory.GetExportJobQueryRunner(r, accessToken), database.ReqOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Response.(*api.GetExportJobResponse), nil
+}
+
 func (s *apiService) BuildCollectionIndex(ctx context.Context, r *api.BuildCollectionIndexRequest) (*api.BuildCollectionIndexResponse, error) {
 	qm := metrics.WriteQueryMetrics{}
 	accessToken, _ := request.GetAccessToken(ctx)
@@ -291,7 +404,8 @@ func (s *apiService) Replace(ctx context.Context, r *api.ReplaceRequest) (*api.R
 		Metadata: &api.ResponseMetadata{
 			CreatedAt: resp.CreatedAt.GetProtoTS(),
 		},
-		Keys: resp.AllKeys,
+		Keys:   resp.AllKeys,
+		Errors: resp.DocErrors,
 	}, nil
 }
 
@@ -350,6 +464,10 @@ func (s *apiService) Read(r *api.ReadRequest, stream api.Tigris_ReadServer) erro
 }
 
 func (s *apiService) Count(ctx context.Context, r *api.CountRequest) (*api.CountResponse, error) {
+	if r.GetAsOf() != nil && api.GetTransaction(ctx) != nil {
+		return nil, errors.InvalidArgument("as_of is not supported inside an explicit transaction")
+	}
+
 	queryMetrics := metrics.StreamingQueryMetrics{}
 	accessToken, _ := request.GetAccessToken(ctx)
 	resp, err := s.sessions.Execute(ctx, s.runnerFactory.GetCountQueryRunner(r, &queryMetrics, accessToken), database.ReqOptions{
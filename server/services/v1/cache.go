@@ -41,14 +41,32 @@ type cacheService struct {
 
 	sessions      cache.Session
 	runnerFactory *cache.RunnerFactory
+	deleteWorker  *cache.DeleteWorker
 }
 
 func newCacheService(tenantMgr *metadata.TenantManager, txMgr *transaction.Manager) *cacheService {
 	cacheSessions := cache.NewSessionManager(txMgr, tenantMgr, metadata.NewCacheTracker(tenantMgr, txMgr))
-	return &cacheService{
+	encoder := metadata.NewCacheEncoder()
+	cacheStore := cache2.NewCache(&config.DefaultConfig.Cache)
+
+	c := &cacheService{
 		UnimplementedCacheServer: api.UnimplementedCacheServer{},
 		sessions:                 cacheSessions,
-		runnerFactory:            cache.NewRunnerFactory(metadata.NewCacheEncoder(), cache2.NewCache(&config.DefaultConfig.Cache)),
+		runnerFactory:            cache.NewRunnerFactory(encoder, cacheStore, txMgr),
+	}
+
+	if config.DefaultConfig.Cache.Deletion.WorkerEnabled {
+		c.deleteWorker = cache.NewDeleteWorker(txMgr, tenantMgr, cacheStore, encoder, &config.DefaultConfig.Cache.Deletion)
+		c.deleteWorker.Start()
+	}
+
+	return c
+}
+
+// Stop stops the background workers of the service.
+func (c *cacheService) Stop() {
+	if c.deleteWorker != nil {
+		c.deleteWorker.Stop()
 	}
 }
 
@@ -90,6 +108,20 @@ func (c *cacheService) ListCaches(ctx context.Context, req *api.ListCachesReques
 	}, nil
 }
 
+func (c *cacheService) DescribeCache(ctx context.Context, req *api.DescribeCacheRequest) (*api.DescribeCacheResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	resp, err := c.sessions.TxExecute(ctx, c.runnerFactory.GetDescribeCacheRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.DescribeCacheResponse{
+		Name:    req.GetName(),
+		Options: resp.Options,
+		Stats:   resp.Stats,
+	}, nil
+}
+
 func (c *cacheService) Set(ctx context.Context, req *api.SetRequest) (*api.SetResponse, error) {
 	accessToken, _ := request.GetAccessToken(ctx)
 	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetSetRunner(req, accessToken))
@@ -138,6 +170,193 @@ func (c *cacheService) Del(ctx context.Context, req *api.DelRequest) (*api.DelRe
 	}, nil
 }
 
+func (c *cacheService) MGet(ctx context.Context, req *api.MGetRequest) (*api.MGetResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetMGetRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.MGetResponse{
+		Values: resp.Values,
+	}, nil
+}
+
+func (c *cacheService) MSet(ctx context.Context, req *api.MSetRequest) (*api.MSetResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetMSetRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.MSetResponse{
+		Status:  resp.Status,
+		Message: "Keys are set successfully",
+	}, nil
+}
+
+func (c *cacheService) Incr(ctx context.Context, req *api.IncrRequest) (*api.IncrResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetIncrRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.IncrResponse{
+		Value: resp.Counter,
+	}, nil
+}
+
+func (c *cacheService) Decr(ctx context.Context, req *api.DecrRequest) (*api.DecrResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetDecrRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.DecrResponse{
+		Value: resp.Counter,
+	}, nil
+}
+
+func (c *cacheService) CompareAndSet(ctx context.Context, req *api.CompareAndSetRequest) (*api.CompareAndSetResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetCompareAndSetRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Key is set successfully"
+	if !resp.Swapped {
+		message = "Key is not set, the current value doesn't match the expected value"
+	}
+	return &api.CompareAndSetResponse{
+		Status:  resp.Status,
+		Message: message,
+		Swapped: resp.Swapped,
+	}, nil
+}
+
+func (c *cacheService) HSet(ctx context.Context, req *api.HSetRequest) (*api.HSetResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetHSetRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.HSetResponse{
+		Status:  resp.Status,
+		Message: fmt.Sprintf("Fields added count# %d", resp.Count),
+		Added:   resp.Count,
+	}, nil
+}
+
+func (c *cacheService) HGet(ctx context.Context, req *api.HGetRequest) (*api.HGetResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetHGetRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.HGetResponse{
+		Value: resp.Data,
+	}, nil
+}
+
+func (c *cacheService) HGetAll(ctx context.Context, req *api.HGetAllRequest) (*api.HGetAllResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetHGetAllRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.HGetAllResponse{
+		Fields: resp.Values,
+	}, nil
+}
+
+func (c *cacheService) HDel(ctx context.Context, req *api.HDelRequest) (*api.HDelResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetHDelRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.HDelResponse{
+		Status:  resp.Status,
+		Message: fmt.Sprintf("Fields deleted count# %d", resp.DeletedCount),
+	}, nil
+}
+
+func (c *cacheService) LPush(ctx context.Context, req *api.LPushRequest) (*api.LPushResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetLPushRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.LPushResponse{
+		Length: resp.Count,
+	}, nil
+}
+
+func (c *cacheService) RPush(ctx context.Context, req *api.RPushRequest) (*api.RPushResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetRPushRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.RPushResponse{
+		Length: resp.Count,
+	}, nil
+}
+
+func (c *cacheService) LPop(ctx context.Context, req *api.LPopRequest) (*api.LPopResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetLPopRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.LPopResponse{
+		Value: resp.Data,
+	}, nil
+}
+
+func (c *cacheService) RPop(ctx context.Context, req *api.RPopRequest) (*api.RPopResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetRPopRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.RPopResponse{
+		Value: resp.Data,
+	}, nil
+}
+
+func (c *cacheService) LRange(ctx context.Context, req *api.LRangeRequest) (*api.LRangeResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetLRangeRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.LRangeResponse{
+		Values: resp.List,
+	}, nil
+}
+
+func (c *cacheService) ZAdd(ctx context.Context, req *api.ZAddRequest) (*api.ZAddResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetZAddRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.ZAddResponse{
+		Added: resp.Count,
+	}, nil
+}
+
+func (c *cacheService) ZRange(ctx context.Context, req *api.ZRangeRequest) (*api.ZRangeResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+	resp, err := c.sessions.Execute(ctx, c.runnerFactory.GetZRangeRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+	return &api.ZRangeResponse{
+		Members: resp.Members,
+	}, nil
+}
+
 func (c *cacheService) Keys(req *api.KeysRequest, streaming api.Cache_KeysServer) error {
 	accessToken, _ := request.GetAccessToken(streaming.Context())
 	_, err := c.sessions.Execute(streaming.Context(), c.runnerFactory.GetKeysRunner(req, accessToken, streaming))
@@ -0,0 +1,254 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog/log"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/services/v1/database"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/store/cache"
+	"github.com/tigrisdata/tigris/store/kv"
+	ulog "github.com/tigrisdata/tigris/util/log"
+)
+
+// CollectionListener keeps the caches backed by a collection in sync with the collection. Once the writes to the
+// collection are committed the cached documents are invalidated, or refreshed if the cache is write-through.
+type CollectionListener struct {
+	tenantMgr  *metadata.TenantManager
+	cacheStore cache.Cache
+	encoder    metadata.CacheEncoder
+}
+
+func NewCollectionListener(tenantMgr *metadata.TenantManager, cacheStore cache.Cache) *CollectionListener {
+	return &CollectionListener{
+		tenantMgr:  tenantMgr,
+		cacheStore: cacheStore,
+		encoder:    metadata.NewCacheEncoder(),
+	}
+}
+
+func (*CollectionListener) OnPreCommit(context.Context, *metadata.Tenant, transaction.Tx, kv.EventListener) error {
+	return nil
+}
+
+// OnPostCommit applies the committed writes to the caches backed by the written collections. The caches are updated
+// on a best-effort basis, the failures are logged and don't fail the user transaction.
+func (l *CollectionListener) OnPostCommit(ctx context.Context, tenant *metadata.Tenant, eventListener kv.EventListener) error {
+	for _, event := range eventListener.GetEvents() {
+		if event.Key == nil {
+			// event.Key == nil if event comes from drop table
+			continue
+		}
+
+		db, collName, ok := l.tenantMgr.DecodeTableName(event.Table)
+		if !ok || db.IsBranch() {
+			continue
+		}
+
+		project, err := tenant.GetProject(db.DbName())
+		if err != nil {
+			continue
+		}
+
+		caches := project.GetCachesForCollection(collName)
+		if len(caches) == 0 {
+			continue
+		}
+
+		// the key of the cache is the primary key of the document, formatted the same way as the search key
+		key, err := database.CreateSearchKey(event.Key)
+		if ulog.E(err) {
+			continue
+		}
+
+		for _, c := range caches {
+			l.apply(ctx, tenant, project, c, key, event)
+		}
+	}
+
+	return nil
+}
+
+func (*CollectionListener) OnRollback(context.Context, *metadata.Tenant, kv.EventListener) {}
+
+func (l *CollectionListener) apply(ctx context.Context, tenant *metadata.Tenant, project *metadata.Project, cacheMetadata *metadata.CacheMetadata, key string, event *kv.Event) {
+	tableName, err := l.encoder.EncodeCacheTableName(tenant.GetNamespace().Id(), project.Id(), cacheMetadata.Name)
+	if ulog.E(err) {
+		return
+	}
+
+	if cacheMetadata.WriteThrough && event.Op != kv.DeleteEvent && event.Data != nil {
+		// the post-commit refreshes of the concurrent writes can be applied out of order, so they expire as the fills
+		err = l.cacheStore.Set(ctx, tableName, key, internal.NewCacheData(event.Data.RawData), fillOptions(false))
+		if err == nil {
+			return
+		}
+
+		// the stale document must not stay in the cache if the new one can't be written
+		log.Warn().Err(err).Str("cache", cacheMetadata.Name).Msg("failed to refresh the cached document")
+	}
+
+	if _, err = l.cacheStore.Delete(ctx, tableName, key); err != nil {
+		log.Err(err).Str("cache", cacheMetadata.Name).Msg("failed to invalidate the cached document")
+	}
+}
+
+// validateBackingCollection checks that the collection can back a cache, the keys of the cache map to the primary
+// keys of the collection so only the collections with a single field primary key are supported.
+func validateBackingCollection(tenant *metadata.Tenant, projectName string, collection string) error {
+	project, err := tenant.GetProject(projectName)
+	if err != nil {
+		return createApiError(err)
+	}
+
+	var coll *schema.DefaultCollection
+	if db := project.GetMainDatabase(); db != nil {
+		coll = db.GetCollection(collection)
+	}
+	if coll == nil {
+		return errors.NotFound("Collection '%s' doesn't exist", collection)
+	}
+
+	fields := coll.GetPrimaryKey().Fields
+	if len(fields) != 1 {
+		return errors.InvalidArgument("Cache can only be backed by a collection with a single field primary key")
+	}
+
+	switch fields[0].DataType {
+	case schema.StringType, schema.UUIDType, schema.Int32Type, schema.Int64Type, schema.ByteType:
+		return nil
+	}
+
+	return errors.InvalidArgument("Primary key of type '%s' can't be used as a cache key",
+		schema.FieldNames[fields[0].DataType])
+}
+
+// readThrough loads the missing key of a cache backed by a collection from the collection and caches it. It returns
+// cache.ErrKeyNotFound if the cache is not backed by a collection or the document doesn't exist.
+func (runner *BaseRunner) readThrough(ctx context.Context, tenant *metadata.Tenant, projectName string, cacheName string, tableName string, key string) (*internal.CacheData, error) {
+	project, err := tenant.GetProject(projectName)
+	if err != nil {
+		return nil, createApiError(err)
+	}
+
+	cacheMetadata := project.GetCollectionCache(cacheName)
+	if cacheMetadata == nil {
+		return nil, cache.ErrKeyNotFound
+	}
+
+	doc, err := runner.loadDocument(ctx, tenant, project, cacheMetadata.Collection, key)
+	if err != nil {
+		return nil, err
+	}
+
+	data := internal.NewCacheData(doc)
+	// the document refreshed by a concurrent write to the collection takes precedence over the one loaded here. A
+	// write committed after the load but invalidated before this fill leaves the loaded document in the cache, so the
+	// fill always expires
+	err = runner.cacheStore.Set(ctx, tableName, key, data, fillOptions(true))
+	if err != nil && err != cache.ErrKeyAlreadyExists {
+		log.Warn().Err(err).Str("cache", cacheName).Msg("failed to cache the loaded document")
+	}
+
+	return data, nil
+}
+
+// fillOptions returns the options of the documents cached from the backing collection, they expire after the fill TTL.
+func fillOptions(nx bool) *cache.SetOptions {
+	return &cache.SetOptions{
+		NX: nx,
+		PX: uint64(config.DefaultConfig.Cache.FillTTL.Milliseconds()),
+	}
+}
+
+// loadDocument reads the document with the primary key from the collection of the main database.
+func (runner *BaseRunner) loadDocument(ctx context.Context, tenant *metadata.Tenant, project *metadata.Project, collection string, key string) ([]byte, error) {
+	var coll *schema.DefaultCollection
+	if db := project.GetMainDatabase(); db != nil {
+		coll = db.GetCollection(collection)
+	}
+	if coll == nil {
+		// the collection is dropped, the cache doesn't have anything to load
+		return nil, cache.ErrKeyNotFound
+	}
+
+	filter, err := primaryKeyFilter(coll, key)
+	if err != nil {
+		return nil, err
+	}
+
+	planner, err := database.NewPrimaryIndexQueryPlanner(coll, tenant.Encoder, filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planner.GeneratePlan(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := runner.txMgr.StartTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	iterator, err := database.NewDatabaseReader(ctx, tx).KeyIterator(plan.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var row database.Row
+	if !iterator.Next(&row) {
+		if err = iterator.Interrupted(); err != nil {
+			return nil, err
+		}
+		return nil, cache.ErrKeyNotFound
+	}
+
+	rawData := row.Data.RawData
+	if !coll.CompatibleSchemaSince(uint32(row.Data.Ver)) {
+		if rawData, err = coll.UpdateRowSchemaRaw(rawData, uint32(row.Data.Ver)); err != nil {
+			return nil, err
+		}
+	}
+
+	return rawData, nil
+}
+
+// primaryKeyFilter returns the filter on the primary key of the collection equal to the key of the cache.
+func primaryKeyFilter(coll *schema.DefaultCollection, key string) ([]byte, error) {
+	field := coll.GetPrimaryKey().Fields[0]
+
+	var value any = key
+	switch field.DataType {
+	case schema.Int32Type, schema.Int64Type:
+		v, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, errors.InvalidArgument("Key '%s' is not a valid primary key of the collection '%s'", key, coll.Name)
+		}
+		value = v
+	}
+
+	return jsoniter.Marshal(map[string]any{field.FieldName: value})
+}
@@ -0,0 +1,335 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog/log"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/store/cache"
+	ulog "github.com/tigrisdata/tigris/util/log"
+)
+
+// deleteTask is the queue item payload of the scheduled cache deletion. The job id is stored under its own name so
+// that the other queue workers skip the item.
+type deleteTask struct {
+	Namespace  string `json:"namespace"`
+	CacheJobId string `json:"cache_job_id"`
+}
+
+// deleteSpec is the deleted cache persisted in the job. The table name is captured when the cache is deleted, so the
+// job doesn't depend on the project which can be dropped meanwhile.
+type deleteSpec struct {
+	TableName string `json:"table_name"`
+}
+
+// ScheduleDeleteJob stores the cache deletion job and enqueues it for the delete worker.
+func ScheduleDeleteJob(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant, project string, name string, tableName string) (*metadata.JobMetadata, error) {
+	job := metadata.NewJobMetadata(metadata.CacheDeleteJobType, project, "", name)
+
+	var err error
+	if job.Spec, err = jsoniter.Marshal(&deleteSpec{TableName: tableName}); err != nil {
+		return nil, err
+	}
+
+	if err = tenant.MetaStore.Job().Create(ctx, tx, tenant.GetNamespace().Id(), job); err != nil {
+		return nil, err
+	}
+
+	task, err := jsoniter.Marshal(&deleteTask{Namespace: tenant.GetNamespace().StrId(), CacheJobId: job.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	item := metadata.NewQueueItem(0, task)
+	item.Id = job.Id
+
+	return job, tenant.MetaStore.Queue().Enqueue(ctx, tx, item, 0)
+}
+
+// DeleteWorker removes the keys of the deleted caches. The keys are scanned and unlinked in batches and the scan
+// cursor is persisted in the job after every batch, so the worker never blocks the cache and a job whose lease
+// expires is resumed by another worker from the last persisted cursor. The cache is purged from the project metadata
+// once all of its keys are removed.
+type DeleteWorker struct {
+	txMgr      *transaction.Manager
+	tenantMgr  *metadata.TenantManager
+	queue      *metadata.QueueSubspace
+	cacheStore cache.Cache
+	encoder    metadata.CacheEncoder
+	cfg        *config.CacheDeletionConfig
+	ctx        context.Context
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+func NewDeleteWorker(txMgr *transaction.Manager, tenantMgr *metadata.TenantManager, cacheStore cache.Cache,
+	encoder metadata.CacheEncoder, cfg *config.CacheDeletionConfig,
+) *DeleteWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &DeleteWorker{
+		txMgr:      txMgr,
+		tenantMgr:  tenantMgr,
+		queue:      metadata.NewMetadataDictionary(metadata.DefaultNameRegistry).Queue(),
+		cacheStore: cacheStore,
+		encoder:    encoder,
+		cfg:        cfg,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+func (w *DeleteWorker) Start() {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := w.runNext(w.ctx); err != nil && w.ctx.Err() == nil {
+					log.Err(err).Msg("cache delete worker failed to run the job")
+				}
+			}
+		}
+	}()
+}
+
+// Stop interrupts the running job and waits for the worker to exit. The interrupted job keeps its lease, it is
+// resumed from the last persisted cursor once the lease expires.
+func (w *DeleteWorker) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+// runNext claims the first ready cache deletion job and runs it.
+func (w *DeleteWorker) runNext(ctx context.Context) error {
+	item, task, err := w.claim(ctx)
+	if err != nil || item == nil {
+		return err
+	}
+
+	tenant, err := w.tenantMgr.GetTenant(ctx, task.Namespace)
+	if err != nil {
+		return err
+	}
+
+	job, err := w.getJob(ctx, tenant, task.CacheJobId)
+	if err == errors.ErrNotFound {
+		// the job was removed, nothing to run
+		return w.complete(ctx, item)
+	}
+	if err != nil {
+		return err
+	}
+	if job.IsTerminal() {
+		return w.complete(ctx, item)
+	}
+
+	if err = w.run(ctx, tenant, item, job); err != nil {
+		log.Err(err).Str("job", job.Id).Str("cache", job.Collection).Msg("cache delete job failed")
+	}
+
+	return nil
+}
+
+// run removes the keys of the leased job, the lease is renewed while the job is running. If the job fails the lease
+// expires and the job is resumed from the last persisted cursor.
+func (w *DeleteWorker) run(ctx context.Context, tenant *metadata.Tenant, item *metadata.QueueItem, job *metadata.JobMetadata) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		w.renewLease(runCtx, cancel, item)
+	}()
+
+	err := w.deleteKeys(runCtx, tenant, job)
+	cancel()
+	<-renewed
+
+	if err != nil {
+		return err
+	}
+
+	// the usage of the cache is dropped only once all its keys are gone so a cache recreated with the same name
+	// starts from empty stats and limits
+	var spec deleteSpec
+	if err = jsoniter.Unmarshal(job.Spec, &spec); err != nil {
+		return err
+	}
+	if err = w.cacheStore.DropStats(ctx, spec.TableName); err != nil {
+		return err
+	}
+
+	return w.withTx(ctx, func(tx transaction.Tx) error {
+		if _, err := tenant.PurgeCache(ctx, tx, job.Project, job.Collection); err != nil {
+			return err
+		}
+
+		job.State = metadata.JobCompleted
+		job.Position = nil
+		if err := tenant.MetaStore.Job().Update(ctx, tx, tenant.GetNamespace().Id(), job); err != nil {
+			return err
+		}
+
+		return w.queue.Complete(ctx, tx, item)
+	})
+}
+
+// deleteKeys scans the cache from the persisted cursor and unlinks the keys batch by batch. The cursor of both the
+// Redis and the in-memory Scan doesn't depend on the position of the keys, so unlinking the scanned keys doesn't cause
+// the remaining keys to be skipped.
+func (w *DeleteWorker) deleteKeys(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) error {
+	var spec deleteSpec
+	if err := jsoniter.Unmarshal(job.Spec, &spec); err != nil {
+		return err
+	}
+
+	var cursor uint64
+	if len(job.Position) > 0 {
+		var err error
+		if cursor, err = strconv.ParseUint(string(job.Position), 10, 64); err != nil {
+			return err
+		}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		internalKeys, next, err := w.cacheStore.Scan(ctx, spec.TableName, cursor, w.cfg.BatchSize, "*")
+		if err != nil {
+			return err
+		}
+
+		if len(internalKeys) > 0 {
+			userKeys := make([]string, len(internalKeys))
+			for i, internalKey := range internalKeys {
+				userKeys[i] = w.encoder.DecodeInternalCacheKeyNameToExternal(internalKey)
+			}
+
+			unlinked, err := w.cacheStore.Unlink(ctx, spec.TableName, userKeys...)
+			if err != nil {
+				return err
+			}
+			job.Processed += unlinked
+		}
+
+		if next == 0 {
+			return nil
+		}
+
+		cursor = next
+		job.Position = []byte(strconv.FormatUint(cursor, 10))
+		if err = w.withTx(ctx, func(tx transaction.Tx) error {
+			return tenant.MetaStore.Job().Update(ctx, tx, tenant.GetNamespace().Id(), job)
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *DeleteWorker) claim(ctx context.Context) (*metadata.QueueItem, *deleteTask, error) {
+	tx, err := w.txMgr.StartTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var task deleteTask
+	items, err := w.queue.PeekMatching(ctx, tx, 1, func(item *metadata.QueueItem) bool {
+		task = deleteTask{}
+		// the queue is shared with the other workers, their items are skipped
+		return jsoniter.Unmarshal(item.Data, &task) == nil && task.CacheJobId != ""
+	})
+	if err != nil || len(items) == 0 {
+		return nil, nil, err
+	}
+
+	item, err := w.queue.ObtainLease(ctx, tx, &items[0], w.cfg.LeaseTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return item, &task, nil
+}
+
+func (w *DeleteWorker) renewLease(ctx context.Context, cancel context.CancelFunc, item *metadata.QueueItem) {
+	ticker := time.NewTicker(w.cfg.LeaseTime / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.withTx(ctx, func(tx transaction.Tx) error {
+				return w.queue.RenewLease(ctx, tx, item, w.cfg.LeaseTime)
+			}); ulog.E(err) {
+				// the job may be claimed by another worker, stop deleting
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func (w *DeleteWorker) complete(ctx context.Context, item *metadata.QueueItem) error {
+	return w.withTx(ctx, func(tx transaction.Tx) error {
+		return w.queue.Complete(ctx, tx, item)
+	})
+}
+
+func (w *DeleteWorker) getJob(ctx context.Context, tenant *metadata.Tenant, id string) (job *metadata.JobMetadata, err error) {
+	err = w.withTx(ctx, func(tx transaction.Tx) error {
+		job, err = tenant.MetaStore.Job().Get(ctx, tx, tenant.GetNamespace().Id(), id)
+		return err
+	})
+
+	return
+}
+
+func (w *DeleteWorker) withTx(ctx context.Context, fn func(tx transaction.Tx) error) error {
+	tx, err := w.txMgr.StartTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -18,6 +18,7 @@ import api "github.com/tigrisdata/tigris/api/server/v1"
 
 const (
 	SetStatus     string = "set"
+	NotSetStatus  string = "not_set"
 	DeletedStatus string = "deleted"
 	CreatedStatus string = "created"
 )
@@ -32,6 +33,14 @@ type Response struct {
 	DeletedCount int64
 	Caches       []*api.CacheMetadata
 	Cursor       uint64
+	Values       map[string][]byte
+	Counter      int64
+	Swapped      bool
+	Count        int64
+	List         [][]byte
+	Members      []*api.ScoredMember
+	Options      *api.CacheOptions
+	Stats        *api.CacheStats
 }
 
 // StreamingKeys is a wrapper interface for passing around for streaming cache keys.
@@ -16,6 +16,7 @@ package cache
 
 import (
 	"context"
+	"math"
 
 	"github.com/rs/zerolog/log"
 	api "github.com/tigrisdata/tigris/api/server/v1"
@@ -44,14 +45,16 @@ type TxRunner interface {
 type BaseRunner struct {
 	encoder     metadata.CacheEncoder
 	cacheStore  cache.Cache
+	txMgr       *transaction.Manager
 	accessToken *types.AccessToken
 }
 
-func NewBaseRunner(encoder metadata.CacheEncoder, accessToken *types.AccessToken, cacheStore cache.Cache) *BaseRunner {
+func NewBaseRunner(encoder metadata.CacheEncoder, accessToken *types.AccessToken, cacheStore cache.Cache, txMgr *transaction.Manager) *BaseRunner {
 	return &BaseRunner{
 		encoder:     encoder,
 		accessToken: accessToken,
 		cacheStore:  cacheStore,
+		txMgr:       txMgr,
 	}
 }
 
@@ -73,6 +76,12 @@ type ListCachesRunner struct {
 	req *api.ListCachesRequest
 }
 
+type DescribeCacheRunner struct {
+	*BaseRunner
+
+	req *api.DescribeCacheRequest
+}
+
 type SetRunner struct {
 	*BaseRunner
 
@@ -97,6 +106,102 @@ type DelRunner struct {
 	req *api.DelRequest
 }
 
+type MGetRunner struct {
+	*BaseRunner
+
+	req *api.MGetRequest
+}
+
+type MSetRunner struct {
+	*BaseRunner
+
+	req *api.MSetRequest
+}
+
+type IncrRunner struct {
+	*BaseRunner
+
+	req *api.IncrRequest
+}
+
+type DecrRunner struct {
+	*BaseRunner
+
+	req *api.DecrRequest
+}
+
+type CompareAndSetRunner struct {
+	*BaseRunner
+
+	req *api.CompareAndSetRequest
+}
+
+type HSetRunner struct {
+	*BaseRunner
+
+	req *api.HSetRequest
+}
+
+type HGetRunner struct {
+	*BaseRunner
+
+	req *api.HGetRequest
+}
+
+type HGetAllRunner struct {
+	*BaseRunner
+
+	req *api.HGetAllRequest
+}
+
+type HDelRunner struct {
+	*BaseRunner
+
+	req *api.HDelRequest
+}
+
+type LPushRunner struct {
+	*BaseRunner
+
+	req *api.LPushRequest
+}
+
+type RPushRunner struct {
+	*BaseRunner
+
+	req *api.RPushRequest
+}
+
+type LPopRunner struct {
+	*BaseRunner
+
+	req *api.LPopRequest
+}
+
+type RPopRunner struct {
+	*BaseRunner
+
+	req *api.RPopRequest
+}
+
+type LRangeRunner struct {
+	*BaseRunner
+
+	req *api.LRangeRequest
+}
+
+type ZAddRunner struct {
+	*BaseRunner
+
+	req *api.ZAddRequest
+}
+
+type ZRangeRunner struct {
+	*BaseRunner
+
+	req *api.ZRangeRequest
+}
+
 type KeysRunner struct {
 	*BaseRunner
 	req       *api.KeysRequest
@@ -106,68 +211,189 @@ type KeysRunner struct {
 type RunnerFactory struct {
 	encoder    metadata.CacheEncoder
 	cacheStore cache.Cache
+	txMgr      *transaction.Manager
 }
 
 // NewRunnerFactory returns RunnerFactory object.
-func NewRunnerFactory(encoder metadata.CacheEncoder, cacheStore cache.Cache) *RunnerFactory {
+func NewRunnerFactory(encoder metadata.CacheEncoder, cacheStore cache.Cache, txMgr *transaction.Manager) *RunnerFactory {
 	return &RunnerFactory{
 		encoder:    encoder,
 		cacheStore: cacheStore,
+		txMgr:      txMgr,
 	}
 }
 
 func (f *RunnerFactory) GetCreateCacheRunner(r *api.CreateCacheRequest, accessToken *types.AccessToken) *CreateCacheRunner {
 	return &CreateCacheRunner{
-		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore),
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
 		req:        r,
 	}
 }
 
 func (f *RunnerFactory) GetDeleteCacheRunner(r *api.DeleteCacheRequest, accessToken *types.AccessToken) *DeleteCacheRunner {
 	return &DeleteCacheRunner{
-		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore),
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
 		req:        r,
 	}
 }
 
 func (f *RunnerFactory) GetListCachesRunner(r *api.ListCachesRequest, accessToken *types.AccessToken) *ListCachesRunner {
 	return &ListCachesRunner{
-		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore),
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetDescribeCacheRunner(r *api.DescribeCacheRequest, accessToken *types.AccessToken) *DescribeCacheRunner {
+	return &DescribeCacheRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
 		req:        r,
 	}
 }
 
 func (f *RunnerFactory) GetSetRunner(r *api.SetRequest, accessToken *types.AccessToken) *SetRunner {
 	return &SetRunner{
-		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore),
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
 		req:        r,
 	}
 }
 
 func (f *RunnerFactory) GetGetSetRunner(r *api.GetSetRequest, accessToken *types.AccessToken) *GetSetRunner {
 	return &GetSetRunner{
-		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore),
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
 		req:        r,
 	}
 }
 
 func (f *RunnerFactory) GetGetRunner(r *api.GetRequest, accessToken *types.AccessToken) *GetRunner {
 	return &GetRunner{
-		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore),
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
 		req:        r,
 	}
 }
 
 func (f *RunnerFactory) GetDelRunner(r *api.DelRequest, accessToken *types.AccessToken) *DelRunner {
 	return &DelRunner{
-		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore),
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetMGetRunner(r *api.MGetRequest, accessToken *types.AccessToken) *MGetRunner {
+	return &MGetRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetMSetRunner(r *api.MSetRequest, accessToken *types.AccessToken) *MSetRunner {
+	return &MSetRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetIncrRunner(r *api.IncrRequest, accessToken *types.AccessToken) *IncrRunner {
+	return &IncrRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetDecrRunner(r *api.DecrRequest, accessToken *types.AccessToken) *DecrRunner {
+	return &DecrRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetCompareAndSetRunner(r *api.CompareAndSetRequest, accessToken *types.AccessToken) *CompareAndSetRunner {
+	return &CompareAndSetRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetHSetRunner(r *api.HSetRequest, accessToken *types.AccessToken) *HSetRunner {
+	return &HSetRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetHGetRunner(r *api.HGetRequest, accessToken *types.AccessToken) *HGetRunner {
+	return &HGetRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetHGetAllRunner(r *api.HGetAllRequest, accessToken *types.AccessToken) *HGetAllRunner {
+	return &HGetAllRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetHDelRunner(r *api.HDelRequest, accessToken *types.AccessToken) *HDelRunner {
+	return &HDelRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetLPushRunner(r *api.LPushRequest, accessToken *types.AccessToken) *LPushRunner {
+	return &LPushRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetRPushRunner(r *api.RPushRequest, accessToken *types.AccessToken) *RPushRunner {
+	return &RPushRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetLPopRunner(r *api.LPopRequest, accessToken *types.AccessToken) *LPopRunner {
+	return &LPopRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetRPopRunner(r *api.RPopRequest, accessToken *types.AccessToken) *RPopRunner {
+	return &RPopRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetLRangeRunner(r *api.LRangeRequest, accessToken *types.AccessToken) *LRangeRunner {
+	return &LRangeRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetZAddRunner(r *api.ZAddRequest, accessToken *types.AccessToken) *ZAddRunner {
+	return &ZAddRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetZRangeRunner(r *api.ZRangeRequest, accessToken *types.AccessToken) *ZRangeRunner {
+	return &ZRangeRunner{
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
 		req:        r,
 	}
 }
 
 func (f *RunnerFactory) GetKeysRunner(r *api.KeysRequest, accessToken *types.AccessToken, streaming StreamingKeys) *KeysRunner {
 	return &KeysRunner{
-		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore),
+		BaseRunner: NewBaseRunner(f.encoder, accessToken, f.cacheStore, f.txMgr),
 		streaming:  streaming,
 		req:        r,
 	}
@@ -179,34 +405,56 @@ func (runner *CreateCacheRunner) Run(ctx context.Context, tx transaction.Tx, ten
 		return Response{}, ctx, errors.Internal("Failed to get current sub for the request")
 	}
 
-	_, err = tenant.CreateCache(ctx, tx, runner.req.GetProject(), runner.req.GetName(), currentSub)
+	limits, err := cacheLimits(runner.req.GetOptions())
+	if err != nil {
+		return Response{}, ctx, err
+	}
+
+	collection := runner.req.GetOptions().GetCollection()
+	if len(collection) > 0 {
+		if err = validateBackingCollection(tenant, runner.req.GetProject(), collection); err != nil {
+			return Response{}, ctx, err
+		}
+	} else if runner.req.GetOptions().GetWriteThrough() {
+		return Response{}, ctx, errors.InvalidArgument("Write-through cache must be backed by a collection")
+	}
+
+	_, err = tenant.CreateCache(ctx, tx, runner.req.GetProject(), &metadata.CacheMetadata{
+		Name:           runner.req.GetName(),
+		Creator:        currentSub,
+		MaxKeys:        limits.MaxKeys,
+		MaxBytes:       limits.MaxBytes,
+		EvictionPolicy: string(limits.Policy),
+		Collection:     collection,
+		WriteThrough:   runner.req.GetOptions().GetWriteThrough(),
+	})
 	if err != nil {
 		return Response{}, ctx, createApiError(err)
 	}
+
+	// the cache being deleted is rejected by CreateCache, so the table is resolved once the cache is created
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, ctx, err
+	}
+
+	if err = runner.cacheStore.SetLimits(ctx, tableName, limits); err != nil {
+		return Response{}, ctx, errors.Internal("Failed to set the limits of the cache, reason %s", err.Error())
+	}
+
 	return Response{
 		Status: database.CreatedStatus,
 	}, ctx, nil
 }
 
+// Run hides the cache and schedules the removal of its keys, the keys are removed in batches by the DeleteWorker so
+// that deleting a large cache doesn't block the cache server.
 func (runner *DeleteCacheRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
 	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
 	if err != nil {
 		return Response{}, ctx, err
 	}
 
-	internalKeys, err := runner.cacheStore.Keys(ctx, tableName, "*")
-	if err != nil {
-		return Response{}, ctx, err
-	}
-	for _, internalKey := range internalKeys {
-		// translate the key to user key
-		userKey := runner.encoder.DecodeInternalCacheKeyNameToExternal(internalKey)
-		_, err = runner.cacheStore.Delete(ctx, tableName, userKey)
-		if err != nil {
-			log.Warn().Str("cacheTableName", tableName).Str("cacheKey", userKey).Msg("Failed to delete cache key")
-		}
-	}
-
 	_, err = tenant.DeleteCache(ctx, tx, runner.req.GetProject(), runner.req.GetName())
 	if err != nil {
 		log.Warn().
@@ -216,6 +464,11 @@ func (runner *DeleteCacheRunner) Run(ctx context.Context, tx transaction.Tx, ten
 			Msg("Failed to update project metadata entry to delete cache")
 		return Response{}, ctx, createApiError(err)
 	}
+
+	if _, err = ScheduleDeleteJob(ctx, tx, tenant, runner.req.GetProject(), runner.req.GetName(), tableName); err != nil {
+		return Response{}, ctx, err
+	}
+
 	return Response{
 		Status: database.DeletedStatus,
 	}, ctx, nil
@@ -227,9 +480,10 @@ func (runner *ListCachesRunner) Run(ctx context.Context, tx transaction.Tx, tena
 		return Response{}, ctx, err
 	}
 	cachesMetadata := make([]*api.CacheMetadata, len(caches))
-	for i, cache := range caches {
+	for i := range caches {
 		cachesMetadata[i] = &api.CacheMetadata{
-			Name: cache,
+			Name:    caches[i].Name,
+			Options: cacheOptions(&caches[i]),
 		}
 	}
 	return Response{
@@ -237,6 +491,69 @@ func (runner *ListCachesRunner) Run(ctx context.Context, tx transaction.Tx, tena
 	}, ctx, nil
 }
 
+// Run returns the limits of the cache along with its current usage as tracked by the cache store.
+func (runner *DescribeCacheRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
+	cacheMetadata, err := tenant.GetCache(ctx, tx, runner.req.GetProject(), runner.req.GetName())
+	if err != nil {
+		return Response{}, ctx, createApiError(err)
+	}
+
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, ctx, err
+	}
+
+	stats, err := runner.cacheStore.Stats(ctx, tableName)
+	if err != nil {
+		return Response{}, ctx, errors.Internal("Failed to get the stats of the cache, reason %s", err.Error())
+	}
+
+	return Response{
+		Options: cacheOptions(cacheMetadata),
+		Stats: &api.CacheStats{
+			Keys:        stats.Keys,
+			MemoryBytes: stats.Bytes,
+			Hits:        stats.Hits,
+			Misses:      stats.Misses,
+			Evictions:   stats.Evictions,
+		},
+	}, ctx, nil
+}
+
+// cacheLimits validates the options of the cache and converts them to the limits enforced by the cache store.
+func cacheLimits(options *api.CacheOptions) (*cache.Limits, error) {
+	if options.GetMaxKeys() < 0 || options.GetMaxBytes() < 0 {
+		return nil, errors.InvalidArgument("Limits of the cache can't be negative")
+	}
+
+	policy, err := cache.ParseEvictionPolicy(options.GetEvictionPolicy())
+	if err != nil {
+		return nil, errors.InvalidArgument(err.Error())
+	}
+
+	return &cache.Limits{
+		MaxKeys:  options.GetMaxKeys(),
+		MaxBytes: options.GetMaxBytes(),
+		Policy:   policy,
+	}, nil
+}
+
+func cacheOptions(cacheMetadata *metadata.CacheMetadata) *api.CacheOptions {
+	// caches created before the limits were introduced don't have a policy recorded
+	policy := cacheMetadata.EvictionPolicy
+	if policy == "" {
+		policy = string(cache.EvictionLRU)
+	}
+
+	return &api.CacheOptions{
+		MaxKeys:        cacheMetadata.MaxKeys,
+		MaxBytes:       cacheMetadata.MaxBytes,
+		EvictionPolicy: policy,
+		Collection:     cacheMetadata.Collection,
+		WriteThrough:   cacheMetadata.WriteThrough,
+	}
+}
+
 func (runner *SetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
 	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
 	if err != nil {
@@ -251,7 +568,7 @@ func (runner *SetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Resp
 	}
 
 	if err = runner.cacheStore.Set(ctx, tableName, runner.req.GetKey(), internal.NewCacheData(runner.req.GetValue()), options); err != nil {
-		return Response{}, errors.Internal("Failed to invoke set, reason %s", err.Error())
+		return Response{}, createWriteError("set", err)
 	}
 
 	return Response{
@@ -267,7 +584,7 @@ func (runner *GetSetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (R
 
 	oldVal, err := runner.cacheStore.GetSet(ctx, tableName, runner.req.GetKey(), internal.NewCacheData(runner.req.GetValue()))
 	if err != nil {
-		return Response{}, errors.Internal("Failed to invoke set, reason %s", err.Error())
+		return Response{}, createWriteError("set", err)
 	}
 
 	result := Response{
@@ -291,6 +608,10 @@ func (runner *GetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Resp
 		GetDelete: false,
 	}
 	data, err := runner.cacheStore.Get(ctx, tableName, runner.req.GetKey(), options)
+	if err == cache.ErrKeyNotFound {
+		// the cache backed by a collection loads the missing key from the collection
+		data, err = runner.readThrough(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), tableName, runner.req.GetKey())
+	}
 	if err != nil {
 		if err == cache.ErrKeyNotFound {
 			return Response{}, errors.NotFound(err.Error())
@@ -318,6 +639,399 @@ func (runner *DelRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Resp
 	}, nil
 }
 
+func (runner *MGetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(runner.req.GetKeys()) == 0 {
+		return Response{}, errors.InvalidArgument("At least one key is required")
+	}
+
+	values, err := runner.cacheStore.MGet(ctx, tableName, runner.req.GetKeys()...)
+	if err != nil {
+		return Response{}, errors.Internal("Failed to invoke mget, reason %s", err.Error())
+	}
+
+	// the missing keys are omitted from the response
+	found := make(map[string][]byte, len(values))
+	for i, v := range values {
+		if v != nil {
+			found[runner.req.GetKeys()[i]] = v.GetRawData()
+		}
+	}
+
+	return Response{
+		Values: found,
+	}, nil
+}
+
+func (runner *MSetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(runner.req.GetValues()) == 0 {
+		return Response{}, errors.InvalidArgument("At least one key is required")
+	}
+
+	values := make(map[string]*internal.CacheData, len(runner.req.GetValues()))
+	for k, v := range runner.req.GetValues() {
+		if len(k) == 0 {
+			return Response{}, errors.InvalidArgument("Empty key is not allowed")
+		}
+		values[k] = internal.NewCacheData(v)
+	}
+
+	if err = runner.cacheStore.MSet(ctx, tableName, values); err != nil {
+		return Response{}, createWriteError("mset", err)
+	}
+
+	return Response{
+		Status: SetStatus,
+	}, nil
+}
+
+func (runner *IncrRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	options := &cache.IncrOptions{
+		EX: runner.req.GetEx(),
+		PX: runner.req.GetPx(),
+	}
+
+	value, err := runner.cacheStore.Incr(ctx, tableName, runner.req.GetKey(), counterDelta(runner.req.GetDelta()), options)
+	if err != nil {
+		return Response{}, createCounterError("incr", err)
+	}
+
+	return Response{
+		Counter: value,
+	}, nil
+}
+
+func (runner *DecrRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	options := &cache.IncrOptions{
+		EX: runner.req.GetEx(),
+		PX: runner.req.GetPx(),
+	}
+
+	value, err := runner.cacheStore.Decr(ctx, tableName, runner.req.GetKey(), counterDelta(runner.req.GetDelta()), options)
+	if err != nil {
+		return Response{}, createCounterError("decr", err)
+	}
+
+	return Response{
+		Counter: value,
+	}, nil
+}
+
+func (runner *CompareAndSetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	options := &cache.SetOptions{
+		EX: runner.req.GetEx(),
+		PX: runner.req.GetPx(),
+	}
+
+	swapped, err := runner.cacheStore.CompareAndSet(ctx, tableName, runner.req.GetKey(), runner.req.GetExpected(),
+		internal.NewCacheData(runner.req.GetValue()), options)
+	if err != nil {
+		return Response{}, createWriteError("compare and set", err)
+	}
+
+	result := Response{
+		Status:  NotSetStatus,
+		Swapped: swapped,
+	}
+	if swapped {
+		result.Status = SetStatus
+	}
+	return result, nil
+}
+
+func (runner *HSetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(runner.req.GetFields()) == 0 {
+		return Response{}, errors.InvalidArgument("At least one field is required")
+	}
+
+	added, err := runner.cacheStore.HSet(ctx, tableName, runner.req.GetKey(), runner.req.GetFields())
+	if err != nil {
+		return Response{}, createStructureError("hset", err)
+	}
+
+	return Response{
+		Status: SetStatus,
+		Count:  added,
+	}, nil
+}
+
+func (runner *HGetRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	value, err := runner.cacheStore.HGet(ctx, tableName, runner.req.GetKey(), runner.req.GetField())
+	if err != nil {
+		return Response{}, createStructureError("hget", err)
+	}
+
+	return Response{
+		Data: value,
+	}, nil
+}
+
+func (runner *HGetAllRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	fields, err := runner.cacheStore.HGetAll(ctx, tableName, runner.req.GetKey())
+	if err != nil {
+		return Response{}, createStructureError("hgetall", err)
+	}
+
+	return Response{
+		Values: fields,
+	}, nil
+}
+
+func (runner *HDelRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(runner.req.GetFields()) == 0 {
+		return Response{}, errors.InvalidArgument("At least one field is required")
+	}
+
+	deletedCount, err := runner.cacheStore.HDel(ctx, tableName, runner.req.GetKey(), runner.req.GetFields()...)
+	if err != nil {
+		return Response{}, createStructureError("hdel", err)
+	}
+
+	return Response{
+		Status:       DeletedStatus,
+		DeletedCount: deletedCount,
+	}, nil
+}
+
+func (runner *LPushRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(runner.req.GetValues()) == 0 {
+		return Response{}, errors.InvalidArgument("At least one value is required")
+	}
+
+	length, err := runner.cacheStore.LPush(ctx, tableName, runner.req.GetKey(), runner.req.GetValues()...)
+	if err != nil {
+		return Response{}, createStructureError("lpush", err)
+	}
+
+	return Response{
+		Count: length,
+	}, nil
+}
+
+func (runner *RPushRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(runner.req.GetValues()) == 0 {
+		return Response{}, errors.InvalidArgument("At least one value is required")
+	}
+
+	length, err := runner.cacheStore.RPush(ctx, tableName, runner.req.GetKey(), runner.req.GetValues()...)
+	if err != nil {
+		return Response{}, createStructureError("rpush", err)
+	}
+
+	return Response{
+		Count: length,
+	}, nil
+}
+
+func (runner *LPopRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	value, err := runner.cacheStore.LPop(ctx, tableName, runner.req.GetKey())
+	if err != nil {
+		return Response{}, createStructureError("lpop", err)
+	}
+
+	return Response{
+		Data: value,
+	}, nil
+}
+
+func (runner *RPopRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	value, err := runner.cacheStore.RPop(ctx, tableName, runner.req.GetKey())
+	if err != nil {
+		return Response{}, createStructureError("rpop", err)
+	}
+
+	return Response{
+		Data: value,
+	}, nil
+}
+
+func (runner *LRangeRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	values, err := runner.cacheStore.LRange(ctx, tableName, runner.req.GetKey(), runner.req.GetStart(), runner.req.GetStop())
+	if err != nil {
+		return Response{}, createStructureError("lrange", err)
+	}
+
+	return Response{
+		List: values,
+	}, nil
+}
+
+func (runner *ZAddRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(runner.req.GetMembers()) == 0 {
+		return Response{}, errors.InvalidArgument("At least one member is required")
+	}
+
+	members := make([]cache.ScoredMember, len(runner.req.GetMembers()))
+	for i, m := range runner.req.GetMembers() {
+		if math.IsNaN(m.GetScore()) {
+			return Response{}, errors.InvalidArgument("Score of the member is not a number")
+		}
+		members[i] = cache.ScoredMember{Member: m.GetMember(), Score: m.GetScore()}
+	}
+
+	added, err := runner.cacheStore.ZAdd(ctx, tableName, runner.req.GetKey(), members...)
+	if err != nil {
+		return Response{}, createStructureError("zadd", err)
+	}
+
+	return Response{
+		Count: added,
+	}, nil
+}
+
+func (runner *ZRangeRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
+	if err != nil {
+		return Response{}, err
+	}
+
+	// the missing score bounds are unbounded
+	query := &cache.RangeQuery{
+		Start:   runner.req.GetStart(),
+		Stop:    runner.req.GetStop(),
+		ByScore: runner.req.GetByScore(),
+		Min:     math.Inf(-1),
+		Max:     math.Inf(1),
+		Reverse: runner.req.GetReverse(),
+	}
+	if runner.req.Min != nil {
+		query.Min = runner.req.GetMin()
+	}
+	if runner.req.Max != nil {
+		query.Max = runner.req.GetMax()
+	}
+
+	members, err := runner.cacheStore.ZRange(ctx, tableName, runner.req.GetKey(), query)
+	if err != nil {
+		return Response{}, createStructureError("zrange", err)
+	}
+
+	result := make([]*api.ScoredMember, len(members))
+	for i, m := range members {
+		result[i] = &api.ScoredMember{Member: m.Member, Score: m.Score}
+	}
+
+	return Response{
+		Members: result,
+	}, nil
+}
+
+// createStructureError returns the error of an operation on a hash, a list or a sorted set.
+func createStructureError(op string, err error) error {
+	switch err {
+	case cache.ErrKeyNotFound:
+		return errors.NotFound(err.Error())
+	case cache.ErrWrongType:
+		return errors.InvalidArgument(err.Error())
+	}
+
+	return createWriteError(op, err)
+}
+
+// counterDelta returns the delta of the counter, the counter is changed by one if the delta is not set.
+func counterDelta(delta int64) int64 {
+	if delta == 0 {
+		return 1
+	}
+
+	return delta
+}
+
+func createCounterError(op string, err error) error {
+	if err == cache.ErrNotInteger {
+		return errors.InvalidArgument(err.Error())
+	}
+
+	return createWriteError(op, err)
+}
+
+// createWriteError returns the error of an operation that adds keys to the cache, a full cache that can't evict
+// keys rejects the writes.
+func createWriteError(op string, err error) error {
+	if err == cache.ErrCacheFull {
+		return errors.ResourceExhausted(err.Error())
+	}
+	if err == cache.ErrConflict {
+		return errors.Aborted(err.Error())
+	}
+
+	return errors.Internal("Failed to invoke %s, reason %s", op, err.Error())
+}
+
 func (runner *KeysRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
 	tableName, err := getEncodedCacheTableName(ctx, tenant, runner.req.GetProject(), runner.req.GetName(), runner.encoder)
 	if err != nil {
@@ -328,10 +1042,19 @@ func (runner *KeysRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Res
 	if pattern == "" {
 		pattern = "*"
 	}
+	// the keys are streamed page by page, every page carries the cursor of the next one so the client can resume the
+	// listing from there
 	cursor := runner.req.GetCursor()
-	var internalKeys []string
 	for {
-		internalKeys, cursor = runner.cacheStore.Scan(ctx, tableName, cursor, runner.req.GetCount(), pattern)
+		if err = ctx.Err(); err != nil {
+			return Response{}, err
+		}
+
+		var internalKeys []string
+		internalKeys, cursor, err = runner.cacheStore.Scan(ctx, tableName, cursor, runner.req.GetCount(), pattern)
+		if err != nil {
+			return Response{}, errors.Internal("Failed to scan the keys, reason %s", err.Error())
+		}
 
 		// transform internal keys to user facing keys
 		userKeys := make([]string, len(internalKeys))
@@ -354,12 +1077,21 @@ func (runner *KeysRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Res
 	return Response{}, nil
 }
 
+// getEncodedCacheTableName returns the table of the cache, a cache being deleted is not found so that its keys are not
+// written or read while the DeleteWorker removes them.
 func getEncodedCacheTableName(_ context.Context, tenant *metadata.Tenant, projectName string, cacheName string, encoder metadata.CacheEncoder) (string, error) {
 	project, err := tenant.GetProject(projectName)
 	if err != nil {
 		return "", createApiError(err)
 	}
+	if project.IsCacheDeleting(cacheName) {
+		return "", createApiError(metadata.NewCacheNotFoundErr(cacheName))
+	}
+
+	return encodeCacheTableName(tenant, project, cacheName, encoder)
+}
 
+func encodeCacheTableName(tenant *metadata.Tenant, project *metadata.Project, cacheName string, encoder metadata.CacheEncoder) (string, error) {
 	// Encode cache table is encoding tenant id, project id(main database id) and cache name.
 	encodedCacheTableName, err := encoder.EncodeCacheTableName(tenant.GetNamespace().Id(), project.Id(), cacheName)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	"github.com/tigrisdata/tigris/server/metadata"
 	"github.com/tigrisdata/tigris/server/request"
 	"github.com/tigrisdata/tigris/server/transaction"
+	ulog "github.com/tigrisdata/tigris/util/log"
 )
 
 type Session interface {
@@ -99,6 +100,12 @@ func (sessMgr *SessionManager) TxExecute(ctx context.Context, runner TxRunner) (
 		_ = tx.Rollback(ctx)
 		return Response{}, err
 	}
+	// the caches backed by a collection are tracked by the tenant, bump the metadata version so that the tenants are
+	// reloaded with the updated caches
+	if err = sessMgr.versionH.Increment(ctx, tx); ulog.E(err) {
+		_ = tx.Rollback(ctx)
+		return Response{}, err
+	}
 	if err = tx.Commit(ctx); err != nil {
 		return Response{}, errors.Internal("Failed to run runner in transaction, failed to commit")
 	}
@@ -0,0 +1,263 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/buger/jsonparser"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/query/filter"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/cdc"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/store/kv"
+	"github.com/tigrisdata/tigris/value"
+)
+
+const (
+	// versionsPerSecond is the rate at which FoundationDB advances the commit version, the VERSIONS_PER_SECOND knob of
+	// the cluster. The version only follows the wall clock approximately, it is moved ahead on every recovery of the
+	// cluster, so a timestamp converted with this rate may map to a version later than the timestamp.
+	versionsPerSecond = 1_000_000
+	// mvccWindowVersions is how far behind the latest version FoundationDB still serves the reads.
+	mvccWindowVersions = 5 * versionsPerSecond
+)
+
+// AsOfReader serves the reads at a historical version. The version is read directly from FoundationDB while it is
+// within the MVCC window, older versions are reconstructed from the CDC history if it is enabled.
+type AsOfReader struct {
+	cdcMgr  *cdc.Manager
+	encoder metadata.Encoder
+	version int64
+	current int64
+}
+
+// NewAsOfReader resolves the requested version against the latest version of the database.
+func NewAsOfReader(ctx context.Context, txMgr *transaction.Manager, cdcMgr *cdc.Manager, encoder metadata.Encoder, asOf *api.AsOf) (*AsOfReader, error) {
+	tx, err := txMgr.StartTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := tx.GetReadVersion(ctx)
+	_ = tx.Rollback(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := resolveAsOf(asOf, current, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &AsOfReader{
+		cdcMgr:  cdcMgr,
+		encoder: encoder,
+		version: version,
+		current: current,
+	}, nil
+}
+
+// resolveAsOf converts the as_of of the request to the commit version. The timestamp is converted by going back from
+// the current version at the versionsPerSecond rate, so the resulting version is an approximation of the timestamp.
+func resolveAsOf(asOf *api.AsOf, current int64, now time.Time) (int64, error) {
+	var version int64
+	switch {
+	case asOf.GetVersion() != 0 && len(asOf.GetTimestamp()) > 0:
+		return 0, errors.InvalidArgument("only one of version or timestamp can be set in as_of")
+	case asOf.GetVersion() != 0:
+		version = asOf.GetVersion()
+	case len(asOf.GetTimestamp()) > 0:
+		ts, err := time.Parse(time.RFC3339Nano, asOf.GetTimestamp())
+		if err != nil {
+			return 0, errors.InvalidArgument("as_of timestamp should be in RFC 3339 format, got '%s'", asOf.GetTimestamp())
+		}
+		// versionsPerSecond is one version per microsecond
+		version = current - now.Sub(ts).Microseconds()
+	default:
+		return 0, errors.InvalidArgument("as_of requires either version or timestamp")
+	}
+
+	if version <= 0 {
+		return 0, errors.InvalidArgument("as_of version should be positive, got '%d'", version)
+	}
+	if version > current {
+		return 0, errors.InvalidArgument("as_of version '%d' is ahead of the current version '%d'", version, current)
+	}
+
+	return version, nil
+}
+
+// InMVCCWindow returns true if the version can be read directly from FoundationDB.
+func (r *AsOfReader) InMVCCWindow() bool {
+	return r.current-r.version < mvccWindowVersions
+}
+
+// WrapContext returns the context to start the transactions reading at the version.
+func (r *AsOfReader) WrapContext(ctx context.Context) context.Context {
+	return kv.CtxWithReadVersion(ctx, r.version)
+}
+
+// TooOld is returned when the version left the MVCC window while it was read.
+func (r *AsOfReader) TooOld() error {
+	return errVersionTooOld(r.version, r.current-mvccWindowVersions)
+}
+
+// HistoryIterator returns the documents of the collection matching the filter at the version, reconstructed from the
+// CDC history in the primary key order. The history only covers the collections created while the CDC was enabled,
+// the older collections can't be read past the MVCC window, and the versions older than the HistoryRetention are
+// rejected. The history is replayed from the version the collection was created at. The matching documents are kept
+// in memory while the history is replayed, so the read fails once more than MaxHistoryRows documents match.
+func (r *AsOfReader) HistoryIterator(ctx context.Context, dbName string, coll *schema.DefaultCollection, wrapped *filter.WrappedFilter) (Iterator, error) {
+	if !config.DefaultConfig.Cdc.Enabled || coll.HistoryVersion == 0 {
+		return nil, r.TooOld()
+	}
+	if retention := config.DefaultConfig.Cdc.HistoryRetention; retention > 0 && r.current-r.version > retention.Microseconds() {
+		// versionsPerSecond is one version per microsecond
+		return nil, api.Errorf(api.Code_OUT_OF_RANGE,
+			"requested version '%d' is older than the history retention of '%s', the oldest available version is '%d'",
+			r.version, retention, r.current-retention.Microseconds())
+	}
+	if r.version < coll.HistoryVersion {
+		return nil, errVersionTooOld(r.version, coll.HistoryVersion)
+	}
+
+	history, err := r.cdcMgr.History(dbName)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRows := config.DefaultConfig.Cdc.MaxHistoryRows
+	docs := make(map[string]*Row)
+	err = history.Replay(ctx, coll.EncodedName, coll.HistoryVersion, r.version, func(e *cdc.HistoryEvent) error {
+		// only the last change of a document decides if it is returned, so a document not matching the filter after
+		// the change is dropped even if its previous version matched
+		if e.Op == kv.DeleteEvent || !matchesHistoryRow(wrapped, e.Data) {
+			delete(docs, string(e.Key))
+			return nil
+		}
+
+		docs[string(e.Key)] = &Row{Data: e.Data}
+		if maxRows > 0 && len(docs) > maxRows {
+			return errors.ResourceExhausted("as_of read matches more than '%d' documents older than version '%d', narrow the filter",
+				maxRows, r.current-mvccWindowVersions)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*Row, 0, len(docs))
+	for _, row := range docs {
+		if row.Key, err = historyRowKey(r.encoder, coll, row.Data.RawData); err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return bytes.Compare(rows[i].Key, rows[j].Key) < 0
+	})
+
+	return &rowsIterator{rows: rows}, nil
+}
+
+func matchesHistoryRow(wrapped *filter.WrappedFilter, data *internal.TableData) bool {
+	if wrapped == nil || wrapped.None() {
+		return true
+	}
+
+	tsJSON, err := data.TimeStampsToJSON()
+	if err != nil {
+		return false
+	}
+
+	return wrapped.Matches(data.RawData, tsJSON)
+}
+
+// historyRowKey builds the primary key of the reconstructed document, so that the rows of the history are ordered and
+// resumed the same way as the rows read from the table. The key of the change is not used as it is published in the
+// JSON form which doesn't preserve the types of the key parts.
+func historyRowKey(encoder metadata.Encoder, coll *schema.DefaultCollection, doc []byte) ([]byte, error) {
+	parts := make([]any, 0, len(coll.PrimaryKey.Fields))
+	for _, field := range coll.PrimaryKey.Fields {
+		jsonVal, _, _, err := jsonparser.Get(doc, field.FieldName)
+		if err != nil {
+			return nil, errors.Internal("missing primary key field '%s' in the history of the document", field.FieldName)
+		}
+
+		v, err := value.NewValue(field.Type(), jsonVal)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, v.AsInterface())
+	}
+
+	key, err := encoder.EncodeKey(coll.EncodedName, coll.PrimaryKey, parts)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.SerializeToBytes(), nil
+}
+
+// asOfReaderOptions adjusts the reader options for the historical read. The search store only has the latest version
+// of the documents, so the reads which would fall back to it are served by the table scan.
+func asOfReaderOptions(options readerOptions, coll *schema.DefaultCollection) (readerOptions, error) {
+	if !options.inMemoryStore {
+		return options, nil
+	}
+	if options.sorting != nil {
+		return options, errors.InvalidArgument("sort is not supported by the as_of reads")
+	}
+
+	options.inMemoryStore = false
+	options.tablePlan = &filter.TableScanPlan{Table: coll.EncodedName}
+
+	return options, nil
+}
+
+func errVersionTooOld(version int64, oldest int64) error {
+	return api.Errorf(api.Code_OUT_OF_RANGE, "requested version '%d' is older than the oldest available version '%d'",
+		version, oldest)
+}
+
+// rowsIterator iterates over the rows materialized in memory.
+type rowsIterator struct {
+	rows []*Row
+	pos  int
+}
+
+func (it *rowsIterator) Next(row *Row) bool {
+	if it.pos >= len(it.rows) {
+		return false
+	}
+
+	*row = *it.rows[it.pos]
+	it.pos++
+
+	return true
+}
+
+func (*rowsIterator) Interrupted() error { return nil }
@@ -0,0 +1,157 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/query/sort"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+)
+
+func TestResolveAsOf(t *testing.T) {
+	now := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	current := int64(100_000_000)
+
+	cases := []struct {
+		name    string
+		asOf    *api.AsOf
+		version int64
+		err     string
+	}{
+		{"version", &api.AsOf{Version: 99_000_000}, 99_000_000, ""},
+		{"timestamp", &api.AsOf{Timestamp: "2023-04-01T09:59:58Z"}, 98_000_000, ""},
+		{"timestamp nanos", &api.AsOf{Timestamp: "2023-04-01T09:59:59.5Z"}, 99_500_000, ""},
+		{"both", &api.AsOf{Version: 1, Timestamp: "2023-04-01T09:59:58Z"}, 0, "only one of version or timestamp"},
+		{"empty", &api.AsOf{}, 0, "as_of requires either version or timestamp"},
+		{"bad timestamp", &api.AsOf{Timestamp: "yesterday"}, 0, "RFC 3339"},
+		{"future version", &api.AsOf{Version: current + 1}, 0, "is ahead of the current version"},
+		{"future timestamp", &api.AsOf{Timestamp: "2023-04-01T10:00:01Z"}, 0, "is ahead of the current version"},
+		{"before first version", &api.AsOf{Timestamp: "2023-03-01T10:00:00Z"}, 0, "should be positive"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			version, err := resolveAsOf(c.asOf, current, now)
+			if len(c.err) > 0 {
+				require.ErrorContains(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.version, version)
+		})
+	}
+}
+
+func TestAsOfReader(t *testing.T) {
+	r := &AsOfReader{current: 100_000_000, version: 96_000_000}
+	require.True(t, r.InMVCCWindow())
+
+	r.version = 90_000_000
+	require.False(t, r.InMVCCWindow())
+
+	//nolint:errorlint
+	require.Equal(t, api.Code_OUT_OF_RANGE, r.TooOld().(*api.TigrisError).Code)
+	require.ErrorContains(t, r.TooOld(), "requested version '90000000' is older than the oldest available version '95000000'")
+}
+
+func TestAsOfHistoryWindow(t *testing.T) {
+	enabled := config.DefaultConfig.Cdc.Enabled
+	config.DefaultConfig.Cdc.Enabled = true
+	defer func() { config.DefaultConfig.Cdc.Enabled = enabled }()
+
+	r := &AsOfReader{current: 100_000_000, version: 90_000_000}
+
+	// the collection created before the CDC was enabled is not covered by the history
+	_, err := r.HistoryIterator(context.TODO(), "db1", &schema.DefaultCollection{}, nil)
+	require.ErrorContains(t, err, "older than the oldest available version '95000000'")
+
+	_, err = r.HistoryIterator(context.TODO(), "db1", &schema.DefaultCollection{HistoryVersion: 91_000_000}, nil)
+	require.ErrorContains(t, err, "older than the oldest available version '91000000'")
+
+	retention := config.DefaultConfig.Cdc.HistoryRetention
+	config.DefaultConfig.Cdc.HistoryRetention = 5 * time.Second
+	defer func() { config.DefaultConfig.Cdc.HistoryRetention = retention }()
+
+	_, err = r.HistoryIterator(context.TODO(), "db1", &schema.DefaultCollection{HistoryVersion: 1}, nil)
+	require.ErrorContains(t, err, "older than the history retention of '5s', the oldest available version is '95000000'")
+}
+
+func TestHistoryRowKey(t *testing.T) {
+	coll := &schema.DefaultCollection{
+		EncodedName: []byte("t1"),
+		PrimaryKey: &schema.Index{
+			Name: "pkey",
+			Fields: []*schema.Field{
+				{FieldName: "id", DataType: schema.Int64Type},
+				{FieldName: "name", DataType: schema.StringType},
+			},
+		},
+	}
+	encoder := metadata.NewEncoder()
+
+	first, err := historyRowKey(encoder, coll, []byte(`{"id": 2, "name": "a", "value": 1}`))
+	require.NoError(t, err)
+	second, err := historyRowKey(encoder, coll, []byte(`{"id": 10, "name": "a"}`))
+	require.NoError(t, err)
+
+	// the keys are ordered by the typed primary key
+	require.Negative(t, bytes.Compare(first, second))
+
+	key, err := encoder.EncodeKey(coll.EncodedName, coll.PrimaryKey, []any{int64(2), "a"})
+	require.NoError(t, err)
+	require.Equal(t, key.SerializeToBytes(), first)
+
+	_, err = historyRowKey(encoder, coll, []byte(`{"id": 2}`))
+	require.ErrorContains(t, err, "missing primary key field 'name'")
+}
+
+func TestAsOfReaderOptions(t *testing.T) {
+	coll := &schema.DefaultCollection{EncodedName: []byte("t1")}
+
+	options, err := asOfReaderOptions(readerOptions{inMemoryStore: true}, coll)
+	require.NoError(t, err)
+	require.False(t, options.inMemoryStore)
+	require.Equal(t, []byte("t1"), options.tablePlan.Table)
+
+	_, err = asOfReaderOptions(readerOptions{inMemoryStore: true, sorting: &sort.Ordering{}}, coll)
+	require.ErrorContains(t, err, "sort is not supported")
+}
+
+func TestRowsIterator(t *testing.T) {
+	it := &rowsIterator{rows: []*Row{
+		{Data: internal.NewTableData([]byte(`{"id":1}`))},
+		{Data: internal.NewTableData([]byte(`{"id":2}`))},
+	}}
+
+	var (
+		row  Row
+		docs []string
+	)
+	for it.Next(&row) {
+		docs = append(docs, string(row.Data.RawData))
+	}
+
+	require.Equal(t, []string{`{"id":1}`, `{"id":2}`}, docs)
+	require.NoError(t, it.Interrupted())
+}
@@ -121,55 +121,137 @@ func (runner *BaseQueryRunner) getDBAndCollection(ctx context.Context, tx transa
 func (runner *BaseQueryRunner) insertOrReplace(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant,
 	coll *schema.DefaultCollection, documents [][]byte, insert bool,
 ) (*internal.Timestamp, [][]byte, error) {
-	var err error
 	ts := internal.NewTimestamp()
 	allKeys := make([][]byte, 0, len(documents))
 	indexer := NewSecondaryIndexer(coll)
 	for _, doc := range documents {
-		// reset it back to doc
-		doc, err = runner.mutateAndValidatePayload(ctx, coll, newInsertPayloadMutator(coll, ts.ToRFC3339()), doc)
+		keyGen, _, err := runner.writeDocument(ctx, tx, tenant, coll, indexer, ts, doc, insert)
 		if err != nil {
 			return nil, nil, err
 		}
+		allKeys = append(allKeys, keyGen.getKeysForResp())
+	}
+	return ts, allKeys, nil
+}
 
-		keyGen := newKeyGenerator(doc, tenant.TableKeyGenerator, coll.GetPrimaryKey())
-		key, err := keyGen.generate(ctx, runner.txMgr, runner.encoder, coll.EncodedName)
-		if err != nil {
-			return nil, nil, err
-		}
+// insertOrReplaceUnordered is the "ordered: false" variant of the insertOrReplace. The documents which are rejected
+// because of their content are skipped and reported individually, the rest of the batch is written. Keys are in the
+// order of the input documents, the keys of the skipped documents are nil.
+func (runner *BaseQueryRunner) insertOrReplaceUnordered(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant,
+	coll *schema.DefaultCollection, documents [][]byte, insert bool,
+) (*internal.Timestamp, [][]byte, []*api.DocError, error) {
+	var docErrors []*api.DocError
 
-		// we need to use keyGen updated document as it may be mutated by adding auto-generated keys.
-		tableData := internal.NewTableDataWithTS(ts, nil, keyGen.document)
-		tableData.SetVersion(int32(coll.GetVersion()))
-
-		if insert || keyGen.forceInsert {
-			// we use Insert API, in case user is using autogenerated primary key and has primary key field
-			// as Int64 or timestamp to ensure uniqueness if multiple workers end up generating same timestamp.
-			err = tx.Insert(ctx, key, tableData)
-		} else {
-			szCtx := ctx
-			if config.DefaultConfig.SecondaryIndex.WriteEnabled {
-				sz, err := indexer.ReadDocAndDelete(ctx, tx, key)
-				if err != nil {
-					return nil, nil, err
-				}
-				szCtx = kv.CtxWithSize(ctx, sz)
-			}
-			err = tx.Replace(szCtx, key, tableData, false)
+	ts := internal.NewTimestamp()
+	allKeys := make([][]byte, len(documents))
+	indexer := NewSecondaryIndexer(coll)
+	for i, doc := range documents {
+		keyGen, written, err := runner.writeDocument(ctx, tx, tenant, coll, indexer, ts, doc, insert)
+		if err == nil {
+			allKeys[i] = keyGen.getKeysForResp()
+			continue
 		}
-		if err != nil {
-			return nil, nil, err
+
+		// the row can't be left in the transaction without its index entries, so the batch fails
+		if written || !isDocumentError(err) {
+			return nil, nil, nil, err
 		}
+		docErrors = append(docErrors, newDocError(i, newKeyGenerator(doc, nil, coll.GetPrimaryKey()).keysFromDocument(), err))
+	}
+
+	return ts, allKeys, docErrors, nil
+}
 
+// writeDocument validates the document, generates its key and writes it along with the secondary index entries. The
+// returned written flag is set if the error happened after the row was written in the transaction, the transaction
+// must not be committed then.
+func (runner *BaseQueryRunner) writeDocument(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant,
+	coll *schema.DefaultCollection, indexer SecondaryIndexer, ts *internal.Timestamp, doc []byte, insert bool,
+) (*keyGenerator, bool, error) {
+	// reset it back to doc
+	doc, err := runner.mutateAndValidatePayload(ctx, coll, newInsertPayloadMutator(coll, ts.ToRFC3339()), doc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	keyGen := newKeyGenerator(doc, tenant.TableKeyGenerator, coll.GetPrimaryKey())
+	key, err := keyGen.generate(ctx, runner.txMgr, runner.encoder, coll.EncodedName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// we need to use keyGen updated document as it may be mutated by adding auto-generated keys.
+	tableData := internal.NewTableDataWithTS(ts, nil, keyGen.document)
+	tableData.SetVersion(int32(coll.GetVersion()))
+
+	// set once the index entries of the previous version may be deleted
+	modified := false
+	if insert || keyGen.forceInsert {
+		// we use Insert API, in case user is using autogenerated primary key and has primary key field
+		// as Int64 or timestamp to ensure uniqueness if multiple workers end up generating same timestamp.
+		err = tx.Insert(ctx, key, tableData)
+	} else {
+		szCtx := ctx
 		if config.DefaultConfig.SecondaryIndex.WriteEnabled {
-			err := indexer.Index(ctx, tx, tableData, key.IndexParts())
+			modified = true
+			sz, err := indexer.ReadDocAndDelete(ctx, tx, key)
 			if err != nil {
-				return nil, nil, err
+				return nil, modified, err
 			}
+			szCtx = kv.CtxWithSize(ctx, sz)
 		}
-		allKeys = append(allKeys, keyGen.getKeysForResp())
+		err = tx.Replace(szCtx, key, tableData, false)
 	}
-	return ts, allKeys, err
+	if err != nil {
+		return nil, modified, err
+	}
+
+	if config.DefaultConfig.SecondaryIndex.WriteEnabled {
+		if err = indexer.Index(ctx, tx, tableData, key.IndexParts()); err != nil {
+			return nil, true, err
+		}
+	}
+
+	return keyGen, false, nil
+}
+
+// isUnordered returns true if the request explicitly asked to continue writing the batch on document errors. The
+// batch is ordered by default.
+func isUnordered(ordered *bool) bool {
+	return ordered != nil && !*ordered
+}
+
+// isDocumentError returns true if the error is caused by the content of the document and not by the state of the
+// transaction, such documents can be skipped without failing the batch.
+func isDocumentError(err error) bool {
+	if err == kv.ErrDuplicateKey {
+		return true
+	}
+
+	//nolint:errorlint
+	ep, ok := err.(*api.TigrisError)
+
+	return ok && (ep.Code == api.Code_INVALID_ARGUMENT || ep.Code == api.Code_ALREADY_EXISTS)
+}
+
+// newDocError converts the document error to the API representation.
+func newDocError(index int, key []byte, err error) *api.DocError {
+	if err == kv.ErrDuplicateKey {
+		err = errors.AlreadyExists(err.(kv.StoreError).Msg())
+	}
+
+	docErr := &api.DocError{
+		Index: int32(index),
+		Key:   key,
+		Error: api.ToAPIError(CreateApiError(err)),
+	}
+
+	//nolint:errorlint
+	if ep, ok := err.(*api.TigrisError); ok {
+		docErr.Field = ep.Field()
+	}
+
+	return docErr
 }
 
 func (*BaseQueryRunner) mutateAndValidatePayload(ctx context.Context, coll *schema.DefaultCollection, mutator mutator, doc []byte) ([]byte, error) {
@@ -251,6 +333,12 @@ func (*BaseQueryRunner) getSearchOrdering(coll *schema.DefaultCollection, sortRe
 			(*ordering)[i].Name = cf.InMemoryName()
 		}
 
+		if sf.Near != nil {
+			if cf.DataType != schema.GeoPointType || !cf.SearchIndexed {
+				return nil, errors.InvalidArgument("Search results can't be sorted by distance on `%s` field. Only search indexed 'geopoint' fields are supported", sf.Name)
+			}
+			continue
+		}
 		if !cf.Sortable {
 			return nil, errors.InvalidArgument("Search results can't be sorted on `%s` field. Enable sorting on this field", sf.Name)
 		}
@@ -0,0 +1,746 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog/log"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/query/filter"
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/store/kv"
+	"github.com/tigrisdata/tigris/store/search"
+)
+
+const (
+	defaultConsistencyBatchSize = 100
+	// maxConsistencyBatchSize is the maximum number of documents the search store returns in a single page.
+	maxConsistencyBatchSize  = 250
+	defaultConsistencySample = 20
+	// searchTimestampTolerance absorbs the precision lost by the search store returning the timestamps as floats.
+	searchTimestampTolerance = 1000
+	// maxConsistencyOrphanRepairs is the maximum number of the orphaned search documents repaired by a single run, their
+	// ids are kept in memory till the end of the scan. The rest are repaired by the next run.
+	maxConsistencyOrphanRepairs = 10000
+)
+
+// ConsistencyOptions is the spec of the verify job.
+type ConsistencyOptions struct {
+	// Search compares the rows with the implicit search index of the collection.
+	Search bool `json:"search"`
+	// SecondaryIndex compares the rows with the secondary indexes of the collection.
+	SecondaryIndex bool `json:"secondary_index"`
+	// Repair fixes the found inconsistencies, otherwise they are only reported.
+	Repair bool `json:"repair,omitempty"`
+	// BatchSize is the number of rows checked in a single transaction.
+	BatchSize int `json:"batch_size,omitempty"`
+	// MaxSamples is the maximum number of reported keys of every index.
+	MaxSamples int `json:"max_samples,omitempty"`
+}
+
+// ConsistencyIssues is the outcome of the check of a single index.
+type ConsistencyIssues struct {
+	// Checked is the number of the checked rows plus the number of the checked index entries.
+	Checked int64 `json:"checked"`
+	// Missing rows are not in the index.
+	Missing int64 `json:"missing"`
+	// Stale rows are in the index with outdated content.
+	Stale int64 `json:"stale"`
+	// Orphaned index entries don't have a matching row.
+	Orphaned int64 `json:"orphaned"`
+	Repaired int64 `json:"repaired"`
+	// Samples are the keys of the first inconsistent documents.
+	Samples []string `json:"samples,omitempty"`
+}
+
+func (i *ConsistencyIssues) total() int64 {
+	return i.Missing + i.Stale + i.Orphaned
+}
+
+func (i *ConsistencyIssues) sample(max int, kind string, key any) {
+	if len(i.Samples) < max {
+		i.Samples = append(i.Samples, fmt.Sprintf("%s: %v", kind, key))
+	}
+}
+
+// ConsistencyReport is the result of the verify job.
+type ConsistencyReport struct {
+	Rows           int64              `json:"rows"`
+	Search         *ConsistencyIssues `json:"search,omitempty"`
+	SecondaryIndex *ConsistencyIssues `json:"secondary_index,omitempty"`
+}
+
+// ConsistencyChecker detects the drift between the rows of a collection and its implicit search index and secondary
+// indexes, for example the writes missed by the post-commit search indexing or the partially built indexes. The
+// collection is scanned in batches, so the check doesn't see a single snapshot of the collection and the documents
+// written while the check is running may be reported, the check should be repeated before repairing a large number
+// of documents.
+type ConsistencyChecker struct {
+	*BaseQueryRunner
+
+	opts ConsistencyOptions
+}
+
+func NewConsistencyChecker(txMgr *transaction.Manager, searchStore search.Store) *ConsistencyChecker {
+	return &ConsistencyChecker{
+		BaseQueryRunner: NewBaseQueryRunner(metadata.NewEncoder(), nil, txMgr, searchStore, nil),
+	}
+}
+
+// NewVerifyJob returns a new verify job of the collection.
+func NewVerifyJob(project string, branch string, collection string, opts *ConsistencyOptions) (*metadata.JobMetadata, error) {
+	if !opts.Search && !opts.SecondaryIndex {
+		return nil, errors.InvalidArgument("nothing to verify, either search or secondary index should be set")
+	}
+	if opts.BatchSize < 0 || opts.BatchSize > maxConsistencyBatchSize {
+		return nil, errors.InvalidArgument("batch size should be between 1 and %d", maxConsistencyBatchSize)
+	}
+
+	job := metadata.NewJobMetadata(metadata.VerifyJobType, project, branch, collection)
+
+	var err error
+	if job.Spec, err = jsoniter.Marshal(opts); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// Verify runs the verify job till completion, the report is stored in the result of the job.
+func (c *ConsistencyChecker) Verify(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) (*metadata.JobMetadata, error) {
+	if err := jsoniter.Unmarshal(job.Spec, &c.opts); err != nil {
+		return nil, errors.Internal("invalid verify job spec")
+	}
+	if c.opts.BatchSize == 0 {
+		c.opts.BatchSize = defaultConsistencyBatchSize
+	}
+	if c.opts.MaxSamples == 0 {
+		c.opts.MaxSamples = defaultConsistencySample
+	}
+
+	report, err := c.check(ctx, tenant, job)
+	if report != nil {
+		job.Processed = report.Rows
+		job.Failed = 0
+		if report.Search != nil {
+			job.Failed += report.Search.total()
+		}
+		if report.SecondaryIndex != nil {
+			job.Failed += report.SecondaryIndex.total()
+		}
+
+		var merr error
+		if job.Result, merr = jsoniter.Marshal(report); merr != nil && err == nil {
+			err = merr
+		}
+	}
+
+	job.State = metadata.JobCompleted
+	if err != nil {
+		job.State = metadata.JobFailed
+		job.Message = err.Error()
+	}
+
+	if serr := c.saveJob(ctx, tenant, job); serr != nil && err == nil {
+		err = serr
+	}
+
+	return job, err
+}
+
+func (c *ConsistencyChecker) check(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) (*ConsistencyReport, error) {
+	_, coll, err := c.getDBAndCollection(ctx, nil, tenant, job.Project, job.Collection, job.Branch)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.mustBeDocumentsCollection(coll, "verify"); err != nil {
+		return nil, err
+	}
+
+	report := &ConsistencyReport{}
+	if c.opts.Search && coll.GetImplicitSearchIndex() != nil {
+		report.Search = &ConsistencyIssues{}
+	}
+	if c.opts.SecondaryIndex && len(coll.EncodedTableIndexName) > 0 {
+		report.SecondaryIndex = &ConsistencyIssues{}
+	}
+
+	if err = c.checkRows(ctx, coll, report); err != nil {
+		return report, err
+	}
+
+	if report.Search != nil {
+		if err = c.checkSearchOrphans(ctx, coll, report.Search); err != nil {
+			return report, err
+		}
+	}
+
+	if report.SecondaryIndex != nil {
+		if err = c.checkIndexOrphans(ctx, coll, report.SecondaryIndex); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// checkRows scans the collection and verifies that every row is in the indexes.
+func (c *ConsistencyChecker) checkRows(ctx context.Context, coll *schema.DefaultCollection, report *ConsistencyReport) error {
+	var last []byte
+	for {
+		done, err := c.checkRowsBatch(ctx, coll, report, &last)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+type consistencyRow struct {
+	key       []any
+	searchKey string
+	data      *internal.TableData
+}
+
+func (c *ConsistencyChecker) checkRowsBatch(ctx context.Context, coll *schema.DefaultCollection, report *ConsistencyReport, last *[]byte) (bool, error) {
+	tx, err := c.txMgr.StartTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	iter, err := createBulkDocsReader(ctx, tx, coll.EncodedName, nil, *last)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		row  Row
+		rows []consistencyRow
+	)
+	for len(rows) < c.opts.BatchSize && iter.Next(&row) {
+		if *last != nil && bytes.Equal(row.Key, *last) {
+			// the range scan starts from the last checked key
+			continue
+		}
+		*last = row.Key
+
+		key, err := keys.FromBinary(coll.EncodedName, row.Key)
+		if err != nil {
+			return false, err
+		}
+
+		searchKey, err := CreateSearchKey(kv.BuildKey(key.IndexParts()...))
+		if err != nil {
+			return false, err
+		}
+
+		rows = append(rows, consistencyRow{key: key.IndexParts(), searchKey: searchKey, data: row.Data})
+	}
+	if err = iter.Interrupted(); err != nil {
+		return false, err
+	}
+
+	report.Rows += int64(len(rows))
+
+	if report.SecondaryIndex != nil {
+		if err = c.checkRowsIndexed(ctx, tx, coll, rows, report.SecondaryIndex); err != nil {
+			return false, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	if report.Search != nil && len(rows) > 0 {
+		if err = c.checkRowsSearch(ctx, coll, rows, report.Search); err != nil {
+			return false, err
+		}
+	}
+
+	return len(rows) < c.opts.BatchSize, nil
+}
+
+// checkRowsSearch compares the batch of rows with the documents in the search index. A document is stale if its
+// timestamps don't match the row.
+func (c *ConsistencyChecker) checkRowsSearch(ctx context.Context, coll *schema.DefaultCollection, rows []consistencyRow, issues *ConsistencyIssues) error {
+	index := coll.GetImplicitSearchIndex().StoreIndexName()
+
+	ids := make([]string, len(rows))
+	for i, r := range rows {
+		ids[i] = r.searchKey
+	}
+
+	result, err := c.searchStore.GetDocuments(ctx, index, ids)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[string]map[string]any)
+	if result != nil && result.Hits != nil {
+		for _, hit := range *result.Hits {
+			if hit.Document == nil {
+				continue
+			}
+			if id, ok := (*hit.Document)[schema.SearchId].(string); ok {
+				found[id] = *hit.Document
+			}
+		}
+	}
+
+	var stale []consistencyRow
+	for _, r := range rows {
+		issues.Checked++
+
+		doc, ok := found[r.searchKey]
+		switch {
+		case !ok:
+			issues.Missing++
+			issues.sample(c.opts.MaxSamples, "missing", r.searchKey)
+		case !sameSearchTimestamp(doc[schema.ReservedFields[schema.CreatedAt]], r.data.CreatedAt) ||
+			!sameSearchTimestamp(doc[schema.ReservedFields[schema.UpdatedAt]], r.data.UpdatedAt):
+			issues.Stale++
+			issues.sample(c.opts.MaxSamples, "stale", r.searchKey)
+		default:
+			continue
+		}
+
+		stale = append(stale, r)
+	}
+
+	if !c.opts.Repair || len(stale) == 0 {
+		return nil
+	}
+
+	// the rows may be updated after they are read by the check, the documents are indexed from their current version
+	// and the deleted rows are left to the search indexing of the delete
+	current, err := c.currentRows(ctx, coll, stale)
+	if err != nil {
+		return err
+	}
+
+	var repair [][]byte
+	for _, r := range current {
+		packed, err := PackSearchFields(ctx, r.data, coll, r.searchKey)
+		if err != nil {
+			return err
+		}
+		repair = append(repair, packed)
+	}
+
+	if len(repair) == 0 {
+		return nil
+	}
+
+	resp, err := c.searchStore.IndexDocuments(ctx, index, bytes.NewReader(bytes.Join(repair, []byte("\n"))), search.IndexDocumentsOptions{
+		Action:    search.Replace,
+		BatchSize: len(repair),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range resp {
+		if !r.Success {
+			log.Error().Str("index", index).Int("code", r.Code).Str("error", r.Error).Msg("failed to repair search document")
+			continue
+		}
+		issues.Repaired++
+	}
+
+	return nil
+}
+
+// currentRows reads the current version of the rows, the deleted rows are skipped.
+func (c *ConsistencyChecker) currentRows(ctx context.Context, coll *schema.DefaultCollection, rows []consistencyRow) ([]consistencyRow, error) {
+	tx, err := c.txMgr.StartTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	current := make([]consistencyRow, 0, len(rows))
+	for _, r := range rows {
+		it, err := tx.Read(ctx, keys.NewKey(coll.EncodedName, r.key...), false)
+		if err != nil {
+			return nil, err
+		}
+
+		var row kv.KeyValue
+		if !it.Next(&row) {
+			if err = it.Err(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		r.data = row.Data
+		current = append(current, r)
+	}
+
+	return current, nil
+}
+
+// checkRowsIndexed verifies that all the secondary index entries of the rows exist.
+func (c *ConsistencyChecker) checkRowsIndexed(ctx context.Context, tx transaction.Tx, coll *schema.DefaultCollection, rows []consistencyRow, issues *ConsistencyIssues) error {
+	indexer := newSecondaryIndexerImpl(coll)
+	for _, r := range rows {
+		issues.Checked++
+
+		updateSet, err := indexer.buildAddAndRemoveKVs(r.data, nil, r.key)
+		if err != nil {
+			return err
+		}
+
+		var missing []keys.Key
+		for _, indexKey := range updateSet.addKeys {
+			exists, err := keyExists(ctx, tx, indexKey)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				missing = append(missing, indexKey)
+			}
+		}
+
+		if len(missing) == 0 {
+			continue
+		}
+
+		issues.Missing++
+		issues.sample(c.opts.MaxSamples, "missing", r.key[1:])
+
+		if c.opts.Repair {
+			for _, indexKey := range missing {
+				if err = tx.Replace(ctx, indexKey, internal.EmptyData, false); err != nil {
+					return err
+				}
+			}
+			issues.Repaired++
+		}
+	}
+
+	return nil
+}
+
+// checkSearchOrphans scans the search index and verifies that every document has a row. The orphaned documents are
+// deleted after the scan, so the scan pages don't shift, at most maxConsistencyOrphanRepairs of them are deleted by a
+// single run.
+func (c *ConsistencyChecker) checkSearchOrphans(ctx context.Context, coll *schema.DefaultCollection, issues *ConsistencyIssues) error {
+	wrappedFilter := filter.NewWrappedFilter(nil)
+	reader := NewSearchReader(ctx, c.searchStore, coll, qsearch.NewBuilder().
+		Filter(wrappedFilter).
+		PageSize(maxConsistencyBatchSize).
+		Build())
+	iter := reader.Iterator(coll, wrappedFilter)
+
+	var (
+		row     Row
+		orphans []consistencyRow
+		batch   []consistencyRow
+	)
+	orphaned := func(r consistencyRow) {
+		issues.Orphaned++
+		issues.sample(c.opts.MaxSamples, "orphaned", r.searchKey)
+		if c.opts.Repair && len(orphans) < maxConsistencyOrphanRepairs {
+			orphans = append(orphans, r)
+		}
+	}
+	flush := func() error {
+		missing, err := c.missingRows(ctx, coll, batch)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range missing {
+			orphaned(r)
+		}
+		batch = batch[:0]
+
+		return nil
+	}
+
+	for iter.Next(&row) {
+		issues.Checked++
+
+		key, err := primaryKeyParts(coll, row.Data.RawData)
+		if err != nil {
+			// the document doesn't even have the primary key
+			orphaned(consistencyRow{searchKey: string(row.Key)})
+			continue
+		}
+
+		batch = append(batch, consistencyRow{key: key, searchKey: string(row.Key)})
+		if len(batch) == c.opts.BatchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := iter.Interrupted(); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	return c.deleteSearchOrphans(ctx, coll, orphans, issues)
+}
+
+// deleteSearchOrphans deletes the orphaned search documents. The rows may be inserted after they are checked, so the
+// rows are checked again right before their documents are deleted.
+func (c *ConsistencyChecker) deleteSearchOrphans(ctx context.Context, coll *schema.DefaultCollection, orphans []consistencyRow, issues *ConsistencyIssues) error {
+	index := coll.GetImplicitSearchIndex().StoreIndexName()
+	for len(orphans) > 0 {
+		n := c.opts.BatchSize
+		if n > len(orphans) {
+			n = len(orphans)
+		}
+
+		var keyed, deleted []consistencyRow
+		for _, r := range orphans[:n] {
+			if r.key == nil {
+				deleted = append(deleted, r)
+			} else {
+				keyed = append(keyed, r)
+			}
+		}
+		missing, err := c.missingRows(ctx, coll, keyed)
+		if err != nil {
+			return err
+		}
+		deleted = append(deleted, missing...)
+
+		for _, r := range deleted {
+			if err = c.searchStore.DeleteDocument(ctx, index, r.searchKey); err != nil && !search.IsErrNotFound(err) {
+				return err
+			}
+			issues.Repaired++
+		}
+		orphans = orphans[n:]
+	}
+
+	return nil
+}
+
+// missingRows returns the rows of the batch which don't exist in the collection.
+func (c *ConsistencyChecker) missingRows(ctx context.Context, coll *schema.DefaultCollection, batch []consistencyRow) ([]consistencyRow, error) {
+	if len(batch) == 0 {
+		return nil, nil
+	}
+
+	tx, err := c.txMgr.StartTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var missing []consistencyRow
+	for _, r := range batch {
+		exists, err := keyExists(ctx, tx, keys.NewKey(coll.EncodedName, r.key...))
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			missing = append(missing, r)
+		}
+	}
+
+	return missing, nil
+}
+
+// checkIndexOrphans scans the secondary index and verifies that every entry matches its row.
+func (c *ConsistencyChecker) checkIndexOrphans(ctx context.Context, coll *schema.DefaultCollection, issues *ConsistencyIssues) error {
+	var last []byte
+	for {
+		done, err := c.checkIndexOrphansBatch(ctx, coll, issues, &last)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+func (c *ConsistencyChecker) checkIndexOrphansBatch(ctx context.Context, coll *schema.DefaultCollection, issues *ConsistencyIssues, last *[]byte) (bool, error) {
+	tx, err := c.txMgr.StartTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	indexer := newSecondaryIndexerImpl(coll)
+
+	start := keys.NewKey(coll.EncodedTableIndexName, coll.SecondaryIndexKeyword(), KVSubspace)
+	if *last != nil {
+		// the range scan starts from the last checked entry
+		if start, err = keys.FromBinary(coll.EncodedTableIndexName, *last); err != nil {
+			return false, err
+		}
+	}
+	end := keys.NewKey(coll.EncodedTableIndexName, coll.SecondaryIndexKeyword(), KVSubspace, 0xFF)
+
+	iter, err := tx.ReadRange(ctx, start, end, false, false)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		entry   kv.KeyValue
+		scanned int
+		pkLen   = len(coll.PrimaryKey.Fields) + 1
+	)
+	for scanned < c.opts.BatchSize && iter.Next(&entry) {
+		if *last != nil && bytes.Equal(entry.FDBKey, *last) {
+			continue
+		}
+		*last = entry.FDBKey
+		scanned++
+		issues.Checked++
+
+		indexKey, err := keys.FromBinary(coll.EncodedTableIndexName, entry.FDBKey)
+		if err != nil {
+			return false, err
+		}
+		parts := indexKey.IndexParts()
+		if len(parts) < pkLen {
+			continue
+		}
+		pk := parts[len(parts)-pkLen:]
+
+		orphaned, err := c.isIndexEntryOrphaned(ctx, tx, coll, indexer, indexKey, pk)
+		if err != nil {
+			return false, err
+		}
+		if !orphaned {
+			continue
+		}
+
+		issues.Orphaned++
+		issues.sample(c.opts.MaxSamples, "orphaned", pk[1:])
+
+		if c.opts.Repair {
+			if err = tx.Delete(ctx, indexKey); err != nil {
+				return false, err
+			}
+			issues.Repaired++
+		}
+	}
+	if err = iter.Err(); err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return false, err
+	}
+
+	return scanned < c.opts.BatchSize, nil
+}
+
+// isIndexEntryOrphaned returns true if the row of the index entry doesn't exist or doesn't produce the entry.
+func (*ConsistencyChecker) isIndexEntryOrphaned(ctx context.Context, tx transaction.Tx, coll *schema.DefaultCollection,
+	indexer *SecondaryIndexerImpl, indexKey keys.Key, pk []any,
+) (bool, error) {
+	it, err := tx.Read(ctx, keys.NewKey(coll.EncodedName, pk...), false)
+	if err != nil {
+		return false, err
+	}
+
+	var row kv.KeyValue
+	if !it.Next(&row) {
+		return true, it.Err()
+	}
+
+	updateSet, err := indexer.buildAddAndRemoveKVs(row.Data, nil, pk)
+	if err != nil {
+		return false, err
+	}
+
+	for _, k := range updateSet.addKeys {
+		if bytes.Equal(k.SerializeToBytes(), indexKey.SerializeToBytes()) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (c *ConsistencyChecker) saveJob(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) error {
+	tx, err := c.txMgr.StartTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err = tenant.MetaStore.Job().Update(ctx, tx, tenant.GetNamespace().Id(), job); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func keyExists(ctx context.Context, tx transaction.Tx, key keys.Key) (bool, error) {
+	it, err := tx.Read(ctx, key, false)
+	if err != nil {
+		return false, err
+	}
+
+	var row kv.KeyValue
+	if it.Next(&row) {
+		return true, nil
+	}
+
+	return false, it.Err()
+}
+
+// sameSearchTimestamp compares the timestamp of the search document with the timestamp of the row.
+func sameSearchTimestamp(v any, ts *internal.Timestamp) bool {
+	var nano int64
+	switch t := v.(type) {
+	case nil:
+		return ts == nil
+	case json.Number:
+		n, err := t.Int64()
+		if err != nil {
+			f, err := t.Float64()
+			if err != nil {
+				return false
+			}
+			n = int64(f)
+		}
+		nano = n
+	case float64:
+		nano = int64(t)
+	case int64:
+		nano = t
+	default:
+		return false
+	}
+
+	if ts == nil {
+		return false
+	}
+
+	diff := nano - ts.UnixNano()
+	return diff > -searchTimestampTolerance && diff < searchTimestampTolerance
+}
@@ -0,0 +1,67 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/server/metadata"
+)
+
+func TestSameSearchTimestamp(t *testing.T) {
+	ts := internal.CreateNewTimestamp(1680000000123456789)
+
+	require.True(t, sameSearchTimestamp(nil, nil))
+	require.False(t, sameSearchTimestamp(nil, ts))
+	require.False(t, sameSearchTimestamp(json.Number("1680000000123456789"), nil))
+
+	require.True(t, sameSearchTimestamp(json.Number("1680000000123456789"), ts))
+	require.True(t, sameSearchTimestamp(int64(1680000000123456789), ts))
+	// float64 loses the precision of the nanoseconds
+	require.True(t, sameSearchTimestamp(float64(1680000000123456789), ts))
+
+	require.False(t, sameSearchTimestamp(json.Number("1680000000123556789"), ts))
+	require.False(t, sameSearchTimestamp("1680000000123456789", ts))
+}
+
+func TestNewVerifyJob(t *testing.T) {
+	_, err := NewVerifyJob("p1", "", "c1", &ConsistencyOptions{})
+	require.Error(t, err)
+
+	_, err = NewVerifyJob("p1", "", "c1", &ConsistencyOptions{Search: true, BatchSize: maxConsistencyBatchSize + 1})
+	require.Error(t, err)
+
+	job, err := NewVerifyJob("p1", "", "c1", &ConsistencyOptions{Search: true, Repair: true})
+	require.NoError(t, err)
+	require.Equal(t, metadata.VerifyJobType, job.Type)
+	require.Equal(t, metadata.JobRunning, job.State)
+	require.JSONEq(t, `{"search":true,"secondary_index":false,"repair":true}`, string(job.Spec))
+}
+
+func TestConsistencyIssues(t *testing.T) {
+	issues := &ConsistencyIssues{}
+	for i := 0; i < 3; i++ {
+		issues.Missing++
+		issues.sample(2, "missing", i)
+	}
+	issues.Orphaned++
+	issues.sample(2, "orphaned", "a")
+
+	require.Equal(t, int64(4), issues.total())
+	require.Equal(t, []string{"missing: 0", "missing: 1"}, issues.Samples)
+}
@@ -0,0 +1,739 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog/log"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/lib/blob"
+	"github.com/tigrisdata/tigris/lib/dataformat"
+	"github.com/tigrisdata/tigris/lib/uuid"
+	"github.com/tigrisdata/tigris/query/filter"
+	"github.com/tigrisdata/tigris/query/read"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/transaction"
+	ulog "github.com/tigrisdata/tigris/util/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExportSpec is the export request persisted in the job.
+type ExportSpec struct {
+	Filter       jsoniter.RawMessage `json:"filter,omitempty"`
+	Fields       jsoniter.RawMessage `json:"fields,omitempty"`
+	Destination  string              `json:"destination"`
+	PartRows     int64               `json:"part_rows,omitempty"`
+	CSVDelimiter string              `json:"csv_delimiter,omitempty"`
+}
+
+// exportPosition is the resume position of the export job, the key of the last exported document and the number of
+// the next output file.
+type exportPosition struct {
+	Key  []byte `json:"key,omitempty"`
+	Part int    `json:"part"`
+}
+
+// exportTask is the queue item payload of the scheduled export job.
+type exportTask struct {
+	Namespace string `json:"namespace"`
+	JobId     string `json:"job_id"`
+}
+
+// NewExportJob returns the metadata of the new export job. The spec is validated, so a job which can't be run is
+// rejected before it is scheduled.
+func NewExportJob(project string, branch string, collection string, format string, spec *ExportSpec) (*metadata.JobMetadata, error) {
+	f, err := dataformat.ParseFormat(format)
+	if err != nil {
+		return nil, errors.InvalidArgument(err.Error())
+	}
+	if spec.Destination == "" {
+		return nil, errors.InvalidArgument("export destination is missing")
+	}
+	if spec.PartRows < 0 {
+		return nil, errors.InvalidArgument("part rows should not be negative")
+	}
+	if _, err = read.BuildFields(spec.Fields); err != nil {
+		return nil, errors.InvalidArgument("invalid fields: %s", err.Error())
+	}
+
+	job := metadata.NewJobMetadata(metadata.ExportJobType, project, branch, collection)
+	job.Format = string(f)
+	if job.Spec, err = jsoniter.Marshal(spec); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// APIExportDestination returns the location of an export scheduled through the API. The exports are written only
+// under the configured destination, in the prefix of the namespace, the destination of the request is a relative
+// path under it. So a tenant can't make the server write to a location of its choice with the server credentials.
+func APIExportDestination(root string, namespace string, dest string) (string, error) {
+	if root == "" {
+		return "", errors.Unimplemented("exports are not enabled")
+	}
+	if strings.Contains(dest, "://") || strings.HasPrefix(dest, "/") {
+		return "", errors.InvalidArgument("export destination should be a relative path")
+	}
+
+	cleaned := path.Clean("/" + dest)
+	if cleaned == "/" {
+		return fmt.Sprintf("%s/%s", strings.TrimRight(root, "/"), namespace), nil
+	}
+
+	return fmt.Sprintf("%s/%s%s", strings.TrimRight(root, "/"), namespace, cleaned), nil
+}
+
+// ExportJobRunner persists the export job and schedules it in the metadata queue in the same transaction.
+type ExportJobRunner struct {
+	*BaseQueryRunner
+
+	job *metadata.JobMetadata
+}
+
+func (runner *ExportJobRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
+	job := runner.job
+
+	_, coll, err := runner.getDBAndCollection(ctx, tx, tenant, job.Project, job.Collection, job.Branch)
+	if err != nil {
+		return Response{}, ctx, err
+	}
+
+	if err = runner.mustBeDocumentsCollection(coll, "export"); err != nil {
+		return Response{}, ctx, err
+	}
+
+	var spec ExportSpec
+	if err = jsoniter.Unmarshal(job.Spec, &spec); err != nil {
+		return Response{}, ctx, err
+	}
+	if _, err = filter.NewFactory(coll.QueryableFields, nil).WrappedFilter(spec.Filter); err != nil {
+		return Response{}, ctx, err
+	}
+
+	if err = ScheduleExportJob(ctx, tx, tenant, job); err != nil {
+		return Response{}, ctx, err
+	}
+
+	return Response{Status: CreatedStatus}, ctx, nil
+}
+
+// ScheduleExportJob stores the job and enqueues it for the export worker.
+func ScheduleExportJob(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant, job *metadata.JobMetadata) error {
+	if err := tenant.MetaStore.Job().Create(ctx, tx, tenant.GetNamespace().Id(), job); err != nil {
+		return err
+	}
+
+	task, err := jsoniter.Marshal(&exportTask{Namespace: tenant.GetNamespace().StrId(), JobId: job.Id})
+	if err != nil {
+		return err
+	}
+
+	item := metadata.NewQueueItem(0, task)
+	item.Id = job.Id
+
+	return tenant.MetaStore.Queue().Enqueue(ctx, tx, item, 0)
+}
+
+// ExportJobQueryRunner returns the state of the export job.
+type ExportJobQueryRunner struct {
+	*BaseQueryRunner
+
+	req *api.GetExportJobRequest
+}
+
+func (runner *ExportJobQueryRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
+	job, err := tenant.MetaStore.Job().Get(ctx, tx, tenant.GetNamespace().Id(), runner.req.GetJobId())
+	if err != nil && err != errors.ErrNotFound {
+		return Response{}, ctx, err
+	}
+
+	if job == nil || job.Type != metadata.ExportJobType || job.Project != runner.req.GetProject() {
+		return Response{}, ctx, errors.NotFound("export job '%s' not found", runner.req.GetJobId())
+	}
+
+	return Response{
+		Response: &api.GetExportJobResponse{
+			Job: ExportJobToAPI(job),
+		},
+	}, ctx, nil
+}
+
+// ExportJobToAPI converts the job metadata to the API representation.
+func ExportJobToAPI(job *metadata.JobMetadata) *api.ExportJob {
+	var (
+		spec ExportSpec
+		pos  exportPosition
+	)
+
+	// both are written by the server, the zero values are fine for the reporting
+	_ = jsoniter.Unmarshal(job.Spec, &spec)
+	if len(job.Position) > 0 {
+		_ = jsoniter.Unmarshal(job.Position, &pos)
+	}
+
+	return &api.ExportJob{
+		Id:          job.Id,
+		State:       string(job.State),
+		Collection:  job.Collection,
+		Format:      job.Format,
+		Destination: spec.Destination,
+		Exported:    job.Processed,
+		Files:       int64(pos.Part),
+		Message:     job.Message,
+		CreatedAt:   timestamppb.New(job.CreatedAt),
+		UpdatedAt:   timestamppb.New(job.UpdatedAt),
+	}
+}
+
+// Exporter writes the documents of a collection to the files of the job destination. FoundationDB transactions are
+// limited to five seconds, so the collection is read in batches in the primary key order. Every batch is read in its
+// own transaction at its own read version, the export is consistent only within a batch and not a snapshot of the
+// whole collection. The documents written while the job runs are exported if they are after the position of the job
+// by the time they are committed, every document is exported at most once. The output is split into parts of the
+// configured number of documents and the job progress is persisted after every part, an interrupted job continues
+// with the next part.
+type Exporter struct {
+	*BaseQueryRunner
+
+	s3 *blob.Config
+}
+
+func NewExporter(txMgr *transaction.Manager, s3 *blob.Config) *Exporter {
+	return &Exporter{
+		BaseQueryRunner: NewBaseQueryRunner(metadata.NewEncoder(), nil, txMgr, nil, nil),
+		s3:              s3,
+	}
+}
+
+// Export runs the job till completion and returns the final state of the job.
+func (e *Exporter) Export(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) (*metadata.JobMetadata, error) {
+	if job.IsTerminal() {
+		return job, nil
+	}
+
+	exportErr := e.export(ctx, tenant, job)
+	if exportErr != nil && ctx.Err() != nil {
+		// interrupted, the job keeps running and continues from the last persisted part
+		return job, exportErr
+	}
+
+	job.State = metadata.JobCompleted
+	if exportErr != nil {
+		job.State = metadata.JobFailed
+		job.Message = exportErr.Error()
+	}
+
+	if err := e.saveJob(ctx, tenant, job); err != nil {
+		return job, err
+	}
+
+	return job, exportErr
+}
+
+func (e *Exporter) export(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) error {
+	var (
+		spec ExportSpec
+		pos  exportPosition
+	)
+
+	if err := jsoniter.Unmarshal(job.Spec, &spec); err != nil {
+		return err
+	}
+	if len(job.Position) > 0 {
+		if err := jsoniter.Unmarshal(job.Position, &pos); err != nil {
+			return err
+		}
+	}
+
+	format, err := dataformat.ParseFormat(job.Format)
+	if err != nil {
+		return err
+	}
+
+	bucket, err := blob.Open(spec.Destination, e.s3)
+	if err != nil {
+		return err
+	}
+
+	partRows := spec.PartRows
+	if partRows == 0 {
+		partRows = config.DefaultConfig.Export.PartRows
+	}
+
+	for {
+		done, exported, err := e.exportPart(ctx, tenant, job, &spec, format, bucket, &pos, partRows)
+		if err != nil {
+			return err
+		}
+
+		if exported > 0 || pos.Part == 0 {
+			// an empty collection is exported as a single empty file
+			pos.Part++
+			job.Processed += exported
+			if job.Position, err = jsoniter.Marshal(&pos); err != nil {
+				return err
+			}
+			if err = e.saveJob(ctx, tenant, job); err != nil {
+				return err
+			}
+
+			log.Debug().Str("job", job.Id).Int("part", pos.Part).Int64("exported", job.Processed).Msg("export part written")
+		}
+
+		if done {
+			return nil
+		}
+	}
+}
+
+// exportPart writes the next part, the position key is advanced only in memory, the caller persists it once the part
+// is committed to the destination.
+func (e *Exporter) exportPart(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata, spec *ExportSpec,
+	format dataformat.Format, bucket blob.Bucket, pos *exportPosition, partRows int64,
+) (bool, int64, error) {
+	coll, err := e.getExportCollection(ctx, tenant, job)
+	if err != nil {
+		return false, 0, err
+	}
+
+	wrappedFilter, err := filter.NewFactory(coll.QueryableFields, nil).WrappedFilter(spec.Filter)
+	if err != nil {
+		return false, 0, err
+	}
+
+	fieldFactory, err := read.BuildFields(spec.Fields)
+	if err != nil {
+		return false, 0, err
+	}
+
+	obj, err := bucket.Create(ctx, exportPartName(job, format, pos.Part))
+	if err != nil {
+		return false, 0, err
+	}
+
+	opts := &dataformat.WriterOptions{Columns: exportColumns(coll, fieldFactory)}
+	if spec.CSVDelimiter != "" {
+		opts.CSVDelimiter = []rune(spec.CSVDelimiter)[0]
+	}
+
+	w, err := dataformat.NewWriter(format, obj, opts)
+	if err != nil {
+		obj.Abort()
+		return false, 0, err
+	}
+
+	var (
+		exported int64
+		done     bool
+		startKey = pos.Key
+	)
+	for !done && exported < partRows {
+		var n int64
+		if done, n, err = e.exportBatch(ctx, coll, wrappedFilter, fieldFactory, w, pos, partRows-exported); err != nil {
+			obj.Abort()
+			return false, 0, err
+		}
+		exported += n
+	}
+
+	if exported == 0 && pos.Part > 0 {
+		// nothing left after the previous part
+		obj.Abort()
+		pos.Key = startKey
+		return true, 0, nil
+	}
+
+	if err = w.Close(); err != nil {
+		obj.Abort()
+		return false, 0, err
+	}
+
+	if err = obj.Commit(); err != nil {
+		return false, 0, err
+	}
+
+	return done, exported, nil
+}
+
+// exportBatch reads up to the configured batch size of documents after the position key in a single transaction at
+// the current read version.
+func (e *Exporter) exportBatch(ctx context.Context, coll *schema.DefaultCollection, wrappedFilter *filter.WrappedFilter,
+	fieldFactory *read.FieldFactory, w dataformat.Writer, pos *exportPosition, limit int64,
+) (bool, int64, error) {
+	tx, err := e.txMgr.StartTx(ctx)
+	if err != nil {
+		return false, 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	reader := NewDatabaseReader(ctx, tx)
+
+	var iter Iterator
+	if pos.Key != nil {
+		from, err := keys.FromBinary(coll.EncodedName, pos.Key)
+		if err != nil {
+			return false, 0, err
+		}
+		iter, err = reader.ScanIterator(from, nil, false)
+		if err != nil {
+			return false, 0, err
+		}
+	} else if iter, err = reader.ScanTable(coll.EncodedName, false); err != nil {
+		return false, 0, err
+	}
+
+	var (
+		row      Row
+		scanned  int
+		exported int64
+		batch    = config.DefaultConfig.Export.BatchSize
+	)
+	for scanned < batch && exported < limit && iter.Next(&row) {
+		if pos.Key != nil && string(row.Key) == string(pos.Key) {
+			// the range scan starts from the last exported key
+			continue
+		}
+		scanned++
+		pos.Key = row.Key
+
+		tsJSON, err := row.Data.TimeStampsToJSON()
+		if err != nil {
+			return false, 0, err
+		}
+		if !wrappedFilter.Matches(row.Data.RawData, tsJSON) {
+			continue
+		}
+
+		doc := row.Data.RawData
+		if !coll.CompatibleSchemaSince(uint32(row.Data.Ver)) {
+			if doc, err = coll.UpdateRowSchemaRaw(doc, uint32(row.Data.Ver)); err != nil {
+				return false, 0, err
+			}
+		}
+
+		if doc, err = fieldFactory.Apply(doc); err != nil {
+			return false, 0, err
+		}
+
+		if err = w.Write(doc); err != nil {
+			return false, 0, err
+		}
+		exported++
+	}
+
+	if err = iter.Interrupted(); err != nil {
+		return false, 0, err
+	}
+
+	return scanned < batch && exported < limit, exported, nil
+}
+
+func (e *Exporter) getExportCollection(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) (*schema.DefaultCollection, error) {
+	_, coll, err := e.getDBAndCollection(ctx, nil, tenant, job.Project, job.Collection, job.Branch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = e.mustBeDocumentsCollection(coll, "export"); err != nil {
+		return nil, err
+	}
+
+	return coll, nil
+}
+
+func (e *Exporter) saveJob(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) error {
+	tx, err := e.txMgr.StartTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err = tenant.MetaStore.Job().Update(ctx, tx, tenant.GetNamespace().Id(), job); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// exportPartName returns the file name of the part, the job id keeps the files of different jobs apart when they
+// share the destination.
+func exportPartName(job *metadata.JobMetadata, format dataformat.Format, part int) string {
+	return fmt.Sprintf("%s-%s-%05d%s", job.Collection, job.Id, part, format.Extension())
+}
+
+// exportColumns returns the top level fields of the collection which are included in the output.
+func exportColumns(coll *schema.DefaultCollection, fieldFactory *read.FieldFactory) []dataformat.Column {
+	columns := make([]dataformat.Column, 0, len(coll.Fields))
+	for _, f := range coll.Fields {
+		if len(fieldFactory.Include) > 0 {
+			if _, ok := fieldFactory.Include[f.FieldName]; !ok {
+				continue
+			}
+		}
+		if _, ok := fieldFactory.Exclude[f.FieldName]; ok {
+			continue
+		}
+
+		columns = append(columns, dataformat.Column{Name: f.FieldName, Type: exportColumnType(f.DataType)})
+	}
+
+	return columns
+}
+
+func exportColumnType(t schema.FieldType) string {
+	switch t {
+	case schema.BoolType:
+		return "boolean"
+	case schema.Int32Type, schema.Int64Type:
+		return "integer"
+	case schema.DoubleType:
+		return "number"
+	case schema.ArrayType, schema.VectorType:
+		return "array"
+	case schema.ObjectType:
+		return "object"
+	default:
+		return "string"
+	}
+}
+
+// ExportWorker runs the export jobs scheduled in the metadata queue. A job is leased while it runs and the lease is
+// renewed periodically, if the worker dies the lease expires and another worker resumes the job from its last
+// persisted part.
+type ExportWorker struct {
+	txMgr     *transaction.Manager
+	tenantMgr *metadata.TenantManager
+	queue     *metadata.QueueSubspace
+	exporter  *Exporter
+	cfg       *config.ExportConfig
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+func NewExportWorker(txMgr *transaction.Manager, tenantMgr *metadata.TenantManager, cfg *config.ExportConfig) *ExportWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &ExportWorker{
+		txMgr:     txMgr,
+		tenantMgr: tenantMgr,
+		queue:     metadata.NewMetadataDictionary(metadata.DefaultNameRegistry).Queue(),
+		exporter:  NewExporter(txMgr, &cfg.S3),
+		cfg:       cfg,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (w *ExportWorker) Start() {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := w.runNext(w.ctx); err != nil && w.ctx.Err() == nil {
+					log.Err(err).Msg("export worker failed to run the job")
+				}
+			}
+		}
+	}()
+}
+
+// Stop interrupts the running job and waits for the worker to exit. The interrupted job keeps its lease, it is
+// resumed from the last persisted part once the lease expires.
+func (w *ExportWorker) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+// runNext claims the first ready export job and runs it.
+func (w *ExportWorker) runNext(ctx context.Context) error {
+	item, task, err := w.claim(ctx)
+	if err != nil || item == nil {
+		return err
+	}
+
+	tenant, err := w.tenantMgr.GetTenant(ctx, task.Namespace)
+	if err != nil {
+		return err
+	}
+
+	job, err := w.getJob(ctx, tenant, task.JobId)
+	if err == errors.ErrNotFound {
+		// the job was removed, nothing to run
+		return w.complete(ctx, item)
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err = w.run(ctx, tenant, item, job); err != nil {
+		log.Err(err).Str("job", job.Id).Msg("export job failed")
+	}
+
+	return nil
+}
+
+// RunJob runs the job in the foreground. The job is stored and leased by the caller in the metadata queue, so if the
+// caller dies before the job is completed the export worker resumes the job once the lease expires.
+func (w *ExportWorker) RunJob(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata, create bool) (*metadata.JobMetadata, error) {
+	task, err := jsoniter.Marshal(&exportTask{Namespace: tenant.GetNamespace().StrId(), JobId: job.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	item := metadata.NewQueueItem(0, task)
+	item.Id = job.Id
+	item.LeaseId = uuid.NewUUIDAsString()
+
+	if err = w.withTx(ctx, func(tx transaction.Tx) error {
+		if create {
+			if err := tenant.MetaStore.Job().Create(ctx, tx, tenant.GetNamespace().Id(), job); err != nil {
+				return err
+			}
+		} else if err := tenant.MetaStore.Job().Update(ctx, tx, tenant.GetNamespace().Id(), job); err != nil {
+			return err
+		}
+
+		return w.queue.Enqueue(ctx, tx, item, w.cfg.LeaseTime)
+	}); err != nil {
+		return nil, err
+	}
+
+	return w.run(ctx, tenant, item, job)
+}
+
+// run exports the leased job, the lease is renewed while the job is running and the queue item is completed once
+// the job reaches the terminal state.
+func (w *ExportWorker) run(ctx context.Context, tenant *metadata.Tenant, item *metadata.QueueItem, job *metadata.JobMetadata) (*metadata.JobMetadata, error) {
+	runCtx, cancel := context.WithCancel(ctx)
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		w.renewLease(runCtx, cancel, item)
+	}()
+
+	job, err := w.exporter.Export(runCtx, tenant, job)
+	cancel()
+	<-renewed
+
+	if err != nil && !job.IsTerminal() {
+		// the lease expires and the job is resumed from the last persisted part
+		return job, err
+	}
+
+	if cerr := w.complete(ctx, item); cerr != nil {
+		log.Err(cerr).Str("job", job.Id).Msg("failed to complete export queue item")
+	}
+
+	return job, err
+}
+
+func (w *ExportWorker) claim(ctx context.Context) (*metadata.QueueItem, *exportTask, error) {
+	tx, err := w.txMgr.StartTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var task exportTask
+	items, err := w.queue.PeekMatching(ctx, tx, 1, func(item *metadata.QueueItem) bool {
+		task = exportTask{}
+		// the queue is shared with the other workers, their items are skipped
+		return jsoniter.Unmarshal(item.Data, &task) == nil && task.JobId != ""
+	})
+	if err != nil || len(items) == 0 {
+		return nil, nil, err
+	}
+
+	item, err := w.queue.ObtainLease(ctx, tx, &items[0], w.cfg.LeaseTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return item, &task, nil
+}
+
+func (w *ExportWorker) renewLease(ctx context.Context, cancel context.CancelFunc, item *metadata.QueueItem) {
+	ticker := time.NewTicker(w.cfg.LeaseTime / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.withTx(ctx, func(tx transaction.Tx) error {
+				return w.queue.RenewLease(ctx, tx, item, w.cfg.LeaseTime)
+			}); ulog.E(err) {
+				// the job may be claimed by another worker, stop exporting
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func (w *ExportWorker) complete(ctx context.Context, item *metadata.QueueItem) error {
+	return w.withTx(ctx, func(tx transaction.Tx) error {
+		return w.queue.Complete(ctx, tx, item)
+	})
+}
+
+func (w *ExportWorker) getJob(ctx context.Context, tenant *metadata.Tenant, id string) (job *metadata.JobMetadata, err error) {
+	err = w.withTx(ctx, func(tx transaction.Tx) error {
+		job, err = tenant.MetaStore.Job().Get(ctx, tx, tenant.GetNamespace().Id(), id)
+		return err
+	})
+
+	return
+}
+
+func (w *ExportWorker) withTx(ctx context.Context, fn func(tx transaction.Tx) error) error {
+	tx, err := w.txMgr.StartTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,206 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/lib/dataformat"
+	"github.com/tigrisdata/tigris/query/filter"
+	"github.com/tigrisdata/tigris/query/read"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/transaction"
+)
+
+func TestNewExportJob(t *testing.T) {
+	_, err := NewExportJob("p1", "", "c1", "xml", &ExportSpec{Destination: "s3://b"})
+	require.Error(t, err)
+
+	_, err = NewExportJob("p1", "", "c1", "csv", &ExportSpec{})
+	require.Error(t, err)
+
+	_, err = NewExportJob("p1", "", "c1", "csv", &ExportSpec{Destination: "s3://b", PartRows: -1})
+	require.Error(t, err)
+
+	job, err := NewExportJob("p1", "b1", "c1", "", &ExportSpec{Destination: "s3://b", PartRows: 10})
+	require.NoError(t, err)
+	require.Equal(t, metadata.ExportJobType, job.Type)
+	require.Equal(t, metadata.JobRunning, job.State)
+	require.Equal(t, string(dataformat.NDJSON), job.Format)
+
+	var spec ExportSpec
+	require.NoError(t, jsoniter.Unmarshal(job.Spec, &spec))
+	require.Equal(t, "s3://b", spec.Destination)
+	require.Equal(t, int64(10), spec.PartRows)
+
+	require.Equal(t, "c1-"+job.Id+"-00002.parquet", exportPartName(job, dataformat.Parquet, 2))
+}
+
+func TestAPIExportDestination(t *testing.T) {
+	_, err := APIExportDestination("", "ns1", "out")
+	require.Error(t, err)
+
+	for _, dest := range []string{"s3://other/out", "file:///tmp", "/tmp/out"} {
+		_, err = APIExportDestination("s3://exports/", "ns1", dest)
+		require.Error(t, err, dest)
+	}
+
+	cases := []struct {
+		dest     string
+		expected string
+	}{
+		{"", "s3://exports/ns1"},
+		{"out/daily", "s3://exports/ns1/out/daily"},
+		{"../../ns2/out", "s3://exports/ns1/ns2/out"},
+		{"a/../b/", "s3://exports/ns1/b"},
+	}
+	for _, c := range cases {
+		dest, err := APIExportDestination("s3://exports/", "ns1", c.dest)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, dest)
+	}
+}
+
+func TestExportColumns(t *testing.T) {
+	reqSchema := []byte(`{
+		"title": "t1",
+		"properties": {
+			"id": { "type": "integer" },
+			"name": { "type": "string" },
+			"price": { "type": "number" },
+			"ok": { "type": "boolean" },
+			"tags": { "type": "array", "items": { "type": "string" } },
+			"obj": { "type": "object", "properties": { "a": { "type": "integer" } } }
+		},
+		"primary_key": ["id"]
+	}`)
+
+	schFactory, err := schema.NewFactoryBuilder(true).Build("t1", reqSchema)
+	require.NoError(t, err)
+	coll, err := schema.NewDefaultCollection(1, 1, schFactory, nil, nil)
+	require.NoError(t, err)
+
+	all, err := read.BuildFields(nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []dataformat.Column{
+		{Name: "id", Type: "integer"},
+		{Name: "name", Type: "string"},
+		{Name: "price", Type: "number"},
+		{Name: "ok", Type: "boolean"},
+		{Name: "tags", Type: "array"},
+		{Name: "obj", Type: "object"},
+	}, exportColumns(coll, all))
+
+	included, err := read.BuildFields([]byte(`{"id": true, "name": true}`))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []dataformat.Column{
+		{Name: "id", Type: "integer"},
+		{Name: "name", Type: "string"},
+	}, exportColumns(coll, included))
+
+	excluded, err := read.BuildFields([]byte(`{"obj": false, "tags": false, "price": false}`))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []dataformat.Column{
+		{Name: "id", Type: "integer"},
+		{Name: "name", Type: "string"},
+		{Name: "ok", Type: "boolean"},
+	}, exportColumns(coll, excluded))
+}
+
+func TestExportBatchAfterMVCCWindow(t *testing.T) {
+	reqSchema := []byte(`{
+		"title": "t_export",
+		"properties": {
+			"id": { "type": "integer" },
+			"name": { "type": "string" }
+		},
+		"primary_key": ["id"]
+	}`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	require.NoError(t, kvStore.DropTable(ctx, []byte("t_export")))
+	require.NoError(t, kvStore.CreateTable(ctx, []byte("t_export")))
+
+	schFactory, err := schema.NewFactoryBuilder(true).Build("t_export", reqSchema)
+	require.NoError(t, err)
+	coll, err := schema.NewDefaultCollection(1, 1, schFactory, nil, nil)
+	require.NoError(t, err)
+	coll.EncodedName = []byte("t_export")
+
+	tm := transaction.NewManager(kvStore)
+	insert := func(ids ...int) {
+		tx, err := tm.StartTx(ctx)
+		require.NoError(t, err)
+		for _, id := range ids {
+			td := createTD([]byte(fmt.Sprintf(`{"id":%d,"name":"n%d"}`, id, id)))
+			require.NoError(t, tx.Insert(ctx, keys.NewKey(coll.EncodedName, id), td))
+		}
+		require.NoError(t, tx.Commit(ctx))
+	}
+	insert(1, 2)
+
+	batchSize := config.DefaultConfig.Export.BatchSize
+	config.DefaultConfig.Export.BatchSize = 1
+	defer func() { config.DefaultConfig.Export.BatchSize = batchSize }()
+
+	wrappedFilter, err := filter.NewFactory(coll.QueryableFields, nil).WrappedFilter(nil)
+	require.NoError(t, err)
+	fieldFactory, err := read.BuildFields(nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w, err := dataformat.NewWriter(dataformat.NDJSON, &buf, nil)
+	require.NoError(t, err)
+
+	e := NewExporter(tm, nil)
+	pos := &exportPosition{}
+
+	done, exported, err := e.exportBatch(ctx, coll, wrappedFilter, fieldFactory, w, pos, 10)
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, int64(1), exported)
+
+	// the versions older than the five seconds MVCC window of the FoundationDB are no longer readable, the next
+	// batches are read at their own version and see the documents committed in the meantime
+	time.Sleep(6 * time.Second)
+	insert(3)
+
+	for !done {
+		var n int64
+		done, n, err = e.exportBatch(ctx, coll, wrappedFilter, fieldFactory, w, pos, 10)
+		require.NoError(t, err)
+		exported += n
+	}
+	require.NoError(t, w.Close())
+	require.Equal(t, int64(3), exported)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	for i, line := range lines {
+		require.JSONEq(t, fmt.Sprintf(`{"id":%d,"name":"n%d"}`, i+1, i+1), line)
+	}
+}
@@ -0,0 +1,609 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog/log"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/dataformat"
+	"github.com/tigrisdata/tigris/lib/uuid"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/metrics"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/server/types"
+	"github.com/tigrisdata/tigris/store/kv"
+	ulog "github.com/tigrisdata/tigris/util/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// importRow is a decoded input row along with its position in the input.
+type importRow struct {
+	row int64
+	doc []byte
+}
+
+// ImportJobRunner creates, resumes or finishes the import job. The job is the durable record of the import progress,
+// a client which lost the connection sends the same input again with the job id and the import skips the rows which
+// are already committed. A running job is resumed only after its lease expires, the resuming stream becomes the owner
+// of the job and the previous stream fails on its next batch.
+type ImportJobRunner struct {
+	*BaseQueryRunner
+
+	req    *api.ImportStreamRequest
+	job    *metadata.JobMetadata
+	result *metadata.JobMetadata
+}
+
+func (runner *ImportJobRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
+	nsID := tenant.GetNamespace().Id()
+
+	if runner.job != nil {
+		// finishing the job, the progress is persisted by the batches, the final update only adds the decode errors
+		// of the rows after the last batch
+		job := *runner.job
+		if err := mustOwnImportJob(ctx, tx, tenant, &job); err != nil {
+			return Response{}, ctx, err
+		}
+		if err := tenant.MetaStore.Job().Update(ctx, tx, nsID, &job); err != nil {
+			return Response{}, ctx, err
+		}
+		runner.result = &job
+
+		return Response{}, ctx, nil
+	}
+
+	if runner.req.GetJobId() != "" {
+		job, err := tenant.MetaStore.Job().Get(ctx, tx, nsID, runner.req.GetJobId())
+		if err != nil && err != errors.ErrNotFound {
+			return Response{}, ctx, err
+		}
+
+		if job != nil {
+			if job.Type != metadata.ImportJobType || job.Project != runner.req.GetProject() ||
+				job.Branch != runner.req.GetBranch() || job.Collection != runner.req.GetCollection() {
+				return Response{}, ctx, errors.InvalidArgument("job '%s' is not an import into collection '%s'",
+					job.Id, runner.req.GetCollection())
+			}
+			if job.State == metadata.JobCompleted || job.State == metadata.JobCancelled {
+				return Response{}, ctx, api.Errorf(api.Code_FAILED_PRECONDITION, "job '%s' is already %s", job.Id, job.State)
+			}
+			if job.State == metadata.JobRunning && time.Since(job.UpdatedAt) < config.DefaultConfig.Import.LeaseTime {
+				return Response{}, ctx, errors.Aborted("import job '%s' is running, it can be resumed once it fails or "+
+					"after %v without progress", job.Id, config.DefaultConfig.Import.LeaseTime)
+			}
+
+			job.State = metadata.JobRunning
+			job.Owner = uuid.NewUUIDAsString()
+			job.Message = ""
+			if err = tenant.MetaStore.Job().Update(ctx, tx, nsID, job); err != nil {
+				return Response{}, ctx, err
+			}
+			runner.result = job
+
+			return Response{}, ctx, nil
+		}
+	}
+
+	job := metadata.NewJobMetadata(metadata.ImportJobType, runner.req.GetProject(), runner.req.GetBranch(), runner.req.GetCollection())
+	if runner.req.GetJobId() != "" {
+		job.Id = runner.req.GetJobId()
+	}
+	job.Format = runner.req.GetFormat()
+	job.Owner = uuid.NewUUIDAsString()
+
+	if err := tenant.MetaStore.Job().Create(ctx, tx, nsID, job); err != nil {
+		if err == kv.ErrDuplicateKey {
+			return Response{}, ctx, errors.Aborted("concurrent import with the same job id, aborting")
+		}
+		return Response{}, ctx, err
+	}
+	runner.result = job
+
+	return Response{}, ctx, nil
+}
+
+// mustOwnImportJob fails if the job is resumed by another stream after the lease of this stream expired.
+func mustOwnImportJob(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant, job *metadata.JobMetadata) error {
+	current, err := tenant.MetaStore.Job().Get(ctx, tx, tenant.GetNamespace().Id(), job.Id)
+	if err != nil {
+		return err
+	}
+	if current.Owner != job.Owner {
+		return errors.Aborted("import job '%s' is resumed by another stream", job.Id)
+	}
+
+	return nil
+}
+
+// ImportJobQueryRunner returns the state of the import job.
+type ImportJobQueryRunner struct {
+	*BaseQueryRunner
+
+	req *api.GetImportJobRequest
+}
+
+func (runner *ImportJobQueryRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
+	job, err := tenant.MetaStore.Job().Get(ctx, tx, tenant.GetNamespace().Id(), runner.req.GetJobId())
+	if err != nil && err != errors.ErrNotFound {
+		return Response{}, ctx, err
+	}
+
+	if job == nil || job.Type != metadata.ImportJobType || job.Project != runner.req.GetProject() {
+		return Response{}, ctx, errors.NotFound("import job '%s' not found", runner.req.GetJobId())
+	}
+
+	return Response{
+		Response: &api.GetImportJobResponse{
+			Job: ImportJobToAPI(job),
+		},
+	}, ctx, nil
+}
+
+// ImportJobToAPI converts the job metadata to the API representation.
+func ImportJobToAPI(job *metadata.JobMetadata) *api.ImportJob {
+	errs := make([]*api.ImportRowError, 0, len(job.Errors))
+	for _, e := range job.Errors {
+		errs = append(errs, &api.ImportRowError{
+			Row:     e.Row,
+			Code:    api.CodeFromString(e.Code),
+			Message: e.Message,
+		})
+	}
+
+	return &api.ImportJob{
+		Id:         job.Id,
+		State:      string(job.State),
+		Collection: job.Collection,
+		Processed:  job.Processed,
+		Committed:  job.Committed,
+		Failed:     job.Failed,
+		Errors:     errs,
+		Message:    job.Message,
+		CreatedAt:  timestamppb.New(job.CreatedAt),
+		UpdatedAt:  timestamppb.New(job.UpdatedAt),
+	}
+}
+
+// ImportSchemaRunner infers the schema from the sampled rows, creates the collection if it doesn't exist or evolves
+// the schema of the existing collection.
+type ImportSchemaRunner struct {
+	*ImportQueryRunner
+}
+
+func (runner *ImportSchemaRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
+	_, coll, err := runner.getDBAndCollection(ctx, tx, tenant,
+		runner.req.GetProject(), runner.req.GetCollection(), runner.req.GetBranch())
+
+	//nolint:errorlint
+	ep, ok := err.(*api.TigrisError)
+	if err != nil && (!ok || ep.Code != api.Code_NOT_FOUND) {
+		return Response{}, ctx, err
+	}
+
+	var rawSchema []byte
+	if coll != nil {
+		if err = runner.mustBeDocumentsCollection(coll, "insert"); err != nil {
+			return Response{}, ctx, err
+		}
+		rawSchema = coll.Schema
+	}
+
+	if err = runner.evolveSchema(ctx, tenant, rawSchema); err != nil {
+		return Response{}, ctx, err
+	}
+
+	return Response{Status: CreatedStatus}, ctx, nil
+}
+
+// ImportBatchRunner inserts a batch of rows and persists the job progress in the same transaction, so the committed
+// rows and the job progress never diverge.
+type ImportBatchRunner struct {
+	*BaseQueryRunner
+
+	req          *api.ImportStreamRequest
+	job          *metadata.JobMetadata
+	rows         []importRow
+	perRow       bool
+	result       *metadata.JobMetadata
+	queryMetrics *metrics.WriteQueryMetrics
+}
+
+func (runner *ImportBatchRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
+	db, coll, err := runner.getDBAndCollection(ctx, tx, tenant,
+		runner.req.GetProject(), runner.req.GetCollection(), runner.req.GetBranch())
+	if err != nil {
+		return Response{}, ctx, err
+	}
+
+	ctx = runner.cdcMgr.WrapContext(ctx, db.Name())
+
+	if err = runner.mustBeDocumentsCollection(coll, "insert"); err != nil {
+		return Response{}, ctx, err
+	}
+	if err = mustOwnImportJob(ctx, tx, tenant, runner.job); err != nil {
+		return Response{}, ctx, err
+	}
+
+	// the runner may be retried on conflicts, so always start from the job state before the batch
+	job := *runner.job
+	job.Errors = append([]metadata.JobRowError(nil), runner.job.Errors...)
+
+	if runner.perRow {
+		for _, r := range runner.rows {
+			if _, _, err = runner.insertOrReplace(ctx, tx, tenant, coll, [][]byte{r.doc}, true); err != nil {
+				if !isImportRowError(err) {
+					return Response{}, ctx, err
+				}
+				job.AddRowError(newJobRowError(r.row, err))
+			}
+		}
+	} else {
+		docs := make([][]byte, len(runner.rows))
+		for i, r := range runner.rows {
+			docs[i] = r.doc
+		}
+
+		if _, _, err = runner.insertOrReplace(ctx, tx, tenant, coll, docs, true); err != nil {
+			return Response{}, ctx, err
+		}
+	}
+
+	job.Processed += int64(len(runner.rows))
+	job.Committed = runner.rows[len(runner.rows)-1].row
+	if err = tenant.MetaStore.Job().Update(ctx, tx, tenant.GetNamespace().Id(), &job); err != nil {
+		return Response{}, ctx, err
+	}
+	runner.result = &job
+
+	runner.queryMetrics.SetWriteType("import_stream")
+	metrics.UpdateSpanTags(ctx, runner.queryMetrics)
+
+	return Response{Status: InsertedStatus}, ctx, nil
+}
+
+// isImportRowError returns true if the error is caused by the document itself and not by the state of the
+// transaction, such rows are reported in the job and skipped.
+func isImportRowError(err error) bool {
+	if err == kv.ErrDuplicateKey {
+		return true
+	}
+
+	//nolint:errorlint
+	ep, ok := err.(*api.TigrisError)
+
+	return ok && (ep.Code == api.Code_INVALID_ARGUMENT || ep.Code == api.Code_ALREADY_EXISTS)
+}
+
+func newJobRowError(row int64, err error) metadata.JobRowError {
+	if err == kv.ErrDuplicateKey {
+		err = errors.AlreadyExists("duplicate key value, violates key constraint")
+	}
+
+	apiErr := api.ToAPIError(CreateApiError(err))
+
+	return metadata.JobRowError{
+		Row:     row,
+		Code:    api.CodeToString(apiErr.Code),
+		Message: apiErr.Message,
+	}
+}
+
+// ImportStreamer consumes the streaming import request. The input is decoded row by row, the schema is inferred from
+// the leading rows when the collection is created by the import, and the rows are committed in batches bounded by
+// the import config. Rows which can't be decoded or inserted are reported in the job and don't fail the import.
+type ImportStreamer struct {
+	sessions     Session
+	factory      *QueryRunnerFactory
+	stream       ImportStreaming
+	accessToken  *types.AccessToken
+	queryMetrics *metrics.WriteQueryMetrics
+
+	req *api.ImportStreamRequest
+	job *metadata.JobMetadata
+	// pending are the rows which failed to decode since the last committed batch. They are persisted together with
+	// the progress covering them, so a resumed job doesn't report them again.
+	pending []metadata.JobRowError
+	// lastRow is the last row read from the input.
+	lastRow int64
+}
+
+func NewImportStreamer(sessions Session, factory *QueryRunnerFactory, stream ImportStreaming,
+	qm *metrics.WriteQueryMetrics, accessToken *types.AccessToken,
+) *ImportStreamer {
+	return &ImportStreamer{
+		sessions:     sessions,
+		factory:      factory,
+		stream:       stream,
+		accessToken:  accessToken,
+		queryMetrics: qm,
+	}
+}
+
+// Import reads the whole stream and returns the final state of the job.
+func (s *ImportStreamer) Import(ctx context.Context) (*metadata.JobMetadata, error) {
+	req, err := s.stream.Recv()
+	if err == io.EOF {
+		return nil, errors.InvalidArgument("empty import stream")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateImportStreamRequest(req); err != nil {
+		return nil, err
+	}
+	s.req = req
+
+	format, err := dataformat.ParseFormat(req.GetFormat())
+	if err != nil {
+		return nil, errors.InvalidArgument(err.Error())
+	}
+
+	opts := &dataformat.ReaderOptions{CSVColumnTypes: req.GetCsvColumnTypes()}
+	if d := req.GetCsvDelimiter(); d != "" {
+		opts.CSVDelimiter, _ = utf8.DecodeRuneInString(d)
+	}
+
+	jobRunner := s.factory.GetImportJobRunner(req, nil, s.accessToken)
+	if _, err = s.sessions.Execute(ctx, jobRunner, ReqOptions{}); err != nil {
+		return nil, err
+	}
+	s.job = jobRunner.result
+
+	reader, err := dataformat.NewReader(format, &importStreamReader{stream: s.stream, buf: req.GetData()}, opts)
+	if err != nil {
+		return s.finish(ctx, err)
+	}
+	defer func() { ulog.E(reader.Close()) }()
+
+	return s.finish(ctx, s.importRows(ctx, reader))
+}
+
+func (s *ImportStreamer) importRows(ctx context.Context, reader dataformat.Reader) error {
+	var (
+		batch      []importRow
+		batchBytes int
+		sampled    = !s.req.GetCreateCollection()
+		sampleSize = config.DefaultConfig.Import.SampleSize
+		batchSize  = config.DefaultConfig.Import.BatchSize
+		maxBytes   = config.DefaultConfig.Import.BatchBytes
+	)
+
+	for {
+		doc, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		//nolint:errorlint
+		rowErr, isRowErr := err.(*dataformat.RowError)
+		if err != nil && !isRowErr {
+			return err
+		}
+		if reader.Row() <= s.job.Committed {
+			// committed by the previous attempt of the job
+			continue
+		}
+		s.lastRow = reader.Row()
+		if isRowErr {
+			s.pending = append(s.pending, metadata.JobRowError{
+				Row:     rowErr.Row,
+				Code:    api.CodeToString(api.Code_INVALID_ARGUMENT),
+				Message: rowErr.Err.Error(),
+			})
+			continue
+		}
+
+		batch = append(batch, importRow{row: reader.Row(), doc: doc})
+		batchBytes += len(doc)
+
+		if !sampled {
+			if len(batch) < sampleSize && batchBytes < maxBytes {
+				continue
+			}
+			if err = s.evolveSchema(ctx, batch); err != nil {
+				return err
+			}
+			sampled = true
+		}
+
+		if len(batch) >= batchSize || batchBytes >= maxBytes {
+			if err = s.flush(ctx, batch); err != nil {
+				return err
+			}
+			batch, batchBytes = nil, 0
+		}
+	}
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if !sampled {
+		if err := s.evolveSchema(ctx, batch); err != nil {
+			return err
+		}
+	}
+
+	return s.flush(ctx, batch)
+}
+
+// flush imports the rows in batches of the configured size, the rows buffered for the schema sampling may span
+// multiple batches.
+func (s *ImportStreamer) flush(ctx context.Context, rows []importRow) error {
+	batchSize := config.DefaultConfig.Import.BatchSize
+	for len(rows) > 0 {
+		n := len(rows)
+		if batchSize > 0 && n > batchSize {
+			n = batchSize
+		}
+		if err := s.importBatch(ctx, rows[:n]); err != nil {
+			return err
+		}
+		rows = rows[n:]
+	}
+
+	return nil
+}
+
+func (s *ImportStreamer) evolveSchema(ctx context.Context, rows []importRow) error {
+	docs := make([][]byte, len(rows))
+	for i, r := range rows {
+		docs[i] = r.doc
+	}
+
+	runner := s.factory.GetImportSchemaRunner(&api.ImportRequest{
+		Project:          s.req.GetProject(),
+		Branch:           s.req.GetBranch(),
+		Collection:       s.req.GetCollection(),
+		Documents:        docs,
+		PrimaryKey:       s.req.GetPrimaryKey(),
+		Autogenerated:    s.req.GetAutogenerated(),
+		CreateCollection: true,
+	}, s.queryMetrics, s.accessToken)
+
+	_, err := s.sessions.Execute(ctx, runner, ReqOptions{})
+
+	return err
+}
+
+// importBatch commits the batch. If the batch fails because of the content of some documents, the failures are
+// isolated by inserting the batch row by row. The schema is inferred only once from the sampled rows, the rows which
+// don't conform to it are rejected instead of evolving the schema on every failed batch.
+func (s *ImportStreamer) importBatch(ctx context.Context, rows []importRow) error {
+	err := s.runBatch(ctx, rows, false)
+	if err == nil || !isImportRowError(err) {
+		return err
+	}
+
+	log.Debug().Str("job", s.job.Id).Err(err).Msg("import batch failed, retrying row by row")
+
+	return s.runBatch(ctx, rows, true)
+}
+
+// runBatch commits the rows together with the pending decode errors of the rows before the last row of the batch.
+func (s *ImportStreamer) runBatch(ctx context.Context, rows []importRow, perRow bool) error {
+	job, rest := s.withPending(rows[len(rows)-1].row)
+
+	runner := s.factory.GetImportBatchRunner(s.req, job, rows, perRow, s.queryMetrics, s.accessToken)
+	if _, err := s.sessions.Execute(ctx, runner, ReqOptions{}); err != nil {
+		return err
+	}
+	s.job = runner.result
+	s.pending = rest
+
+	return nil
+}
+
+// withPending returns the copy of the job with the pending errors of the rows up to the row added, and the pending
+// errors of the rows after it.
+func (s *ImportStreamer) withPending(row int64) (*metadata.JobMetadata, []metadata.JobRowError) {
+	job := *s.job
+	job.Errors = append([]metadata.JobRowError(nil), s.job.Errors...)
+
+	i := 0
+	for ; i < len(s.pending) && s.pending[i].Row <= row; i++ {
+		job.AddRowError(s.pending[i])
+	}
+
+	return &job, s.pending[i:]
+}
+
+// finish persists the final state of the job. The job is left in the failed state when the import is interrupted,
+// it can be resumed later with the same job id.
+func (s *ImportStreamer) finish(ctx context.Context, importErr error) (*metadata.JobMetadata, error) {
+	job := *s.job
+	if importErr == nil && s.lastRow > job.Committed {
+		// the input is fully read, the rows after the last batch all failed to decode
+		withPending, _ := s.withPending(s.lastRow)
+		job = *withPending
+		job.Committed = s.lastRow
+	}
+
+	job.State = metadata.JobCompleted
+	if importErr != nil {
+		// the pending errors are not covered by the committed rows, the resumed job reports them
+		job.State = metadata.JobFailed
+		job.Message = importErr.Error()
+	}
+
+	runner := s.factory.GetImportJobRunner(s.req, &job, s.accessToken)
+	if _, err := s.sessions.Execute(ctx, runner, ReqOptions{}); err != nil {
+		log.Err(err).Str("job", job.Id).Msg("failed to update import job state")
+		if importErr == nil {
+			importErr = err
+		}
+	} else {
+		job = *runner.result
+	}
+
+	if importErr == nil {
+		return &job, nil
+	}
+
+	// the job id is needed by the client to resume the import
+	//nolint:errorlint
+	if ep, ok := CreateApiError(importErr).(*api.TigrisError); ok {
+		return &job, api.Errorf(ep.Code, "import job '%s' failed: %s", job.Id, ep.Message)
+	}
+
+	return &job, errors.Internal("import job '%s' failed: %s", job.Id, importErr.Error())
+}
+
+func validateImportStreamRequest(req *api.ImportStreamRequest) error {
+	if req.GetProject() == "" {
+		return errors.InvalidArgument("project name is missing")
+	}
+	if req.GetCollection() == "" {
+		return errors.InvalidArgument("collection name is missing")
+	}
+	if d := req.GetCsvDelimiter(); d != "" && utf8.RuneCountInString(d) != 1 {
+		return errors.InvalidArgument("csv delimiter should be a single character, got '%s'", d)
+	}
+
+	return nil
+}
+
+// importStreamReader exposes the data chunks of the import stream as io.Reader.
+type importStreamReader struct {
+	stream ImportStreaming
+	buf    []byte
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err == io.EOF {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, fmt.Errorf("receiving import stream: %w", err)
+		}
+		r.buf = req.GetData()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
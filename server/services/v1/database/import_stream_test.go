@@ -0,0 +1,46 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/server/metadata"
+)
+
+func TestImportStreamerWithPending(t *testing.T) {
+	s := &ImportStreamer{
+		job: &metadata.JobMetadata{
+			Committed: 2,
+			Failed:    1,
+			Errors:    []metadata.JobRowError{{Row: 1}},
+		},
+		pending: []metadata.JobRowError{{Row: 3}, {Row: 5}, {Row: 8}},
+	}
+
+	job, rest := s.withPending(5)
+	require.Equal(t, int64(3), job.Failed)
+	require.Equal(t, []metadata.JobRowError{{Row: 1}, {Row: 3}, {Row: 5}}, job.Errors)
+	require.Equal(t, []metadata.JobRowError{{Row: 8}}, rest)
+
+	// the job of the streamer is not changed until the batch is committed
+	require.Equal(t, int64(1), s.job.Failed)
+	require.Len(t, s.job.Errors, 1)
+
+	job, rest = s.withPending(2)
+	require.Equal(t, int64(1), job.Failed)
+	require.Len(t, rest, 3)
+}
@@ -171,20 +171,9 @@ func (runner *SearchIndexerRunner) consume(r *api.ReadResponse) error {
 	if runner.close {
 		return errors.Aborted("consumer exited")
 	}
-	index := runner.collection.PrimaryKey
-	indexParts := make([]any, len(index.Fields)+1)
-	indexParts[0] = index.Name
-	for i, f := range index.Fields {
-		jsonVal, dtp, _, err := jsonparser.Get(r.Data, f.FieldName)
-		if err != nil || dtp == jsonparser.NotExist {
-			return errors.Internal("unable to build index '%s' '%v'", err, dtp)
-		}
-
-		v, err := value.NewValue(f.Type(), jsonVal)
-		if err != nil {
-			return errors.Internal("unable to build index '%s' '%v'", err, dtp)
-		}
-		indexParts[i+1] = v.AsInterface()
+	indexParts, err := primaryKeyParts(runner.collection, r.Data)
+	if err != nil {
+		return err
 	}
 
 	id, err := CreateSearchKey(kv.BuildKey(indexParts...))
@@ -222,6 +211,27 @@ func (runner *SearchIndexerRunner) consume(r *api.ReadResponse) error {
 	return nil
 }
 
+// primaryKeyParts builds the primary key index parts of the document as they are stored in the table key.
+func primaryKeyParts(coll *schema.DefaultCollection, doc []byte) ([]any, error) {
+	index := coll.PrimaryKey
+	indexParts := make([]any, len(index.Fields)+1)
+	indexParts[0] = index.Name
+	for i, f := range index.Fields {
+		jsonVal, dtp, _, err := jsonparser.Get(doc, f.FieldName)
+		if err != nil || dtp == jsonparser.NotExist {
+			return nil, errors.Internal("unable to build index '%s' '%v'", err, dtp)
+		}
+
+		v, err := value.NewValue(f.Type(), jsonVal)
+		if err != nil {
+			return nil, errors.Internal("unable to build index '%s' '%v'", err, dtp)
+		}
+		indexParts[i+1] = v.AsInterface()
+	}
+
+	return indexParts, nil
+}
+
 func createReadReq(req *api.BuildCollectionSearchIndexRequest) *api.ReadRequest {
 	return &api.ReadRequest{
 		Project:    req.Project,
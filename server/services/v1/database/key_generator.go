@@ -108,6 +108,24 @@ func (k *keyGenerator) setKeyInDoc(field *schema.Field, jsonVal []byte) error {
 	return err
 }
 
+// keysFromDocument returns the primary key fields which are present in the document, without generating the missing
+// ones. It is used to identify in the response the document which has failed before its key is generated.
+func (k *keyGenerator) keysFromDocument() []byte {
+	for _, field := range k.index.Fields {
+		jsonVal, dtp, _, err := jsonparser.Get(k.document, field.FieldName)
+		if err != nil || dtp == jsonparser.Null {
+			continue
+		}
+		k.addKeyToResp(field, jsonVal)
+	}
+
+	if len(k.keysForResp) == 0 {
+		return nil
+	}
+
+	return k.getKeysForResp()
+}
+
 func (k *keyGenerator) addKeyToResp(field *schema.Field, jsonVal []byte) {
 	jsonVal = k.getJsonQuotedValue(field.Type(), jsonVal)
 	jsonKeyAndValue := []byte(fmt.Sprintf(`"%s":%s`, field.FieldName, jsonVal))
@@ -80,7 +80,16 @@ func (runner *InsertQueryRunner) Run(ctx context.Context, tx transaction.Tx, ten
 		return Response{}, ctx, err
 	}
 
-	ts, allKeys, err := runner.insertOrReplace(ctx, tx, tenant, coll, runner.req.GetDocuments(), true)
+	var (
+		ts        *internal.Timestamp
+		allKeys   [][]byte
+		docErrors []*api.DocError
+	)
+	if opts := runner.req.GetOptions(); opts != nil && isUnordered(opts.Ordered) {
+		ts, allKeys, docErrors, err = runner.insertOrReplaceUnordered(ctx, tx, tenant, coll, runner.req.GetDocuments(), true)
+	} else {
+		ts, allKeys, err = runner.insertOrReplace(ctx, tx, tenant, coll, runner.req.GetDocuments(), true)
+	}
 	if err != nil {
 		if err == kv.ErrDuplicateKey {
 			return Response{}, ctx, errors.AlreadyExists(err.(kv.StoreError).Msg())
@@ -95,6 +104,7 @@ func (runner *InsertQueryRunner) Run(ctx context.Context, tx transaction.Tx, ten
 	return Response{
 		CreatedAt: ts,
 		AllKeys:   allKeys,
+		DocErrors: docErrors,
 		Status:    InsertedStatus,
 	}, ctx, nil
 }
@@ -119,7 +129,16 @@ func (runner *ReplaceQueryRunner) Run(ctx context.Context, tx transaction.Tx, te
 		return Response{}, ctx, err
 	}
 
-	ts, allKeys, err := runner.insertOrReplace(ctx, tx, tenant, coll, runner.req.GetDocuments(), false)
+	var (
+		ts        *internal.Timestamp
+		allKeys   [][]byte
+		docErrors []*api.DocError
+	)
+	if opts := runner.req.GetOptions(); opts != nil && isUnordered(opts.Ordered) {
+		ts, allKeys, docErrors, err = runner.insertOrReplaceUnordered(ctx, tx, tenant, coll, runner.req.GetDocuments(), false)
+	} else {
+		ts, allKeys, err = runner.insertOrReplace(ctx, tx, tenant, coll, runner.req.GetDocuments(), false)
+	}
 	if err != nil {
 		return Response{}, ctx, err
 	}
@@ -130,6 +149,7 @@ func (runner *ReplaceQueryRunner) Run(ctx context.Context, tx transaction.Tx, te
 	return Response{
 		CreatedAt: ts,
 		AllKeys:   allKeys,
+		DocErrors: docErrors,
 		Status:    ReplacedStatus,
 	}, ctx, nil
 }
@@ -410,7 +430,7 @@ type CountQueryRunner struct {
 }
 
 func (runner *CountQueryRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
-	_, coll, err := runner.getDBAndCollection(ctx, tx, tenant, runner.req.GetProject(), runner.req.GetCollection(), runner.req.GetBranch())
+	db, coll, err := runner.getDBAndCollection(ctx, tx, tenant, runner.req.GetProject(), runner.req.GetCollection(), runner.req.GetBranch())
 	if err != nil {
 		return Response{}, ctx, err
 	}
@@ -419,37 +439,100 @@ func (runner *CountQueryRunner) Run(ctx context.Context, tx transaction.Tx, tena
 		return Response{}, ctx, err
 	}
 
-	reader := NewDatabaseReader(ctx, tx)
-	var iterator Iterator
-	if iterator, err = reader.ScanTable(coll.EncodedName, false); err != nil {
+	var count int64
+	if runner.req.GetAsOf() != nil {
+		count, err = runner.countAsOf(ctx, tenant, db.Name(), coll)
+	} else {
+		count, err = runner.count(ctx, tx, coll)
+	}
+	if err != nil {
 		return Response{}, ctx, err
 	}
-	if !filter.None(runner.req.Filter) {
-		filterFactory := filter.NewFactory(coll.QueryableFields, nil)
-		var filters []filter.Filter
-		if filters, err = filterFactory.Factorize(runner.req.Filter); err != nil {
-			return Response{}, ctx, err
+
+	runner.queryMetrics.SetReadType("count")
+	ctx = metrics.UpdateSpanTags(ctx, runner.queryMetrics)
+
+	return Response{
+		Response: &api.CountResponse{
+			Count: count,
+		},
+	}, ctx, nil
+}
+
+func (runner *CountQueryRunner) count(ctx context.Context, tx transaction.Tx, coll *schema.DefaultCollection) (int64, error) {
+	iterator, err := NewDatabaseReader(ctx, tx).ScanTable(coll.EncodedName, false)
+	if err != nil {
+		return 0, err
+	}
+
+	wrapped, err := runner.filter(coll)
+	if err != nil {
+		return 0, err
+	}
+	if !wrapped.None() {
+		iterator = NewFilterIterator(iterator, wrapped)
+	}
+
+	return runner.countRows(iterator)
+}
+
+// countAsOf counts the documents at the as_of version, the count is done in its own transaction reading at this
+// version instead of the transaction of the session.
+func (runner *CountQueryRunner) countAsOf(ctx context.Context, tenant *metadata.Tenant, dbName string, coll *schema.DefaultCollection) (int64, error) {
+	asOf, err := NewAsOfReader(ctx, runner.txMgr, runner.cdcMgr, tenant.Encoder, runner.req.GetAsOf())
+	if err != nil {
+		return 0, err
+	}
+
+	if !asOf.InMVCCWindow() {
+		wrapped, err := runner.filter(coll)
+		if err != nil {
+			return 0, err
 		}
 
-		if iterator, err = reader.FilteredRead(iterator, filter.NewWrappedFilter(filters)); err != nil {
-			return Response{}, ctx, err
+		// the filter is applied while the history is replayed
+		iterator, err := asOf.HistoryIterator(ctx, dbName, coll, wrapped)
+		if err != nil {
+			return 0, err
 		}
+		return runner.countRows(iterator)
+	}
+
+	tx, err := runner.txMgr.StartTx(asOf.WrapContext(ctx))
+	if err != nil {
+		return 0, err
 	}
+	defer func() { _ = tx.Rollback(ctx) }()
 
+	count, err := runner.count(ctx, tx, coll)
+	if err == kv.ErrTransactionMaxDurationReached {
+		return 0, asOf.TooOld()
+	}
+
+	return count, err
+}
+
+func (runner *CountQueryRunner) filter(coll *schema.DefaultCollection) (*filter.WrappedFilter, error) {
+	if filter.None(runner.req.Filter) {
+		return filter.NewWrappedFilter(nil), nil
+	}
+
+	filters, err := filter.NewFactory(coll.QueryableFields, nil).Factorize(runner.req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter.NewWrappedFilter(filters), nil
+}
+
+func (runner *CountQueryRunner) countRows(iterator Iterator) (int64, error) {
 	var count int64
 	var row Row
 	for iterator.Next(&row) {
 		count++
 	}
 
-	runner.queryMetrics.SetReadType("count")
-	ctx = metrics.UpdateSpanTags(ctx, runner.queryMetrics)
-
-	return Response{
-		Response: &api.CountResponse{
-			Count: count,
-		},
-	}, ctx, nil
+	return count, iterator.Interrupted()
 }
 
 // StreamingQueryRunner is a runner used for Queries that are reads and needs to return result in streaming fashion.
@@ -603,6 +686,21 @@ func (runner *StreamingQueryRunner) ReadOnly(ctx context.Context, tenant *metada
 		return Response{}, ctx, err
 	}
 
+	txCtx := ctx
+	var asOf *AsOfReader
+	if runner.req.GetOptions().GetAsOf() != nil {
+		if options, err = asOfReaderOptions(options, collection); err != nil {
+			return Response{}, ctx, err
+		}
+		if asOf, err = NewAsOfReader(ctx, runner.txMgr, runner.cdcMgr, tenant.Encoder, runner.req.GetOptions().GetAsOf()); err != nil {
+			return Response{}, ctx, err
+		}
+		if !asOf.InMVCCWindow() {
+			return runner.iterateOnHistory(ctx, db.Name(), collection, asOf, options)
+		}
+		txCtx = asOf.WrapContext(ctx)
+	}
+
 	if options.inMemoryStore {
 		if err = runner.iterateOnSearchStore(ctx, collection, options); err != nil {
 			return Response{}, ctx, CreateApiError(err)
@@ -613,7 +711,7 @@ func (runner *StreamingQueryRunner) ReadOnly(ctx context.Context, tenant *metada
 	for {
 		// A for loop is needed to recreate the transaction after exhausting the duration of the previous transaction.
 		// This is mainly needed for long-running transactions, otherwise reads should be small.
-		tx, err := runner.txMgr.StartTx(ctx)
+		tx, err := runner.txMgr.StartTx(txCtx)
 		if err != nil {
 			return Response{}, ctx, err
 		}
@@ -627,6 +725,11 @@ func (runner *StreamingQueryRunner) ReadOnly(ctx context.Context, tenant *metada
 
 		_ = tx.Rollback(ctx)
 
+		if err == kv.ErrTransactionMaxDurationReached && asOf != nil {
+			// the requested version has left the MVCC window, it can't be read anymore with a new transaction
+			return Response{}, ctx, CreateApiError(asOf.TooOld())
+		}
+
 		if err == kv.ErrTransactionMaxDurationReached {
 			// We have received ErrTransactionMaxDurationReached i.e. 5 second transaction limit, so we need to retry the
 			// transaction.
@@ -661,6 +764,10 @@ func (runner *StreamingQueryRunner) ReadOnly(ctx context.Context, tenant *metada
 // if we see ErrTransactionMaxDurationReached which is expected because we do not expect caller to do long reads in an
 // explicit transaction.
 func (runner *StreamingQueryRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, context.Context, error) {
+	if runner.req.GetOptions().GetAsOf() != nil {
+		return Response{}, ctx, errors.InvalidArgument("as_of is not supported inside an explicit transaction")
+	}
+
 	db, coll, err := runner.getDBAndCollection(ctx, tx, tenant,
 		runner.req.GetProject(), runner.req.GetCollection(), runner.req.GetBranch())
 	if err != nil {
@@ -695,6 +802,24 @@ func (runner *StreamingQueryRunner) Run(ctx context.Context, tx transaction.Tx,
 	return Response{}, ctx, nil
 }
 
+// iterateOnHistory streams the documents of the collection reconstructed from the CDC history at the as_of version.
+func (runner *StreamingQueryRunner) iterateOnHistory(ctx context.Context, dbName string, coll *schema.DefaultCollection, asOf *AsOfReader, options readerOptions) (Response, context.Context, error) {
+	if options.sorting != nil {
+		return Response{}, ctx, errors.InvalidArgument("sort is not supported by the as_of reads older than '%d'", asOf.current-mvccWindowVersions)
+	}
+
+	iter, err := asOf.HistoryIterator(ctx, dbName, coll, options.filter)
+	if err != nil {
+		return Response{}, ctx, CreateApiError(err)
+	}
+
+	if _, err = runner.iterate(ctx, coll, iter, options.fieldFactory); err != nil {
+		return Response{}, ctx, CreateApiError(err)
+	}
+
+	return Response{}, runner.instrumentRunner(ctx, options), nil
+}
+
 func (runner *StreamingQueryRunner) iterateOnKvStore(ctx context.Context, tx transaction.Tx, coll *schema.DefaultCollection, options readerOptions) ([]byte, error) {
 	var err error
 	var iter Iterator
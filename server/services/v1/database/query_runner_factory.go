@@ -50,6 +50,54 @@ func (f *QueryRunnerFactory) GetImportQueryRunner(r *api.ImportRequest, qm *metr
 	}
 }
 
+func (f *QueryRunnerFactory) GetImportJobRunner(r *api.ImportStreamRequest, job *metadata.JobMetadata, accessToken *types.AccessToken) *ImportJobRunner {
+	return &ImportJobRunner{
+		BaseQueryRunner: NewBaseQueryRunner(f.encoder, f.cdcMgr, f.txMgr, f.searchStore, accessToken),
+		req:             r,
+		job:             job,
+	}
+}
+
+func (f *QueryRunnerFactory) GetImportJobQueryRunner(r *api.GetImportJobRequest, accessToken *types.AccessToken) *ImportJobQueryRunner {
+	return &ImportJobQueryRunner{
+		BaseQueryRunner: NewBaseQueryRunner(f.encoder, f.cdcMgr, f.txMgr, f.searchStore, accessToken),
+		req:             r,
+	}
+}
+
+func (f *QueryRunnerFactory) GetImportSchemaRunner(r *api.ImportRequest, qm *metrics.WriteQueryMetrics, accessToken *types.AccessToken) *ImportSchemaRunner {
+	return &ImportSchemaRunner{
+		ImportQueryRunner: f.GetImportQueryRunner(r, qm, accessToken),
+	}
+}
+
+func (f *QueryRunnerFactory) GetImportBatchRunner(r *api.ImportStreamRequest, job *metadata.JobMetadata, rows []importRow,
+	perRow bool, qm *metrics.WriteQueryMetrics, accessToken *types.AccessToken,
+) *ImportBatchRunner {
+	return &ImportBatchRunner{
+		BaseQueryRunner: NewBaseQueryRunner(f.encoder, f.cdcMgr, f.txMgr, f.searchStore, accessToken),
+		req:             r,
+		job:             job,
+		rows:            rows,
+		perRow:          perRow,
+		queryMetrics:    qm,
+	}
+}
+
+func (f *QueryRunnerFactory) GetExportJobRunner(job *metadata.JobMetadata, accessToken *types.AccessToken) *ExportJobRunner {
+	return &ExportJobRunner{
+		BaseQueryRunner: NewBaseQueryRunner(f.encoder, f.cdcMgr, f.txMgr, f.searchStore, accessToken),
+		job:             job,
+	}
+}
+
+func (f *QueryRunnerFactory) GetExportJobQueryRunner(r *api.GetExportJobRequest, accessToken *types.AccessToken) *ExportJobQueryRunner {
+	return &ExportJobQueryRunner{
+		BaseQueryRunner: NewBaseQueryRunner(f.encoder, f.cdcMgr, f.txMgr, f.searchStore, accessToken),
+		req:             r,
+	}
+}
+
 func (f *QueryRunnerFactory) GetInsertQueryRunner(r *api.InsertRequest, qm *metrics.WriteQueryMetrics, accessToken *types.AccessToken) *InsertQueryRunner {
 	return &InsertQueryRunner{
 		BaseQueryRunner: NewBaseQueryRunner(f.encoder, f.cdcMgr, f.txMgr, f.searchStore, accessToken),
@@ -15,12 +15,15 @@
 package database
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
 	"github.com/tigrisdata/tigris/query/sort"
 	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/store/kv"
 )
 
 func TestSearchQueryRunner_getFacetFields(t *testing.T) {
@@ -211,3 +214,44 @@ func TestSearchQueryRunner_getSortOrdering(t *testing.T) {
 		assert.Nil(t, sort)
 	})
 }
+
+func TestDocumentErrors(t *testing.T) {
+	pk := &schema.Index{Fields: []*schema.Field{
+		{FieldName: "id", DataType: schema.Int64Type},
+		{FieldName: "name", DataType: schema.StringType},
+	}}
+
+	t.Run("keys from document", func(t *testing.T) {
+		assert.Equal(t, []byte(`{"id":1,"name":"a"}`), newKeyGenerator([]byte(`{"id":1,"name":"a","v":2}`), nil, pk).keysFromDocument())
+		assert.Equal(t, []byte(`{"name":"a"}`), newKeyGenerator([]byte(`{"id":null,"name":"a"}`), nil, pk).keysFromDocument())
+		assert.Nil(t, newKeyGenerator([]byte(`{"v":2}`), nil, pk).keysFromDocument())
+	})
+
+	t.Run("document error classification", func(t *testing.T) {
+		assert.True(t, isDocumentError(kv.ErrDuplicateKey))
+		assert.True(t, isDocumentError(errors.InvalidArgument("bad doc")))
+		assert.True(t, isDocumentError(errors.AlreadyExists("exists")))
+		assert.False(t, isDocumentError(errors.Aborted("conflict")))
+		assert.False(t, isDocumentError(fmt.Errorf("some error")))
+	})
+
+	t.Run("new doc error", func(t *testing.T) {
+		docErr := newDocError(3, []byte(`{"id":1}`),
+			api.Errorf(api.Code_INVALID_ARGUMENT, "wrong type").WithField("a.b"))
+		assert.Equal(t, int32(3), docErr.Index)
+		assert.Equal(t, []byte(`{"id":1}`), docErr.Key)
+		assert.Equal(t, "a.b", docErr.Field)
+		assert.Equal(t, api.Code_INVALID_ARGUMENT, docErr.Error.Code)
+
+		docErr = newDocError(0, nil, kv.ErrDuplicateKey)
+		assert.Equal(t, api.Code_ALREADY_EXISTS, docErr.Error.Code)
+		assert.Empty(t, docErr.Field)
+	})
+
+	t.Run("ordered by default", func(t *testing.T) {
+		ordered, unordered := true, false
+		assert.False(t, isUnordered(nil))
+		assert.False(t, isUnordered(&ordered))
+		assert.True(t, isUnordered(&unordered))
+	})
+}
@@ -38,6 +38,11 @@ type SearchStreaming interface {
 	api.Tigris_SearchServer
 }
 
+// ImportStreaming is a wrapper interface for passing around the streaming import.
+type ImportStreaming interface {
+	api.Tigris_ImportStreamServer
+}
+
 // ReqOptions are options used by queryLifecycle to execute a query.
 type ReqOptions struct {
 	TxCtx              *api.TransactionCtx
@@ -54,4 +59,5 @@ type Response struct {
 	DeletedAt     *internal.Timestamp
 	ModifiedCount int32
 	AllKeys       [][]byte
+	DocErrors     []*api.DocError
 }
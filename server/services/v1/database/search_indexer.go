@@ -41,19 +41,29 @@ import (
 
 type TentativeSearchKeysToRemove struct{}
 
+// SearchIndexer indexes the collection writes in the search store. Without the outbox the documents are indexed
+// after the commit on a best-effort basis, with the outbox the writes are added to the outbox before the commit and
+// indexed by the outbox worker.
 type SearchIndexer struct {
 	searchStore search.Store
 	tenantMgr   *metadata.TenantManager
+	outbox      *SearchOutboxWorker
 }
 
-func NewSearchIndexer(searchStore search.Store, tenantMgr *metadata.TenantManager) *SearchIndexer {
+func NewSearchIndexer(searchStore search.Store, tenantMgr *metadata.TenantManager, outbox *SearchOutboxWorker) *SearchIndexer {
 	return &SearchIndexer{
 		searchStore: searchStore,
 		tenantMgr:   tenantMgr,
+		outbox:      outbox,
 	}
 }
 
 func (i *SearchIndexer) OnPostCommit(ctx context.Context, _ *metadata.Tenant, eventListener kv.EventListener) error {
+	if i.outbox != nil {
+		i.outbox.Wake()
+		return nil
+	}
+
 	for _, event := range eventListener.GetEvents() {
 		var err error
 
@@ -117,8 +127,12 @@ func (i *SearchIndexer) OnPostCommit(ctx context.Context, _ *metadata.Tenant, ev
 	return nil
 }
 
-func (*SearchIndexer) OnPreCommit(context.Context, *metadata.Tenant, transaction.Tx, kv.EventListener) error {
-	return nil
+func (i *SearchIndexer) OnPreCommit(ctx context.Context, tenant *metadata.Tenant, tx transaction.Tx, eventListener kv.EventListener) error {
+	if i.outbox == nil {
+		return nil
+	}
+
+	return i.outbox.Add(ctx, tenant, tx, eventListener.GetEvents())
 }
 
 func (*SearchIndexer) OnRollback(context.Context, *metadata.Tenant, kv.EventListener) {}
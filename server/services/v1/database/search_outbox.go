@@ -0,0 +1,513 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/apple/foundationdb/bindings/go/src/fdb/tuple"
+	"github.com/rs/zerolog/log"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/lib/uuid"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/metrics"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/store/kv"
+	"github.com/tigrisdata/tigris/store/search"
+)
+
+// SearchOutboxWorker indexes the collection writes stored in the search outbox. The outbox only has the keys of the
+// written documents, the worker reads the current state of the documents and upserts them in the search index, or
+// deletes them from the search index if they don't exist anymore. A batch failed with a transient error stays in the
+// outbox and is retried with a backoff, so the search index is eventually consistent with the collections. The
+// documents which can never be indexed, like the documents of a dropped namespace or the documents rejected by the
+// search store, are dropped so that they don't block the rest of the partition.
+type SearchOutboxWorker struct {
+	txMgr       *transaction.Manager
+	tenantMgr   *metadata.TenantManager
+	tracker     *metadata.CacheTracker
+	searchStore search.Store
+	outbox      *metadata.SearchOutboxSubspace
+	cfg         *config.SearchOutboxConfig
+	owner       string
+	partitions  []outboxPartition
+	wake        chan struct{}
+	ctx         context.Context
+	cancel      context.CancelFunc
+	stopOnce    sync.Once
+	wg          sync.WaitGroup
+}
+
+// outboxPartition is the retry state of the partition, it is only accessed by the worker goroutine.
+type outboxPartition struct {
+	failures int
+	retryAt  time.Time
+}
+
+func NewSearchOutboxWorker(txMgr *transaction.Manager, tenantMgr *metadata.TenantManager, searchStore search.Store,
+	cfg *config.SearchOutboxConfig,
+) *SearchOutboxWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &SearchOutboxWorker{
+		txMgr:       txMgr,
+		tenantMgr:   tenantMgr,
+		tracker:     metadata.NewCacheTracker(tenantMgr, txMgr),
+		searchStore: searchStore,
+		outbox:      metadata.NewMetadataDictionary(metadata.DefaultNameRegistry).SearchOutbox(),
+		cfg:         cfg,
+		owner:       uuid.NewUUIDAsString(),
+		partitions:  make([]outboxPartition, cfg.Partitions),
+		wake:        make(chan struct{}, 1),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+func (w *SearchOutboxWorker) Start() {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-ticker.C:
+			case <-w.wake:
+			}
+
+			w.drain(w.ctx)
+		}
+	}()
+}
+
+// Stop interrupts the batch being indexed and waits for the worker to exit. The items of the interrupted batch stay
+// in the outbox and are indexed again once the lease of the partition expires. It is safe to call Stop more than once.
+func (w *SearchOutboxWorker) Stop() {
+	w.stopOnce.Do(w.cancel)
+	w.wg.Wait()
+}
+
+// Wake makes the worker to process the outbox without waiting for the next poll.
+func (w *SearchOutboxWorker) Wake() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Add adds the collection writes of the transaction to the outbox. It is called before the commit so the writes and
+// the outbox items are committed atomically.
+func (w *SearchOutboxWorker) Add(ctx context.Context, tenant *metadata.Tenant, tx transaction.Tx, events []*kv.Event) error {
+	items := make(map[int64]*metadata.SearchOutboxItem)
+	for _, event := range events {
+		if event.Key == nil {
+			// event.Key == nil if event comes from drop table
+			continue
+		}
+		if _, _, _, ok := w.tenantMgr.GetEncoder().DecodeTableName(event.Table); !ok {
+			// not a collection
+			continue
+		}
+
+		key := packOutboxKey(event.Key)
+		partition := w.outbox.Partition(event.Table, key, w.cfg.Partitions)
+
+		item, ok := items[partition]
+		if !ok {
+			item = &metadata.SearchOutboxItem{}
+			items[partition] = item
+		}
+		item.Entries = append(item.Entries, metadata.SearchOutboxEntry{
+			Namespace: tenant.GetNamespace().StrId(),
+			Table:     event.Table,
+			Key:       key,
+		})
+	}
+
+	for partition, item := range items {
+		if err := w.outbox.Add(ctx, tx, partition, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// drain processes all the partitions until they are empty or fail.
+func (w *SearchOutboxWorker) drain(ctx context.Context) {
+	for p := range w.partitions {
+		state := &w.partitions[p]
+		if time.Now().Before(state.retryAt) {
+			continue
+		}
+
+		for {
+			n, err := w.processPartition(ctx, int64(p))
+			if err != nil && ctx.Err() != nil {
+				// stopped, the partition is processed by the next worker
+				return
+			}
+			if err != nil {
+				state.failures++
+				state.retryAt = time.Now().Add(w.backoff(state.failures))
+				metrics.SearchOutboxRetry()
+				log.Err(err).Int("partition", p).Int("failures", state.failures).Msg("search outbox indexing failed")
+				break
+			}
+
+			state.failures = 0
+			if n == 0 {
+				break
+			}
+		}
+	}
+}
+
+func (w *SearchOutboxWorker) backoff(failures int) time.Duration {
+	backoff := w.cfg.RetryBackoff
+	for i := 1; i < failures && backoff < w.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > w.cfg.MaxBackoff {
+		return w.cfg.MaxBackoff
+	}
+
+	return backoff
+}
+
+// processPartition indexes the oldest batch of the partition and returns the number of processed outbox items.
+func (w *SearchOutboxWorker) processPartition(ctx context.Context, partition int64) (int, error) {
+	// the lease is written only if the partition has items, so polling the idle partitions doesn't conflict with the
+	// other workers
+	if empty, err := w.isEmpty(ctx, partition); err != nil || empty {
+		return 0, err
+	}
+
+	var items []*metadata.SearchOutboxItem
+	if err := w.withTx(ctx, func(tx transaction.Tx) error {
+		owned, err := w.outbox.AcquireLease(ctx, tx, partition, w.owner, w.cfg.LeaseTime)
+		if err != nil || !owned {
+			return err
+		}
+
+		items, err = w.outbox.Peek(ctx, tx, partition, w.cfg.BatchSize)
+		return err
+	}); err != nil || len(items) == 0 {
+		return 0, err
+	}
+
+	indexed, dropped, err := w.index(ctx, items)
+	if err != nil {
+		return 0, err
+	}
+
+	// the items are only removed if the partition is still owned, otherwise the new owner indexes them again
+	if err = w.withTx(ctx, func(tx transaction.Tx) error {
+		owned, err := w.outbox.AcquireLease(ctx, tx, partition, w.owner, w.cfg.LeaseTime)
+		if err != nil {
+			return err
+		}
+		if !owned {
+			return errors.Aborted("search outbox partition '%d' is leased by another worker", partition)
+		}
+
+		return w.outbox.Remove(ctx, tx, partition, items)
+	}); err != nil {
+		return 0, err
+	}
+
+	metrics.SearchOutboxIndexed(indexed, dropped, time.Since(items[0].CreatedAt))
+
+	return len(items), nil
+}
+
+// isEmpty checks if the partition has items with a read-only transaction.
+func (w *SearchOutboxWorker) isEmpty(ctx context.Context, partition int64) (bool, error) {
+	tx, err := w.txMgr.StartTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	items, err := w.outbox.Peek(ctx, tx, partition, 1)
+	if err != nil {
+		return false, err
+	}
+
+	return len(items) == 0, nil
+}
+
+type outboxDocument struct {
+	namespace string
+	table     []byte
+	key       kv.Key
+}
+
+type outboxBatch struct {
+	docs    [][]byte
+	deletes []string
+}
+
+// index indexes the current state of the documents of the items. It returns the number of indexed documents and the
+// number of dropped documents, which can't be indexed and are not retried.
+func (w *SearchOutboxWorker) index(ctx context.Context, items []*metadata.SearchOutboxItem) (int, int, error) {
+	docs, dropped, err := w.documents(ctx, items)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	batches, unreadable, err := w.readDocuments(ctx, docs)
+	if err != nil {
+		return 0, 0, err
+	}
+	dropped += unreadable
+
+	var indexed int
+	for index, batch := range batches {
+		if len(batch.docs) > 0 {
+			resp, err := w.searchStore.IndexDocuments(ctx, index, bytes.NewReader(bytes.Join(batch.docs, []byte("\n"))),
+				search.IndexDocumentsOptions{
+					Action:    search.Replace,
+					BatchSize: len(batch.docs),
+				})
+			if err != nil {
+				return 0, 0, err
+			}
+
+			for _, r := range resp {
+				switch {
+				case r.Success:
+					indexed++
+				case r.Code >= http.StatusInternalServerError:
+					return 0, 0, search.NewSearchError(r.Code, search.ErrCodeUnhandled, r.Error)
+				default:
+					// the document is rejected by the search store, retrying doesn't help
+					dropped++
+					log.Error().Str("index", index).Int("code", r.Code).Str("error", r.Error).
+						Msg("search outbox document rejected")
+				}
+			}
+		}
+
+		for _, key := range batch.deletes {
+			if err := w.searchStore.DeleteDocument(ctx, index, key); err != nil && !search.IsErrNotFound(err) {
+				return 0, 0, err
+			}
+			indexed++
+		}
+	}
+
+	return indexed, dropped, nil
+}
+
+// documents returns the written documents of the items, every document only once, along with the number of dropped
+// documents. The tenants of the documents are refreshed, so that the collections created by the same transactions
+// are known. The documents of the dropped namespaces and the documents with a malformed key are dropped.
+func (w *SearchOutboxWorker) documents(ctx context.Context, items []*metadata.SearchOutboxItem) ([]outboxDocument, int, error) {
+	var (
+		docs       []outboxDocument
+		dropped    int
+		seen       = make(map[string]struct{})
+		namespaces = make(map[string]struct{})
+	)
+	for _, item := range items {
+		for _, e := range item.Entries {
+			id := string(e.Table) + string(e.Key)
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			key, err := unpackOutboxKey(e.Key)
+			if err != nil {
+				dropped++
+				log.Err(err).Str("ns", e.Namespace).Msg("search outbox document with malformed key dropped")
+				continue
+			}
+
+			docs = append(docs, outboxDocument{namespace: e.Namespace, table: e.Table, key: key})
+			namespaces[e.Namespace] = struct{}{}
+		}
+	}
+
+	for ns := range namespaces {
+		tenant, err := w.tenantMgr.GetTenant(ctx, ns)
+		if isNamespaceNotFound(err) {
+			delete(namespaces, ns)
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if _, err = w.tracker.InstantTracking(ctx, nil, tenant); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	live := docs[:0]
+	for _, doc := range docs {
+		if _, ok := namespaces[doc.namespace]; !ok {
+			dropped++
+			continue
+		}
+		live = append(live, doc)
+	}
+
+	return live, dropped, nil
+}
+
+func isNamespaceNotFound(err error) bool {
+	//nolint:errorlint
+	e, ok := err.(metadata.Error)
+	return ok && e.Code() == metadata.ErrCodeNamespaceNotFound
+}
+
+// readDocuments reads the current state of the documents and groups them by the search index. It also returns the
+// number of documents dropped as they can't be converted to the search documents. Every document is indexed in the
+// state it is read at, so the documents are read in multiple transactions once the size of the read documents reaches
+// the configured ReadBytes, to stay within the transaction duration limit.
+func (w *SearchOutboxWorker) readDocuments(ctx context.Context, docs []outboxDocument) (map[string]*outboxBatch, int, error) {
+	var (
+		tx        transaction.Tx
+		err       error
+		readBytes int
+	)
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	var dropped int
+	batches := make(map[string]*outboxBatch)
+	for _, doc := range docs {
+		if tx == nil || (w.cfg.ReadBytes > 0 && readBytes >= w.cfg.ReadBytes) {
+			if tx != nil {
+				_ = tx.Rollback(ctx)
+			}
+			if tx, err = w.txMgr.StartTx(ctx); err != nil {
+				tx = nil
+				return nil, 0, err
+			}
+			readBytes = 0
+		}
+
+		db, collName, ok := w.tenantMgr.DecodeTableName(doc.table)
+		if !ok {
+			// the collection is dropped
+			continue
+		}
+
+		collection := db.GetCollection(collName)
+		if collection == nil || collection.GetImplicitSearchIndex() == nil {
+			continue
+		}
+
+		searchKey, err := CreateSearchKey(doc.key)
+		if err != nil {
+			dropped++
+			log.Err(err).Str("collection", collName).Msg("search outbox document with unsupported key dropped")
+			continue
+		}
+
+		index := collection.GetImplicitSearchIndex().StoreIndexName()
+		batch, ok := batches[index]
+		if !ok {
+			batch = &outboxBatch{}
+			batches[index] = batch
+		}
+
+		parts := make([]any, len(doc.key))
+		for i, p := range doc.key {
+			parts[i] = p
+		}
+
+		it, err := tx.Read(ctx, keys.NewKey(doc.table, parts...), false)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var row kv.KeyValue
+		if !it.Next(&row) {
+			if it.Err() != nil {
+				return nil, 0, it.Err()
+			}
+
+			batch.deletes = append(batch.deletes, searchKey)
+			continue
+		}
+		readBytes += len(row.Data.RawData)
+
+		packed, err := PackSearchFields(ctx, row.Data, collection, searchKey)
+		if err != nil {
+			dropped++
+			log.Err(err).Str("collection", collName).Str("key", searchKey).Msg("search outbox document dropped")
+			continue
+		}
+		batch.docs = append(batch.docs, packed)
+	}
+
+	return batches, dropped, nil
+}
+
+func (w *SearchOutboxWorker) withTx(ctx context.Context, fn func(tx transaction.Tx) error) error {
+	tx, err := w.txMgr.StartTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func packOutboxKey(key kv.Key) []byte {
+	t := make(tuple.Tuple, len(key))
+	for i, p := range key {
+		t[i] = p
+	}
+
+	return t.Pack()
+}
+
+func unpackOutboxKey(b []byte) (kv.Key, error) {
+	t, err := tuple.Unpack(b)
+	if err != nil {
+		return nil, err
+	}
+
+	key := make(kv.Key, len(t))
+	for i, p := range t {
+		key[i] = p
+	}
+
+	return key, nil
+}
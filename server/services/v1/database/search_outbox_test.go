@@ -0,0 +1,78 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/store/kv"
+)
+
+func TestSearchOutboxKey(t *testing.T) {
+	key := kv.BuildKey("pkey", int64(10), "abc", []byte{1, 2})
+
+	unpacked, err := unpackOutboxKey(packOutboxKey(key))
+	require.NoError(t, err)
+	require.Equal(t, key, unpacked)
+
+	expSearchKey, err := CreateSearchKey(key)
+	require.NoError(t, err)
+	searchKey, err := CreateSearchKey(unpacked)
+	require.NoError(t, err)
+	require.Equal(t, expSearchKey, searchKey)
+}
+
+func TestSearchOutboxBackoff(t *testing.T) {
+	w := &SearchOutboxWorker{cfg: &config.SearchOutboxConfig{
+		RetryBackoff: 100 * time.Millisecond,
+		MaxBackoff:   time.Second,
+	}}
+
+	require.Equal(t, 100*time.Millisecond, w.backoff(1))
+	require.Equal(t, 200*time.Millisecond, w.backoff(2))
+	require.Equal(t, 800*time.Millisecond, w.backoff(4))
+	require.Equal(t, time.Second, w.backoff(5))
+	require.Equal(t, time.Second, w.backoff(100))
+}
+
+func TestSearchOutboxNamespaceNotFound(t *testing.T) {
+	require.True(t, isNamespaceNotFound(metadata.NewNamespaceNotFoundErr("ns1")))
+	require.False(t, isNamespaceNotFound(metadata.NewProjectNotFoundErr("p1")))
+	require.False(t, isNamespaceNotFound(errors.Internal("namespace not found: ns1")))
+	require.False(t, isNamespaceNotFound(nil))
+}
+
+func TestSearchOutboxStop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &SearchOutboxWorker{
+		cfg:    &config.SearchOutboxConfig{PollInterval: time.Hour},
+		wake:   make(chan struct{}, 1),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	w.Start()
+	w.Stop()
+	require.Error(t, w.ctx.Err())
+
+	// stopping again doesn't block or panic
+	w.Stop()
+}
@@ -61,12 +61,12 @@ func (p *page) hasCapacity() bool {
 
 // readRow should be used to read search data because this is the single point where we unpack search fields, apply
 // filter and then pack the document into bytes.
-func (p *page) readRow() map[string]any {
+func (p *page) readRow() *tsearch.Hit {
 	for p.idx < len(p.hits) {
-		document := p.hits[p.idx].Document
+		hit := p.hits[p.idx]
 		p.idx++
-		if document != nil {
-			return document
+		if hit != nil && hit.Document != nil {
+			return hit
 		}
 	}
 
@@ -166,6 +166,7 @@ type FilterableSearchIterator struct {
 	single     bool
 	last       bool
 	page       *page
+	match      *api.Match
 	filter     *filter.WrappedFilter
 	pageReader *pageReader
 	collection *schema.DefaultCollection
@@ -192,9 +193,12 @@ func (it *FilterableSearchIterator) Next(row *Row) bool {
 			}
 		}
 
-		if doc := it.page.readRow(); doc != nil {
-			var searchKey string
-			if searchKey, row.Data, doc, it.err = UnpackSearchFields(doc, it.collection); it.err != nil {
+		if hit := it.page.readRow(); hit != nil {
+			var (
+				searchKey string
+				doc       map[string]any
+			)
+			if searchKey, row.Data, doc, it.err = UnpackSearchFields(hit.Document, it.collection); it.err != nil {
 				return false
 			}
 			row.Key = []byte(searchKey)
@@ -210,6 +214,7 @@ func (it *FilterableSearchIterator) Next(row *Row) bool {
 				return false
 			}
 			row.Data.RawData = rawData
+			it.match = hit.Match
 			return true
 		}
 
@@ -221,6 +226,11 @@ func (it *FilterableSearchIterator) Next(row *Row) bool {
 	}
 }
 
+// getMatch returns the match information of the last row returned by the iterator.
+func (it *FilterableSearchIterator) getMatch() *api.Match {
+	return it.match
+}
+
 func (it *FilterableSearchIterator) getFacets() map[string]*api.SearchFacet {
 	return it.pageReader.cachedFacets
 }
@@ -101,6 +101,11 @@ func (runner *SearchQueryRunner) ReadOnly(ctx context.Context, tenant *metadata.
 		return Response{}, ctx, err
 	}
 
+	highlight, err := qsearch.BuildHighlight(runner.req.Highlight, collection.GetQueryableField)
+	if err != nil {
+		return Response{}, ctx, err
+	}
+
 	ctx = metrics.UpdateSpanTags(ctx, runner.queryMetrics)
 
 	pageSize := int(runner.req.PageSize)
@@ -118,9 +123,10 @@ func (runner *SearchQueryRunner) ReadOnly(ctx context.Context, tenant *metadata.
 		ReadFields(fieldSelection).
 		SortOrder(sortOrder).
 		VectorSearch(vecSearch).
+		Highlight(highlight).
 		Build()
-	if searchQ.IsQAndVectorBoth() {
-		return Response{}, ctx, errors.InvalidArgument("Currently either full text or vector search is supported")
+	if searchQ.IsQAndVectorBoth() && searchQ.SortOrder != nil {
+		return Response{}, ctx, errors.InvalidArgument("sort is not supported with the hybrid text and vector search")
 	}
 
 	searchReader := NewSearchReader(ctx, runner.searchStore, collection, searchQ)
@@ -151,12 +157,17 @@ func (runner *SearchQueryRunner) ReadOnly(ctx context.Context, tenant *metadata.
 				row.Data.RawData = newValue
 			}
 
+			metadata := &api.SearchHitMeta{
+				CreatedAt: row.Data.CreateToProtoTS(),
+				UpdatedAt: row.Data.UpdatedToProtoTS(),
+			}
+			if searchQ.IsHighlightQuery() || searchQ.IsVectorSearch() {
+				metadata.Match = iterator.getMatch()
+			}
+
 			resp.Hits = append(resp.Hits, &api.SearchHit{
-				Data: row.Data.RawData,
-				Metadata: &api.SearchHitMeta{
-					CreatedAt: row.Data.CreateToProtoTS(),
-					UpdatedAt: row.Data.UpdatedToProtoTS(),
-				},
+				Data:     row.Data.RawData,
+				Metadata: metadata,
 			})
 
 			if len(resp.Hits) == pageSize {
@@ -302,6 +313,7 @@ func (runner *SearchQueryRunner) getVectorSearch(coll *schema.DefaultCollection)
 	if f.Dimensions != nil && *f.Dimensions != len(vectorSearch.VectorV) {
 		return qsearch.VectorSearch{}, errors.InvalidArgument("query vector is not same size as dimensions, expected size: %d", *f.Dimensions)
 	}
+	vectorSearch.Metric = f.Distance
 
 	return vectorSearch, nil
 }
@@ -21,7 +21,9 @@ import (
 	"io"
 	"math/rand"
 	"net/http"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -36,6 +38,7 @@ import (
 	"github.com/tigrisdata/tigris/store/kv"
 	"github.com/tigrisdata/tigris/store/search"
 	ulog "github.com/tigrisdata/tigris/util/log"
+	"google.golang.org/grpc/peer"
 )
 
 const (
@@ -55,6 +58,15 @@ type Session interface {
 	ReadOnlyExecute(ctx context.Context, runner ReadOnlyQueryRunner, req ReqOptions) (Response, error)
 	Execute(ctx context.Context, runner QueryRunner, req ReqOptions) (Response, error)
 	executeWithRetry(ctx context.Context, runner QueryRunner, req ReqOptions) (resp Response, err error)
+
+	// ListSessions returns the explicit transactions open on this node.
+	ListSessions() []SessionInfo
+	// KillSession rolls back the explicit transaction, the next call of the client in this transaction fails
+	// with ErrSessionKilled.
+	KillSession(id string) error
+	// StartIdleReaper starts rolling back the explicit transactions idle longer than the configured timeout, the
+	// returned function stops the reaper.
+	StartIdleReaper(cfg *config.TransactionConfig) func()
 }
 
 type SessionManager struct {
@@ -120,6 +132,18 @@ func (m *SessionManagerWithMetrics) Execute(ctx context.Context, runner QueryRun
 	return
 }
 
+func (m *SessionManagerWithMetrics) ListSessions() []SessionInfo {
+	return m.s.ListSessions()
+}
+
+func (m *SessionManagerWithMetrics) KillSession(id string) error {
+	return m.s.KillSession(id)
+}
+
+func (m *SessionManagerWithMetrics) StartIdleReaper(cfg *config.TransactionConfig) func() {
+	return m.s.StartIdleReaper(cfg)
+}
+
 func (m *SessionManagerWithMetrics) executeWithRetry(ctx context.Context, runner QueryRunner, req ReqOptions) (resp Response, err error) {
 	m.measure(ctx, "executeWithRetry", func(ctx context.Context) error {
 		resp, err = m.s.executeWithRetry(ctx, runner, req)
@@ -245,17 +269,99 @@ func (sessMgr *SessionManager) Create(ctx context.Context, trackVerInOwnTxn bool
 		versionTracker: versionTracker,
 		txListeners:    sessMgr.txListeners,
 		tenantTracker:  sessMgr.tenantTracker,
+		createdAt:      time.Now(),
 	}
+	q.lastActive.Store(q.createdAt.UnixNano())
 	if track {
+		q.origin = sessionOrigin(ctx)
 		sessMgr.tracker.add(txCtx.Id, q)
 	}
 
 	return q, nil
 }
 
+// Get returns the session to commit or roll it back. The session is marked as running, so it isn't rolled back as idle
+// before it is removed.
 func (sessMgr *SessionManager) Get(ctx context.Context) (*QuerySession, error) {
 	txCtx := api.GetTransaction(ctx)
-	return sessMgr.tracker.get(txCtx.GetId()), nil
+	if session := sessMgr.tracker.acquire(txCtx.GetId()); session != nil {
+		return session, nil
+	}
+
+	return nil, sessMgr.tracker.goneErr(txCtx.GetId())
+}
+
+// ListSessions returns the explicit transactions open on this node.
+func (sessMgr *SessionManager) ListSessions() []SessionInfo {
+	sessions := sessMgr.tracker.list()
+
+	infos := make([]SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		infos = append(infos, s.Info())
+	}
+
+	return infos
+}
+
+// KillSession rolls back the explicit transaction. The session is rolled back even if a request is running in it,
+// the request fails on its next operation.
+func (sessMgr *SessionManager) KillSession(id string) error {
+	session := sessMgr.tracker.expire(id, transaction.ErrSessionKilled)
+	if session == nil {
+		return errors.NotFound("transaction '%s' not found", id)
+	}
+
+	log.Info().Str("id", id).Str("namespace", session.tenant.GetNamespace().StrId()).Msg("killing transaction")
+
+	return session.Rollback()
+}
+
+// StartIdleReaper starts the background rollback of the explicit transactions which haven't received requests for
+// longer than the idle timeout, if the timeout is set. The client gets ErrSessionIdleTimeout on its next call in such
+// transaction. The reaper also forgets the rolled back transactions after the retention. The reaper isn't started if
+// the reap interval is not positive. The returned function stops the reaper and waits for it to exit.
+func (sessMgr *SessionManager) StartIdleReaper(cfg *config.TransactionConfig) func() {
+	if cfg.ReapInterval <= 0 {
+		log.Warn().Dur("interval", cfg.ReapInterval).Msg("transaction reaper is disabled, the reap interval is not positive")
+		return func() {}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+		done = make(chan struct{})
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		t := time.NewTicker(cfg.ReapInterval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+			}
+
+			now := time.Now()
+			if cfg.IdleTimeout > 0 {
+				for _, session := range sessMgr.tracker.expireIdle(now, cfg.IdleTimeout) {
+					log.Info().Str("id", session.txCtx.Id).Str("namespace", session.tenant.GetNamespace().StrId()).
+						Dur("idle", now.Sub(session.LastActiveAt())).Msg("rolling back idle transaction")
+					ulog.E(session.Rollback())
+				}
+			}
+			sessMgr.tracker.pruneGone(now, cfg.GoneRetention)
+		}
+	}()
+
+	return func() {
+		once.Do(func() { close(done) })
+		wg.Wait()
+	}
 }
 
 func (sessMgr *SessionManager) Remove(ctx context.Context) error {
@@ -269,10 +375,12 @@ func (sessMgr *SessionManager) Remove(ctx context.Context) error {
 // needs to run without calling Commit/Rollback.
 func (sessMgr *SessionManager) Execute(ctx context.Context, runner QueryRunner, req ReqOptions) (Response, error) {
 	if req.TxCtx != nil {
-		session := sessMgr.tracker.get(req.TxCtx.Id)
+		session := sessMgr.tracker.acquire(req.TxCtx.Id)
 		if session == nil {
-			return Response{}, transaction.ErrSessionIsGone
+			return Response{}, sessMgr.tracker.goneErr(req.TxCtx.Id)
 		}
+		defer session.running.Add(-1)
+
 		resp, ctx, err := session.Run(runner)
 		session.ctx = ctx
 		return resp, CreateApiError(err)
@@ -357,6 +465,48 @@ type QuerySession struct {
 	versionTracker *metadata.Tracker
 	txListeners    []TxListener
 	tenantTracker  *metadata.CacheTracker
+
+	// the fields below are only maintained for the introspection of the explicit transactions
+	createdAt    time.Time
+	origin       string
+	lastActive   atomic.Int64
+	running      atomic.Int32
+	operations   atomic.Int64
+	bytesWritten atomic.Int64
+	seenEvents   atomic.Int64
+}
+
+// SessionInfo describes an explicit transaction open on this node.
+type SessionInfo struct {
+	Id           string
+	Namespace    string
+	Origin       string
+	CreatedAt    time.Time
+	LastActiveAt time.Time
+	Operations   int64
+	BytesWritten int64
+}
+
+func (s *QuerySession) Info() SessionInfo {
+	return SessionInfo{
+		Id:           s.txCtx.Id,
+		Namespace:    s.tenant.GetNamespace().StrId(),
+		Origin:       s.origin,
+		CreatedAt:    s.createdAt,
+		LastActiveAt: s.LastActiveAt(),
+		Operations:   s.operations.Load(),
+		BytesWritten: s.bytesWritten.Load(),
+	}
+}
+
+// LastActiveAt returns the time the last request started or finished in the session.
+func (s *QuerySession) LastActiveAt() time.Time {
+	return time.Unix(0, s.lastActive.Load())
+}
+
+// idle returns true if there is no request running in the session since the given time.
+func (s *QuerySession) idle(since time.Time) bool {
+	return s.running.Load() == 0 && s.LastActiveAt().Before(since)
 }
 
 func (s *QuerySession) GetTx() transaction.Tx {
@@ -368,9 +518,34 @@ func (s *QuerySession) GetTransactionCtx() *api.TransactionCtx {
 }
 
 func (s *QuerySession) Run(runner QueryRunner) (Response, context.Context, error) {
+	s.running.Add(1)
+	s.lastActive.Store(time.Now().UnixNano())
+	defer func() {
+		s.trackWrites()
+		s.lastActive.Store(time.Now().UnixNano())
+		s.running.Add(-1)
+	}()
+
+	s.operations.Add(1)
+
 	return runner.Run(s.ctx, s.tx, s.tenant)
 }
 
+// trackWrites accumulates the size of the documents written by the requests run in the session so far.
+func (s *QuerySession) trackWrites() {
+	events := kv.GetEventListener(s.ctx).GetEvents()
+
+	var written int64
+	for _, e := range events[s.seenEvents.Load():] {
+		if e.Data != nil {
+			written += int64(len(e.Data.RawData))
+		}
+	}
+
+	s.seenEvents.Store(int64(len(events)))
+	s.bytesWritten.Add(written)
+}
+
 func (s *QuerySession) Rollback() error {
 	defer s.cancel()
 
@@ -465,17 +640,106 @@ func (*SessionManager) askMetadataCluster(ctx context.Context, namespaceId strin
 	return listNamespaceResp.Namespaces[0], nil
 }
 
+// sessionOrigin describes who started the session, the subject of the token and the address of the client.
+func sessionOrigin(ctx context.Context) string {
+	var origin []string
+	if sub, err := request.GetCurrentSub(ctx); err == nil && len(sub) > 0 {
+		origin = append(origin, sub)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		origin = append(origin, p.Addr.String())
+	}
+
+	return strings.Join(origin, " from ")
+}
+
 // sessionTracker is used to track sessions.
 type sessionTracker struct {
 	sync.RWMutex
 
 	sessions map[string]*QuerySession
+	// gone keeps the reason of the sessions rolled back by the server to report it on the next call of the client.
+	gone map[string]goneSession
+}
+
+type goneSession struct {
+	err error
+	at  time.Time
 }
 
 func newSessionTracker() *sessionTracker {
 	return &sessionTracker{
 		sessions: make(map[string]*QuerySession),
+		gone:     make(map[string]goneSession),
+	}
+}
+
+func (tracker *sessionTracker) list() []*QuerySession {
+	tracker.RLock()
+	defer tracker.RUnlock()
+
+	sessions := make([]*QuerySession, 0, len(tracker.sessions))
+	for _, s := range tracker.sessions {
+		sessions = append(sessions, s)
+	}
+
+	return sessions
+}
+
+// expire removes the session from the tracker and remembers the reason, the caller is responsible to roll it back.
+func (tracker *sessionTracker) expire(id string, reason error) *QuerySession {
+	tracker.Lock()
+	defer tracker.Unlock()
+
+	session := tracker.sessions[id]
+	if session == nil {
+		return nil
+	}
+
+	delete(tracker.sessions, id)
+	tracker.gone[id] = goneSession{err: reason, at: time.Now()}
+
+	return session
+}
+
+// expireIdle expires the sessions which are idle longer than the timeout and returns them for the rollback.
+func (tracker *sessionTracker) expireIdle(now time.Time, timeout time.Duration) []*QuerySession {
+	tracker.Lock()
+	defer tracker.Unlock()
+
+	var expired []*QuerySession
+	for id, s := range tracker.sessions {
+		if s.idle(now.Add(-timeout)) {
+			delete(tracker.sessions, id)
+			tracker.gone[id] = goneSession{err: transaction.ErrSessionIdleTimeout, at: now}
+			expired = append(expired, s)
+		}
 	}
+
+	return expired
+}
+
+func (tracker *sessionTracker) pruneGone(now time.Time, retention time.Duration) {
+	tracker.Lock()
+	defer tracker.Unlock()
+
+	for id, g := range tracker.gone {
+		if now.Sub(g.at) > retention {
+			delete(tracker.gone, id)
+		}
+	}
+}
+
+// goneErr returns the reason the session was rolled back by the server, or ErrSessionIsGone if it is not known.
+func (tracker *sessionTracker) goneErr(id string) error {
+	tracker.RLock()
+	defer tracker.RUnlock()
+
+	if g, ok := tracker.gone[id]; ok {
+		return g.err
+	}
+
+	return transaction.ErrSessionIsGone
 }
 
 func (tracker *sessionTracker) get(id string) *QuerySession {
@@ -485,6 +749,20 @@ func (tracker *sessionTracker) get(id string) *QuerySession {
 	return tracker.sessions[id]
 }
 
+// acquire returns the session marked as running, the caller is responsible to decrement the running requests. The
+// session is marked under the lock, so it can't be expired as idle between the lookup and the request.
+func (tracker *sessionTracker) acquire(id string) *QuerySession {
+	tracker.RLock()
+	defer tracker.RUnlock()
+
+	session := tracker.sessions[id]
+	if session != nil {
+		session.running.Add(1)
+	}
+
+	return session
+}
+
 func (tracker *sessionTracker) remove(id string) {
 	tracker.Lock()
 	defer tracker.Unlock()
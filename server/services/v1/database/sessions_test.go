@@ -16,8 +16,11 @@ package database
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/transaction"
 )
 
 func TestSessionTracker(t *testing.T) {
@@ -33,3 +36,59 @@ func TestSessionTracker(t *testing.T) {
 	s.add("abc", sess)
 	require.Equal(t, sess, s.get("abc"))
 }
+
+func TestSessionTrackerExpire(t *testing.T) {
+	s := newSessionTracker()
+	now := time.Now()
+
+	idle, active, running := &QuerySession{}, &QuerySession{}, &QuerySession{}
+	idle.lastActive.Store(now.Add(-time.Minute).UnixNano())
+	active.lastActive.Store(now.UnixNano())
+	running.lastActive.Store(now.Add(-time.Minute).UnixNano())
+	running.running.Add(1)
+
+	s.add("idle", idle)
+	s.add("active", active)
+	s.add("running", running)
+	killed := &QuerySession{}
+	killed.lastActive.Store(now.UnixNano())
+	s.add("killed", killed)
+
+	require.Equal(t, []*QuerySession{idle}, s.expireIdle(now, 10*time.Second))
+	require.Nil(t, s.get("idle"))
+	require.Equal(t, active, s.get("active"))
+	require.Equal(t, running, s.get("running"))
+	require.Equal(t, transaction.ErrSessionIdleTimeout, s.goneErr("idle"))
+
+	require.NotNil(t, s.expire("killed", transaction.ErrSessionKilled))
+	require.Nil(t, s.expire("killed", transaction.ErrSessionKilled))
+	require.Equal(t, transaction.ErrSessionKilled, s.goneErr("killed"))
+	require.Len(t, s.list(), 2)
+
+	// unknown sessions are reported as gone
+	require.Equal(t, transaction.ErrSessionIsGone, s.goneErr("abc"))
+
+	s.pruneGone(now.Add(time.Hour), 10*time.Minute)
+	require.Equal(t, transaction.ErrSessionIsGone, s.goneErr("idle"))
+	require.Equal(t, transaction.ErrSessionIsGone, s.goneErr("killed"))
+
+	// the acquired session doesn't expire till it is released
+	require.Equal(t, active, s.acquire("active"))
+	require.Empty(t, s.expireIdle(now.Add(time.Hour), 10*time.Second))
+	active.running.Add(-1)
+	require.Equal(t, []*QuerySession{active}, s.expireIdle(now.Add(time.Hour), 10*time.Second))
+	require.Nil(t, s.acquire("active"))
+}
+
+func TestSessionIdleReaperStop(t *testing.T) {
+	sessMgr := &SessionManager{tracker: newSessionTracker()}
+
+	// the reaper is not started without a positive interval
+	stop := sessMgr.StartIdleReaper(&config.TransactionConfig{})
+	stop()
+
+	stop = sessMgr.StartIdleReaper(&config.TransactionConfig{ReapInterval: time.Millisecond, GoneRetention: time.Minute})
+	time.Sleep(5 * time.Millisecond)
+	stop()
+	stop()
+}
@@ -0,0 +1,156 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+)
+
+const (
+	importStreamPath = fullProjectPath + "/database/collections/{collection}/documents/import_stream"
+
+	// importChunkSize is the size of the data chunks the HTTP request body is split into.
+	importChunkSize = 1024 * 1024
+)
+
+// importStreamHandler streams the raw HTTP request body to the ImportStream API. The import settings are passed in
+// the query parameters:
+//
+//	format=ndjson|csv|parquet
+//	branch=<name>
+//	create_collection=true
+//	primary_key=<field>[,<field>]
+//	autogenerated=<field>[,<field>]
+//	job_id=<id>
+//	csv_delimiter=<char>
+//	csv_column_type=<column>:<type> (can be repeated)
+func importStreamHandler(mux *runtime.ServeMux, client api.TigrisClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, api.ImportStreamMethodName,
+			runtime.WithHTTPPathPattern(apiPathPrefix+importStreamPath))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		resp, err := importStream(ctx, client, r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, r, resp)
+	}
+}
+
+func importStream(ctx context.Context, client api.TigrisClient, r *http.Request) (*api.ImportStreamResponse, error) {
+	req, err := importStreamRequestFromHTTP(r)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := client.ImportStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, importChunkSize)
+	for {
+		n, readErr := io.ReadFull(r.Body, buf)
+		if n > 0 || req != nil {
+			if req == nil {
+				req = &api.ImportStreamRequest{}
+			}
+			req.Data = append([]byte(nil), buf[:n]...)
+
+			if err = stream.Send(req); err == io.EOF {
+				// the server has terminated the stream, the actual error is returned by CloseAndRecv
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			req = nil
+		}
+
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, errors.InvalidArgument("failed to read request body: %s", readErr.Error())
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func importStreamRequestFromHTTP(r *http.Request) (*api.ImportStreamRequest, error) {
+	var err error
+
+	query := r.URL.Query()
+	req := &api.ImportStreamRequest{
+		Project:       chi.URLParam(r, "project"),
+		Collection:    chi.URLParam(r, "collection"),
+		Branch:        query.Get("branch"),
+		Format:        query.Get("format"),
+		JobId:         query.Get("job_id"),
+		CsvDelimiter:  query.Get("csv_delimiter"),
+		PrimaryKey:    splitQueryList(query["primary_key"]),
+		Autogenerated: splitQueryList(query["autogenerated"]),
+	}
+
+	if v := query.Get("create_collection"); v != "" {
+		if req.CreateCollection, err = strconv.ParseBool(v); err != nil {
+			return nil, errors.InvalidArgument("invalid create_collection value '%s'", v)
+		}
+	}
+
+	for _, v := range query["csv_column_type"] {
+		column, typ, found := strings.Cut(v, ":")
+		if !found || column == "" || typ == "" {
+			return nil, errors.InvalidArgument("invalid csv_column_type '%s', expected <column>:<type>", v)
+		}
+		if req.CsvColumnTypes == nil {
+			req.CsvColumnTypes = make(map[string]string)
+		}
+		req.CsvColumnTypes[column] = typ
+	}
+
+	return req, nil
+}
+
+// splitQueryList supports both repeated and comma separated query parameters.
+func splitQueryList(values []string) []string {
+	var res []string
+	for _, v := range values {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				res = append(res, s)
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,63 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func newImportStreamHTTPRequest(query string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/v1/projects/p1/database/collections/c1/documents/import_stream?"+query, nil)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("project", "p1")
+	rctx.URLParams.Add("collection", "c1")
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestImportStreamRequestFromHTTP(t *testing.T) {
+	req, err := importStreamRequestFromHTTP(newImportStreamHTTPRequest(
+		"format=csv&branch=b1&create_collection=true&primary_key=id,name&autogenerated=id&job_id=j1" +
+			"&csv_delimiter=;&csv_column_type=id:integer&csv_column_type=tags:array"))
+	require.NoError(t, err)
+	require.Equal(t, "p1", req.Project)
+	require.Equal(t, "c1", req.Collection)
+	require.Equal(t, "b1", req.Branch)
+	require.Equal(t, "csv", req.Format)
+	require.Equal(t, "j1", req.JobId)
+	require.Equal(t, ";", req.CsvDelimiter)
+	require.True(t, req.CreateCollection)
+	require.Equal(t, []string{"id", "name"}, req.PrimaryKey)
+	require.Equal(t, []string{"id"}, req.Autogenerated)
+	require.Equal(t, map[string]string{"id": "integer", "tags": "array"}, req.CsvColumnTypes)
+
+	_, err = importStreamRequestFromHTTP(newImportStreamHTTPRequest("create_collection=maybe"))
+	require.Error(t, err)
+
+	_, err = importStreamRequestFromHTTP(newImportStreamHTTPRequest("csv_column_type=id"))
+	require.Error(t, err)
+}
+
+func TestSplitQueryList(t *testing.T) {
+	require.Nil(t, splitQueryList(nil))
+	require.Equal(t, []string{"a", "b", "c"}, splitQueryList([]string{"a, b", "", "c,"}))
+}
@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/fullstorydev/grpchan/inprocgrpc"
 	"github.com/go-chi/chi/v5"
@@ -32,14 +34,17 @@ import (
 	"github.com/tigrisdata/tigris/server/metadata"
 	"github.com/tigrisdata/tigris/server/services/v1/auth"
 	"github.com/tigrisdata/tigris/server/services/v1/billing"
+	"github.com/tigrisdata/tigris/server/services/v1/database"
 	"github.com/tigrisdata/tigris/server/transaction"
 	ulog "github.com/tigrisdata/tigris/util/log"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	userPattern   = "/" + version + "/management/*"
 	DeletedStatus = "deleted"
+	KilledStatus  = "killed"
 )
 
 type managementService struct {
@@ -50,11 +55,12 @@ type managementService struct {
 	NamespaceMetadataProvider
 	*transaction.Manager
 	*metadata.TenantManager
+	sessions database.Session
 }
 
 type nsDetailsResp = map[string]map[string]map[string]map[string]string
 
-func newManagementService(authProvider auth.Provider, txMgr *transaction.Manager, tenantMgr *metadata.TenantManager, userStore *metadata.UserSubspace, namespaceStore *metadata.NamespaceSubspace, bProvider billing.Provider) *managementService {
+func newManagementService(authProvider auth.Provider, txMgr *transaction.Manager, tenantMgr *metadata.TenantManager, userStore *metadata.UserSubspace, namespaceStore *metadata.NamespaceSubspace, bProvider billing.Provider, sessions database.Session) *managementService {
 	if authProvider == nil && config.DefaultConfig.Auth.EnableOauth {
 		log.Error().Str("Provider", config.DefaultConfig.Auth.OAuthProvider).Msg("Unable to configure external auth provider")
 		panic("Unable to configure external auth provider")
@@ -77,6 +83,7 @@ func newManagementService(authProvider auth.Provider, txMgr *transaction.Manager
 		BillingProvider:           bProvider,
 		Manager:                   txMgr,
 		TenantManager:             tenantMgr,
+		sessions:                  sessions,
 	}
 }
 
@@ -338,6 +345,49 @@ func (m *managementService) UpdateNamespaceMetadata(ctx context.Context, req *ap
 	return m.NamespaceMetadataProvider.UpdateNamespaceMetadata(ctx, req)
 }
 
+// ListTransactions returns the explicit transactions open on this node, optionally only of the given namespace.
+func (m *managementService) ListTransactions(_ context.Context, req *api.ListTransactionsRequest) (*api.ListTransactionsResponse, error) {
+	sessions := m.sessions.ListSessions()
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.Before(sessions[j].CreatedAt)
+	})
+
+	now := time.Now()
+	resp := &api.ListTransactionsResponse{}
+	for _, s := range sessions {
+		if len(req.GetNamespace()) > 0 && s.Namespace != req.GetNamespace() {
+			continue
+		}
+
+		resp.Transactions = append(resp.Transactions, &api.TransactionInfo{
+			Id:           s.Id,
+			Namespace:    s.Namespace,
+			Origin:       s.Origin,
+			CreatedAt:    timestamppb.New(s.CreatedAt),
+			LastActiveAt: timestamppb.New(s.LastActiveAt),
+			AgeMs:        now.Sub(s.CreatedAt).Milliseconds(),
+			IdleMs:       now.Sub(s.LastActiveAt).Milliseconds(),
+			Operations:   s.Operations,
+			BytesWritten: s.BytesWritten,
+		})
+	}
+
+	return resp, nil
+}
+
+// KillTransaction force rolls back the explicit transaction open on this node.
+func (m *managementService) KillTransaction(_ context.Context, req *api.KillTransactionRequest) (*api.KillTransactionResponse, error) {
+	if len(req.GetId()) == 0 {
+		return nil, errors.InvalidArgument("transaction id is required")
+	}
+
+	if err := m.sessions.KillSession(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &api.KillTransactionResponse{Status: KilledStatus}, nil
+}
+
 func (m *managementService) RegisterHTTP(router chi.Router, inproc *inprocgrpc.Channel) error {
 	mux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &api.CustomMarshaler{JSONBuiltin: &runtime.JSONBuiltin{}}),
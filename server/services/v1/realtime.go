@@ -52,11 +52,15 @@ func newRealtimeService(_ kv.TxStore, _ search.Store, tenantMgr *metadata.Tenant
 	cacheS := cache.NewCache(&config.DefaultConfig.Cache)
 	encoder := metadata.NewCacheEncoder()
 	heartbeatF := realtime.NewHeartbeatFactory(cacheS, encoder)
-	channelFactory := realtime.NewChannelFactory(cacheS, encoder, heartbeatF)
+	channelFactory := realtime.NewChannelFactory(cacheS, encoder, heartbeatF, realtime.NewRetention(&config.DefaultConfig.Cache.Retention))
+	tokenIssuer, err := realtime.NewTokenIssuer(&config.DefaultConfig.Auth)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to configure the realtime token issuer")
+	}
 
 	return &realtimeService{
 		cache:     cacheS,
-		rtmRunner: realtime.NewRTMRunnerFactory(cacheS, channelFactory),
+		rtmRunner: realtime.NewRTMRunnerFactory(cacheS, channelFactory, tokenIssuer),
 		devices:   realtime.NewSessionMgr(cacheS, tenantMgr, txMgr, heartbeatF, channelFactory),
 	}
 }
@@ -193,3 +197,43 @@ func (s *realtimeService) ListSubscriptions(ctx context.Context, req *api.ListSu
 	}
 	return resp.Response.(*api.ListSubscriptionResponse), nil
 }
+
+func (s *realtimeService) UpdateRTChannel(ctx context.Context, req *api.UpdateRTChannelRequest) (*api.UpdateRTChannelResponse, error) {
+	runner := s.rtmRunner.GetChannelRunner()
+	runner.SetUpdateChannelReq(req)
+
+	resp, err := s.devices.ExecuteRunner(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response.(*api.UpdateRTChannelResponse), nil
+}
+
+func (s *realtimeService) History(ctx context.Context, req *api.HistoryRequest) (*api.HistoryResponse, error) {
+	runner := s.rtmRunner.GetHistoryRunner(req)
+	resp, err := s.devices.ExecuteRunner(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response.(*api.HistoryResponse), nil
+}
+
+func (s *realtimeService) GetPresence(ctx context.Context, req *api.GetPresenceRequest) (*api.GetPresenceResponse, error) {
+	runner := s.rtmRunner.GetChannelRunner()
+	runner.SetPresenceReq(req)
+
+	resp, err := s.devices.ExecuteRunner(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response.(*api.GetPresenceResponse), nil
+}
+
+func (s *realtimeService) CreateRTToken(ctx context.Context, req *api.CreateRTTokenRequest) (*api.CreateRTTokenResponse, error) {
+	runner := s.rtmRunner.GetTokenRunner(req)
+	resp, err := s.devices.ExecuteRunner(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Response.(*api.CreateRTTokenResponse), nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/server/request"
+	"github.com/tigrisdata/tigris/server/types"
+)
+
+// The operations a capability allows on a channel.
+const (
+	OpPublish   = "publish"
+	OpSubscribe = "subscribe"
+	OpPresence  = "presence"
+	OpHistory   = "history"
+)
+
+var capabilityOps = map[string]struct{}{
+	OpPublish:   {},
+	OpSubscribe: {},
+	OpPresence:  {},
+	OpHistory:   {},
+}
+
+// Access is the channel access of a client. A nil access is unrestricted, it is the access of the clients
+// authenticated with the project tokens, only the tokens issued for the realtime clients carry capabilities.
+type Access struct {
+	*types.RealtimeAccess
+}
+
+// AccessFromContext returns the access of the token of the request.
+func AccessFromContext(ctx context.Context) *Access {
+	token, err := request.GetAccessToken(ctx)
+	if err != nil || token.Realtime == nil {
+		return nil
+	}
+
+	return &Access{RealtimeAccess: token.Realtime}
+}
+
+// CheckProject returns an error if the token is issued for another project.
+func (a *Access) CheckProject(project string) error {
+	if a == nil || a.Project == project {
+		return nil
+	}
+
+	return errors.PermissionDenied("token is not issued for the project '%s'", project)
+}
+
+// Check returns an error if the operation is not allowed on the channel of the project.
+func (a *Access) Check(project string, channel string, op string) error {
+	if err := a.CheckProject(project); err != nil {
+		return err
+	}
+	if a.Allows(channel, op) {
+		return nil
+	}
+
+	return errors.PermissionDenied("token is not allowed to %s on the channel '%s'", op, channel)
+}
+
+// CheckAny returns an error if no operation is allowed on the channel of the project.
+func (a *Access) CheckAny(project string, channel string) error {
+	if err := a.CheckProject(project); err != nil {
+		return err
+	}
+	if a.Allows(channel, "") {
+		return nil
+	}
+
+	return errors.PermissionDenied("token is not allowed to access the channel '%s'", channel)
+}
+
+// Allows returns whether the operation is allowed on the channel, an empty operation matches any operation.
+func (a *Access) Allows(channel string, op string) bool {
+	if a == nil {
+		return true
+	}
+
+	for _, c := range a.Capabilities {
+		if !matchChannel(c.Channel, channel) {
+			continue
+		}
+		if len(op) == 0 && len(c.Operations) > 0 {
+			return true
+		}
+		for _, o := range c.Operations {
+			if o == op {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Equal returns whether both the accesses are of the same token claim.
+func (a *Access) Equal(other *Access) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+
+	return reflect.DeepEqual(a.RealtimeAccess, other.RealtimeAccess)
+}
+
+// matchChannel matches the channel against the pattern of a capability, "*" matches any channel and a pattern
+// ending with "*" matches the channels with its prefix.
+func matchChannel(pattern string, channel string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(channel, prefix)
+	}
+
+	return pattern == channel
+}
+
+// CapabilitiesFromAPI validates the capabilities of a token request.
+func CapabilitiesFromAPI(capabilities []*api.RTCapability) ([]types.ChannelCapability, error) {
+	if len(capabilities) == 0 {
+		return nil, errors.InvalidArgument("token requires at least one capability")
+	}
+
+	result := make([]types.ChannelCapability, 0, len(capabilities))
+	for _, c := range capabilities {
+		if c == nil || len(c.Channel) == 0 {
+			return nil, errors.InvalidArgument("capability requires a channel")
+		}
+		if strings.Contains(strings.TrimSuffix(c.Channel, "*"), "*") {
+			return nil, errors.InvalidArgument("channel '%s' can only have '*' at the end", c.Channel)
+		}
+		if len(c.Operations) == 0 {
+			return nil, errors.InvalidArgument("capability of the channel '%s' requires operations", c.Channel)
+		}
+		for _, op := range c.Operations {
+			if _, ok := capabilityOps[op]; !ok {
+				return nil, errors.InvalidArgument("unsupported operation '%s' in the capability of the channel '%s'", op, c.Channel)
+			}
+		}
+
+		result = append(result, types.ChannelCapability{
+			Channel:    c.Channel,
+			Operations: c.Operations,
+		})
+	}
+
+	return result, nil
+}
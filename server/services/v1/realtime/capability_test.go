@@ -0,0 +1,96 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/server/types"
+)
+
+func TestAccess(t *testing.T) {
+	var unrestricted *Access
+	require.True(t, unrestricted.Allows("any", OpPublish))
+	require.NoError(t, unrestricted.Check("p1", "any", OpPublish))
+
+	access := &Access{RealtimeAccess: &types.RealtimeAccess{
+		Project: "p1",
+		Capabilities: []types.ChannelCapability{
+			{Channel: "chat:*", Operations: []string{OpPublish, OpSubscribe}},
+			{Channel: "room:123", Operations: []string{OpSubscribe}},
+			{Channel: "*", Operations: []string{OpPresence}},
+		},
+	}}
+
+	cases := []struct {
+		channel string
+		op      string
+		allowed bool
+	}{
+		{"chat:1", OpPublish, true},
+		{"chat:", OpSubscribe, true},
+		{"chat", OpPublish, false},
+		{"chat:1", OpHistory, false},
+		{"room:123", OpSubscribe, true},
+		{"room:123", OpPublish, false},
+		{"room:1234", OpSubscribe, false},
+		{"other", OpPresence, true},
+		{"other", OpSubscribe, false},
+		{"other", "", true},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.allowed, access.Allows(c.channel, c.op), "%s %s", c.channel, c.op)
+	}
+
+	require.NoError(t, access.Check("p1", "chat:1", OpPublish))
+	require.Error(t, access.Check("p1", "room:123", OpPublish))
+	require.Error(t, access.Check("p2", "chat:1", OpPublish))
+	require.NoError(t, access.CheckAny("p1", "room:123"))
+
+	require.True(t, unrestricted.Equal(nil))
+	require.False(t, unrestricted.Equal(access))
+	require.True(t, access.Equal(&Access{RealtimeAccess: &types.RealtimeAccess{
+		Project:      "p1",
+		Capabilities: access.Capabilities,
+	}}))
+
+	access.Capabilities = access.Capabilities[:2]
+	require.Error(t, access.CheckAny("p1", "other"))
+}
+
+func TestCapabilitiesFromAPI(t *testing.T) {
+	capabilities, err := CapabilitiesFromAPI([]*api.RTCapability{
+		{Channel: "chat:*", Operations: []string{OpPublish, OpSubscribe}},
+		{Channel: "room:123", Operations: []string{OpPresence}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChannelCapability{
+		{Channel: "chat:*", Operations: []string{OpPublish, OpSubscribe}},
+		{Channel: "room:123", Operations: []string{OpPresence}},
+	}, capabilities)
+
+	for _, invalid := range [][]*api.RTCapability{
+		nil,
+		{{Channel: "", Operations: []string{OpPublish}}},
+		{{Channel: "chat:*:x", Operations: []string{OpPublish}}},
+		{{Channel: "chat", Operations: nil}},
+		{{Channel: "chat", Operations: []string{"delete"}}},
+	} {
+		_, err = CapabilitiesFromAPI(invalid)
+		require.Error(t, err)
+	}
+}
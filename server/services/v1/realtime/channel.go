@@ -16,7 +16,9 @@ package realtime
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/tigrisdata/tigris/internal"
@@ -26,11 +28,15 @@ import (
 type Channel struct {
 	sync.RWMutex
 
-	encName  string
-	tenant   uint32
-	project  uint32
-	stream   cache.Stream
-	watchers map[string]*ChannelWatcher
+	encName   string
+	name      string
+	tenant    uint32
+	project   uint32
+	stream    cache.Stream
+	presence  *Presence
+	filters   *watcherFilters
+	retention Retention
+	watchers  map[string]*ChannelWatcher
 }
 
 func NewChannel(encName string, stream cache.Stream) *Channel {
@@ -50,11 +56,135 @@ func (ch *Channel) Read(ctx context.Context, pos string) (*cache.StreamMessages,
 }
 
 func (ch *Channel) PublishPresence(ctx context.Context, data *internal.StreamData) (string, error) {
-	return ch.stream.Add(ctx, data)
+	return ch.publish(ctx, data)
 }
 
 func (ch *Channel) PublishMessage(ctx context.Context, data *internal.StreamData) (string, error) {
-	return ch.stream.Add(ctx, data)
+	return ch.publish(ctx, data)
+}
+
+func (ch *Channel) publish(ctx context.Context, data *internal.StreamData) (string, error) {
+	id, err := ch.stream.Add(ctx, data)
+	if err != nil {
+		return "", err
+	}
+
+	// the message is already published, failing to trim only delays the retention until the next trim
+	if _, err := ch.Trim(ctx); err != nil {
+		log.Err(err).Str("channel", ch.encName).Msg("trimming stream failed")
+	}
+
+	return id, nil
+}
+
+func (ch *Channel) Retention() Retention {
+	ch.RLock()
+	defer ch.RUnlock()
+
+	return ch.retention
+}
+
+func (ch *Channel) setRetention(retention Retention) {
+	ch.Lock()
+	defer ch.Unlock()
+
+	ch.retention = retention
+}
+
+// Trim removes the messages exceeding the retention of the channel and returns the number of the messages removed.
+func (ch *Channel) Trim(ctx context.Context) (int64, error) {
+	retention := ch.Retention()
+	if retention.IsEmpty() {
+		return 0, nil
+	}
+
+	return ch.stream.Trim(ctx, retention.trimOptions(time.Now()))
+}
+
+// EnterPresence adds the session to the members of the channel, or updates its data if it is already a member, and
+// publishes the presence event. The event name of the data is either "enter" or "update".
+func (ch *Channel) EnterPresence(ctx context.Context, sessionId string, data *internal.StreamData) error {
+	if err := ch.presence.Set(ctx, sessionId, data); err != nil {
+		return err
+	}
+
+	_, err := ch.PublishPresence(ctx, data)
+	return err
+}
+
+// LeavePresence removes the session from the members of the channel and publishes the leave event with the last data
+// of the member. It is a no-op if the session is not a member.
+func (ch *Channel) LeavePresence(ctx context.Context, sessionId string) error {
+	data, err := ch.presence.Get(ctx, sessionId)
+	if err == cache.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return ch.leavePresence(ctx, sessionId, data)
+}
+
+func (ch *Channel) leavePresence(ctx context.Context, sessionId string, data *internal.StreamData) error {
+	removed, err := ch.presence.Remove(ctx, sessionId)
+	if err != nil || !removed {
+		// already left, the leave event is published by whoever removed it
+		return err
+	}
+
+	md, err := DecodeStreamMD(data.Md)
+	if err != nil {
+		return err
+	}
+
+	leave, err := newStreamData(PresenceChannelData, internal.UserDataEncType(data.Encoding), md.ClientId, md.SocketId, PresenceLeave, data.RawData)
+	if err != nil {
+		return err
+	}
+
+	_, err = ch.PublishPresence(ctx, leave)
+	return err
+}
+
+// PresenceMembers returns the current members of the channel sorted by the session id. The members whose session
+// heartbeat is expired are removed from the channel, and their leave event is published, instead of being returned.
+func (ch *Channel) PresenceMembers(ctx context.Context) ([]*PresenceMember, error) {
+	members, err := ch.presence.Members(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	present := make([]*PresenceMember, 0, len(members))
+	for sessionId, data := range members {
+		alive, err := ch.presence.Alive(ctx, sessionId)
+		if err != nil {
+			return nil, err
+		}
+		if !alive {
+			if err = ch.leavePresence(ctx, sessionId, data); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		md, err := DecodeStreamMD(data.Md)
+		if err != nil {
+			return nil, err
+		}
+
+		present = append(present, &PresenceMember{
+			SessionId: sessionId,
+			ClientId:  md.ClientId,
+			Data:      data,
+		})
+	}
+
+	sort.Slice(present, func(i, j int) bool {
+		return present[i].SessionId < present[j].SessionId
+	})
+
+	return present, nil
 }
 
 func (ch *Channel) getWatcher(watcher string) *ChannelWatcher {
@@ -110,6 +240,10 @@ func (ch *Channel) GetWatcher(ctx context.Context, watcherName string, resume st
 		return nil, err
 	}
 	if exists {
+		if err = ch.restoreFilter(ctx, watcher); err != nil {
+			return nil, err
+		}
+
 		ch.Lock()
 		ch.watchers[watcherName] = watcher
 		ch.Unlock()
@@ -120,6 +254,7 @@ func (ch *Channel) GetWatcher(ctx context.Context, watcherName string, resume st
 	if watcher, err = CreateAndRegisterWatcher(ctx, watcherName, resume, ch.stream); err != nil {
 		return nil, err
 	}
+	watcher.filters = ch.filters
 
 	ch.Lock()
 	ch.watchers[watcherName] = watcher
@@ -128,6 +263,22 @@ func (ch *Channel) GetWatcher(ctx context.Context, watcherName string, resume st
 	return watcher, nil
 }
 
+// restoreFilter restores the filter of a watcher whose consumer group already exists.
+func (ch *Channel) restoreFilter(ctx context.Context, watcher *ChannelWatcher) error {
+	if ch.filters == nil {
+		return nil
+	}
+
+	reqFilter, err := ch.filters.Get(ctx, watcher.name)
+	if err != nil {
+		return err
+	}
+
+	watcher.filters = ch.filters
+	watcher.filter = reqFilter
+	return nil
+}
+
 // DisconnectWatcher ToDo: call it during leave.
 func (ch *Channel) DisconnectWatcher(watcher string) {
 	ch.Lock()
@@ -162,4 +313,10 @@ func (ch *Channel) Close(ctx context.Context) {
 		log.Err(err).Str("channel", ch.encName).Msg("deleting stream failed")
 		return
 	}
+
+	if ch.filters != nil {
+		if err := ch.filters.DeleteAll(ctx); err != nil {
+			log.Err(err).Str("channel", ch.encName).Msg("deleting watcher filters failed")
+		}
+	}
 }
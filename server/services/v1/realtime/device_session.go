@@ -45,6 +45,12 @@ type Session struct {
 	tenant       *metadata.Tenant
 	project      *metadata.Project
 	watchers     map[string]*ChannelWatcher
+	// presenceWatchers are the presence subscriptions of the session keyed by the channel name
+	presenceWatchers map[string]*ChannelWatcher
+	// present are the channels the session has entered the presence of
+	present map[string]*Channel
+	// access is the channel access of the token the session is connected with
+	access *Access
 }
 
 func (s *Sessions) CreateDeviceSession(ctx context.Context, conn *websocket.Conn, params ConnectionParams) (*Session, error) {
@@ -53,6 +59,11 @@ func (s *Sessions) CreateDeviceSession(ctx context.Context, conn *websocket.Conn
 		sessionId = uuid.NewUUIDAsString()
 	}
 
+	access := AccessFromContext(ctx)
+	if err := access.CheckProject(params.ProjectName); err != nil {
+		return nil, err
+	}
+
 	namespaceForThisSession, err := request.GetNamespace(ctx)
 	if err != nil {
 		return nil, err
@@ -82,15 +93,24 @@ func (s *Sessions) CreateDeviceSession(ctx context.Context, conn *websocket.Conn
 		}
 	}
 
+	var clientId string
+	if access != nil {
+		clientId = access.ClientId
+	}
+
 	return &Session{
-		id:        sessionId,
-		conn:      conn,
-		tenant:    tenant,
-		project:   proj,
-		encType:   params.ToEncodingType(),
-		chFactory: s.channelFactory,
-		watchers:  make(map[string]*ChannelWatcher),
-		heartbeat: s.heartbeatFactory.GetHeartbeatTable(tenant.GetNamespace().Id(), proj.Id()),
+		id:               sessionId,
+		clientId:         clientId,
+		conn:             conn,
+		tenant:           tenant,
+		project:          proj,
+		encType:          params.ToEncodingType(),
+		chFactory:        s.channelFactory,
+		watchers:         make(map[string]*ChannelWatcher),
+		presenceWatchers: make(map[string]*ChannelWatcher),
+		present:          make(map[string]*Channel),
+		access:           access,
+		heartbeat:        s.heartbeatFactory.GetHeartbeatTable(tenant.GetNamespace().Id(), proj.Id()),
 	}, nil
 }
 
@@ -98,11 +118,14 @@ func (session *Session) IsActive() bool {
 	return time.Since(session.lastReceived) <= 30*time.Second
 }
 
+// OnPong keeps the heartbeat of an idle session alive, otherwise its presence would expire.
 func (session *Session) OnPong(_ string) error {
+	_ = session.heartbeat.Ping(session.id)
 	return session.sendHeartbeat()
 }
 
 func (session *Session) OnPing(_ string) error {
+	_ = session.heartbeat.Ping(session.id)
 	return session.sendHeartbeat()
 }
 
@@ -126,6 +149,14 @@ func (session *Session) Close() error {
 	for _, w := range session.watchers {
 		w.Disconnect()
 	}
+	for _, w := range session.presenceWatchers {
+		w.Disconnect()
+	}
+	for _, ch := range session.present {
+		if err := ch.LeavePresence(context.TODO(), session.id); err != nil {
+			log.Err(err).Str("channel", ch.name).Msg("leaving presence failed")
+		}
+	}
 
 	return session.conn.Close()
 }
@@ -196,6 +227,9 @@ func (session *Session) handleMessage(ctx context.Context, req *api.RealTimeMess
 		if !ok {
 			return errors.InternalWS("expecting 'attach' event")
 		}
+		if errEvent := session.allow(event.Channel, ""); errEvent != nil {
+			return errEvent
+		}
 
 		// create a channel if it doesn't exist
 		_, err := session.chFactory.GetOrCreateChannel(ctx, session.tenant.GetNamespace().Id(), session.project.Id(), event.Channel)
@@ -213,6 +247,16 @@ func (session *Session) handleMessage(ctx context.Context, req *api.RealTimeMess
 			watcher.Disconnect()
 			delete(session.watchers, event.Channel)
 		}
+		if watcher, ok := session.presenceWatchers[event.Channel]; ok {
+			watcher.Disconnect()
+			delete(session.presenceWatchers, event.Channel)
+		}
+		if ch, ok := session.present[event.Channel]; ok {
+			if err := ch.LeavePresence(ctx, session.id); err != nil {
+				return errors.InternalWS(err.Error())
+			}
+			delete(session.present, event.Channel)
+		}
 		return nil
 	case api.EventType_unsubscribe:
 		event, ok := decoded.(*api.UnsubscribeEvent)
@@ -230,6 +274,9 @@ func (session *Session) handleMessage(ctx context.Context, req *api.RealTimeMess
 		if !ok {
 			return errors.InternalWS("expecting 'subscribe' event")
 		}
+		if errEvent := session.allow(event.Channel, OpSubscribe); errEvent != nil {
+			return errEvent
+		}
 
 		channel, err := session.chFactory.GetChannel(ctx, session.tenant.GetNamespace().Id(), session.project.Id(), event.Channel)
 		if err != nil {
@@ -241,12 +288,42 @@ func (session *Session) handleMessage(ctx context.Context, req *api.RealTimeMess
 			return nil
 		}
 
-		watcher, err := channel.GetWatcher(ctx, session.id, event.Position)
+		// an explicit position takes precedence over the rewind
+		position := event.Position
+		if len(position) == 0 && len(event.Rewind) > 0 {
+			if errEvent := session.allow(event.Channel, OpHistory); errEvent != nil {
+				return errEvent
+			}
+
+			rewind, err := ParseRewind(event.Rewind)
+			if err != nil {
+				return errors.Errorf(errors.CloseUnsupportedData, "%s", err.Error())
+			}
+			if position, err = channel.RewindPosition(ctx, rewind); err != nil {
+				return errors.InternalWS(err.Error())
+			}
+		}
+
+		msgFilter, err := NewMessageFilter(event.Filter)
+		if err != nil {
+			return errors.Errorf(errors.CloseUnsupportedData, "%s", err.Error())
+		}
+
+		watcher, err := channel.GetWatcher(ctx, session.id, position)
 		if err != nil {
 			return nil
 		}
+		// a subscription without a filter keeps the filter of the watcher it resumes
+		if len(event.Filter) > 0 {
+			if err = watcher.SetFilter(ctx, event.Filter); err != nil {
+				return errors.InternalWS(err.Error())
+			}
+		} else if msgFilter, err = NewMessageFilter(watcher.Filter()); err != nil {
+			return errors.InternalWS(err.Error())
+		}
+
 		session.watchers[event.Channel] = watcher
-		watcher.StartWatching(NewDevicePusher(session, event.Channel).Watch)
+		watcher.StartWatching(NewDevicePusher(session, event.Channel, msgFilter).Watch)
 		err = SendReply(session.conn, session.encType, api.EventType_subscribed, &api.SubscribedEvent{
 			Channel: event.Channel,
 		})
@@ -257,6 +334,9 @@ func (session *Session) handleMessage(ctx context.Context, req *api.RealTimeMess
 		if !ok {
 			return errors.InternalWS("expecting message event")
 		}
+		if errEvent := session.allow(event.Channel, OpPublish); errEvent != nil {
+			return errEvent
+		}
 
 		ch, err := session.chFactory.GetChannel(ctx, session.tenant.GetNamespace().Id(), session.project.Id(), event.Channel)
 		if err != nil {
@@ -272,9 +352,12 @@ func (session *Session) handleMessage(ctx context.Context, req *api.RealTimeMess
 		}
 		return nil
 	case api.EventType_presence_member:
-		event, ok := decoded.(*api.MessageEvent)
+		event, ok := decoded.(*api.PresenceMemberEvent)
 		if !ok {
-			return errors.InternalWS("expecting presence event")
+			return errors.InternalWS("expecting presence member event")
+		}
+		if errEvent := session.allow(event.Channel, OpPresence); errEvent != nil {
+			return errEvent
 		}
 
 		ch, err := session.chFactory.GetChannel(ctx, session.tenant.GetNamespace().Id(), session.project.Id(), event.Channel)
@@ -282,19 +365,96 @@ func (session *Session) handleMessage(ctx context.Context, req *api.RealTimeMess
 			return errors.InternalWS(err.Error())
 		}
 
-		streamData, err := NewPresenceData(session.encType, session.clientId, session.socketId, event.Name, event)
-		if err != nil {
-			return errors.InternalWS(err.Error())
+		switch event.Name {
+		case PresenceEnter, PresenceUpdate:
+			// the members are identified by the session so that the presence of a session expires with its heartbeat
+			streamData, err := NewPresenceData(session.encType, session.id, session.socketId, event.Name, event)
+			if err != nil {
+				return errors.InternalWS(err.Error())
+			}
+			if err := ch.EnterPresence(ctx, session.id, streamData); err != nil {
+				return errors.InternalWS(err.Error())
+			}
+			session.present[event.Channel] = ch
+		case PresenceLeave:
+			if err := ch.LeavePresence(ctx, session.id); err != nil {
+				return errors.InternalWS(err.Error())
+			}
+			delete(session.present, event.Channel)
+		default:
+			return errors.Errorf(errors.CloseUnsupportedData, "unsupported presence event '%s'", event.Name)
+		}
+		return nil
+	case api.EventType_presence:
+		event, ok := decoded.(*api.PresenceEvent)
+		if !ok {
+			return errors.InternalWS("expecting presence event")
 		}
-		if _, err := ch.PublishPresence(ctx, streamData); err != nil {
+
+		return session.subscribePresence(ctx, event.Channel)
+	}
+	return nil
+}
+
+// subscribePresence subscribes the session to the presence events of the channel and replies with the current
+// members of the channel. The watcher is registered before the members are read so that no event is missed in
+// between, the events are pushed only after the members are sent. A failed subscription keeps the registered watcher
+// so that it is reused when the session retries.
+func (session *Session) subscribePresence(ctx context.Context, channelName string) *api.ErrorEvent {
+	if errEvent := session.allow(channelName, OpPresence); errEvent != nil {
+		return errEvent
+	}
+	if _, ok := session.presenceWatchers[channelName]; ok {
+		// if already watching ignore
+		return nil
+	}
+
+	channel, err := session.chFactory.GetChannel(ctx, session.tenant.GetNamespace().Id(), session.project.Id(), channelName)
+	if err != nil {
+		return errors.InternalWS(err.Error())
+	}
+
+	watcher, err := channel.GetWatcher(ctx, presenceWatcherName(session.id), "")
+	if err != nil {
+		return errors.InternalWS(err.Error())
+	}
+
+	members, err := channel.PresenceMembers(ctx)
+	if err != nil {
+		return errors.InternalWS(err.Error())
+	}
+
+	event := &api.PresenceEvent{
+		Channel: channelName,
+		Members: make([]*api.PresenceMember, len(members)),
+	}
+	for i, m := range members {
+		if event.Members[i], err = toPresenceMember(session.encType, m); err != nil {
 			return errors.InternalWS(err.Error())
 		}
-	default:
-		// ToDo: presence channel subscribe by adding a different watcher name
 	}
+
+	session.presenceWatchers[channelName] = watcher
+	err = SendReply(session.conn, session.encType, api.EventType_presence, event)
+	log.Err(err).Msgf("failed to send presence message")
+
+	watcher.StartWatching(NewPresencePusher(session, channelName).Watch)
 	return nil
 }
 
+// allow returns an error event if the token of the session doesn't allow the operation on the channel, an empty
+// operation checks the access to the channel.
+func (session *Session) allow(channel string, op string) *api.ErrorEvent {
+	if session.access.Allows(channel, op) {
+		return nil
+	}
+	if len(op) == 0 {
+		return errors.Errorf(errors.ClosePolicyViolation, "not allowed to access the channel '%s'", channel)
+	}
+
+	return errors.Errorf(errors.ClosePolicyViolation, "not allowed to %s on the channel '%s'", op, channel)
+}
+
 func SendReply(conn *websocket.Conn, encType internal.UserDataEncType, eventType api.EventType, event proto.Message) error {
 	encEvent, err := EncodeEvent(encType, event)
 	if err != nil {
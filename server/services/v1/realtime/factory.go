@@ -16,10 +16,13 @@ package realtime
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
+	jsoniter "github.com/json-iterator/go"
 	"github.com/rs/zerolog/log"
+	"github.com/tigrisdata/tigris/internal"
 	"github.com/tigrisdata/tigris/server/metadata"
 	"github.com/tigrisdata/tigris/store/cache"
 )
@@ -33,14 +36,17 @@ type ChannelFactory struct {
 	encoder    metadata.CacheEncoder
 	heartbeatF *HeartbeatFactory
 	channels   map[string]*Channel
+	// retention is applied to the channels that don't set their own
+	retention Retention
 }
 
-func NewChannelFactory(cache cache.Cache, encoder metadata.CacheEncoder, heartbeatF *HeartbeatFactory) *ChannelFactory {
+func NewChannelFactory(cache cache.Cache, encoder metadata.CacheEncoder, heartbeatF *HeartbeatFactory, retention Retention) *ChannelFactory {
 	factory := &ChannelFactory{
 		cache:      cache,
 		encoder:    encoder,
 		heartbeatF: heartbeatF,
 		channels:   make(map[string]*Channel),
+		retention:  retention,
 	}
 
 	go factory.monitorStreams()
@@ -52,11 +58,31 @@ func (factory *ChannelFactory) monitorStreams() {
 	defer ticker.Stop()
 	for range ticker.C {
 		for _, c := range factory.channels {
+			// expire the members first so that a channel with only the expired members is deleted
+			if _, err := c.PresenceMembers(context.TODO()); err != nil {
+				log.Err(err).Str("channel", c.encName).Msg("expiring presence members failed")
+			}
+			factory.trimChannel(c)
 			_ = factory.deleteChannelIfInactive(c)
 		}
 	}
 }
 
+// trimChannel reloads the retention of the channel, as it can be changed by any of the servers, and trims the channel so
+// that the messages of the idle channels expire as well.
+func (factory *ChannelFactory) trimChannel(c *Channel) {
+	retention, err := factory.GetRetention(context.TODO(), c.tenant, c.project, c.name)
+	if err != nil {
+		log.Err(err).Str("channel", c.encName).Msg("reading retention failed")
+		return
+	}
+	c.setRetention(retention)
+
+	if _, err = c.Trim(context.TODO()); err != nil {
+		log.Err(err).Str("channel", c.encName).Msg("trimming stream failed")
+	}
+}
+
 func (factory *ChannelFactory) deleteChannelIfInactive(c *Channel) error {
 	groups, err := c.stream.GetConsumerGroups(context.TODO())
 	if err != nil {
@@ -64,9 +90,19 @@ func (factory *ChannelFactory) deleteChannelIfInactive(c *Channel) error {
 		return err
 	}
 
-	groupsName := make([]string, len(groups))
-	for i, g := range groups {
-		groupsName[i] = g.Name
+	members, err := c.presence.Members(context.TODO())
+	if err != nil {
+		log.Err(err).Str("channel", c.encName).Msg("reading presence members failed")
+		return err
+	}
+
+	// the channel is active as long as any of the subscribed or the present sessions is alive
+	groupsName := make([]string, 0, len(groups)+len(members))
+	for _, g := range groups {
+		groupsName = append(groupsName, watcherSessionId(g.Name))
+	}
+	for sessionId := range members {
+		groupsName = append(groupsName, sessionId)
 	}
 
 	heartbeat := factory.heartbeatF.GetHeartbeatTable(c.tenant, c.project)
@@ -91,7 +127,71 @@ func (factory *ChannelFactory) getOrCreateChannelFromCache(ctx context.Context,
 		return nil, err
 	}
 
-	return NewChannel(encStream, stream), nil
+	return factory.newChannel(ctx, encStream, stream)
+}
+
+// newChannel returns the channel of the stream along with the presence, the watcher filters and the retention of the
+// channel.
+func (factory *ChannelFactory) newChannel(ctx context.Context, encStream string, stream cache.Stream) (*Channel, error) {
+	ch := NewChannel(encStream, stream)
+	ch.tenant, ch.project, ch.name, _ = factory.encoder.DecodeCacheTableName(encStream)
+
+	presenceTableName, _ := factory.encoder.EncodeCacheTableName(ch.tenant, ch.project, presenceTable)
+	ch.presence = NewPresence(factory.cache, presenceTableName, ch.name, factory.heartbeatF.GetHeartbeatTable(ch.tenant, ch.project))
+
+	filterTableName, _ := factory.encoder.EncodeCacheTableName(ch.tenant, ch.project, filterTable)
+	ch.filters = newWatcherFilters(factory.cache, filterTableName, ch.name)
+
+	retention, err := factory.GetRetention(ctx, ch.tenant, ch.project, ch.name)
+	if err != nil {
+		return nil, err
+	}
+	ch.retention = retention
+
+	return ch, nil
+}
+
+// GetRetention returns the retention of the channel, or the default retention if the channel doesn't set its own.
+// The channel doesn't need to exist.
+func (factory *ChannelFactory) GetRetention(ctx context.Context, tenantId uint32, projId uint32, channelName string) (Retention, error) {
+	tableName, _ := factory.encoder.EncodeCacheTableName(tenantId, projId, retentionTable)
+
+	data, err := factory.cache.Get(ctx, tableName, channelName, nil)
+	if err == cache.ErrKeyNotFound {
+		return factory.retention, nil
+	}
+	if err != nil {
+		return Retention{}, err
+	}
+
+	var retention Retention
+	if err = jsoniter.Unmarshal(data.RawData, &retention); err != nil {
+		return Retention{}, err
+	}
+
+	return retention, nil
+}
+
+// SetRetention stores the retention of the channel and trims the channel if it is loaded by this server, the other
+// servers pick up the retention on their next trim.
+func (factory *ChannelFactory) SetRetention(ctx context.Context, tenantId uint32, projId uint32, channelName string, retention Retention) error {
+	tableName, _ := factory.encoder.EncodeCacheTableName(tenantId, projId, retentionTable)
+
+	encoded, err := jsoniter.Marshal(&retention)
+	if err != nil {
+		return err
+	}
+	if err = factory.cache.Set(ctx, tableName, channelName, internal.NewCacheData(encoded), nil); err != nil {
+		return err
+	}
+
+	encStream, _ := factory.encoder.EncodeCacheTableName(tenantId, projId, channelName)
+	if ch, ok := factory.getChannel(encStream); ok {
+		ch.setRetention(retention)
+		_, err = ch.Trim(ctx)
+	}
+
+	return err
 }
 
 func (factory *ChannelFactory) ListChannels(ctx context.Context, tenantId uint32, projId uint32, prefix string) ([]string, error) {
@@ -105,11 +205,16 @@ func (factory *ChannelFactory) ListChannels(ctx context.Context, tenantId uint32
 		return nil, err
 	}
 
-	channelNames := make([]string, len(streams))
-	for i, s := range streams {
+	channelNames := make([]string, 0, len(streams))
+	for _, s := range streams {
+		if strings.Count(s, ":") > 3 {
+			// keys of the internal tables like heartbeat and presence
+			continue
+		}
+
 		_, _, ch, cacheStream := factory.encoder.DecodeCacheTableName(s)
 		if cacheStream {
-			channelNames[i] = ch
+			channelNames = append(channelNames, ch)
 		}
 	}
 
@@ -131,7 +236,10 @@ func (factory *ChannelFactory) GetChannel(ctx context.Context, tenantId uint32,
 		return nil, err
 	}
 
-	ch := NewChannel(encStream, stream)
+	ch, err := factory.newChannel(ctx, encStream, stream)
+	if err != nil {
+		return nil, err
+	}
 
 	factory.Lock()
 	factory.channels[encStream] = ch
@@ -179,7 +287,10 @@ func (factory *ChannelFactory) CreateChannel(ctx context.Context, tenantId uint3
 		return nil, err
 	}
 
-	ch := NewChannel(encStream, stream)
+	ch, err := factory.newChannel(ctx, encStream, stream)
+	if err != nil {
+		return nil, err
+	}
 	factory.channels[ch.encName] = ch
 	return ch, nil
 }
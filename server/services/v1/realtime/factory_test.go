@@ -59,5 +59,5 @@ func TestFactory(t *testing.T) {
 func newFactory(_ *testing.T) *ChannelFactory {
 	cacheS := cache.NewCache(config.GetTestCacheConfig())
 	encoder := metadata.NewCacheEncoder()
-	return NewChannelFactory(cacheS, encoder, NewHeartbeatFactory(cacheS, encoder))
+	return NewChannelFactory(cacheS, encoder, NewHeartbeatFactory(cacheS, encoder), Retention{})
 }
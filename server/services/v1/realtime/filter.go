@@ -0,0 +1,113 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"context"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/query/filter"
+	"github.com/tigrisdata/tigris/store/cache"
+)
+
+const filterTable = "filter"
+
+// NewMessageFilter returns the filter of a subscription. The filter has the syntax of the query filters and is
+// evaluated against a document with the "name" of the message and its payload as "data", so the payload fields are
+// filtered as "data.<field>". An empty filter matches all the messages.
+func NewMessageFilter(reqFilter []byte) (*filter.WrappedFilter, error) {
+	if filter.None(reqFilter) {
+		return nil, nil
+	}
+
+	f, err := filter.NewDynamicFactory().WrappedFilter(reqFilter)
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid filter: %s", err.Error())
+	}
+
+	return f, nil
+}
+
+type filterDoc struct {
+	Name string              `json:"name"`
+	Data jsoniter.RawMessage `json:"data,omitempty"`
+}
+
+// matchMessage returns whether the message passes the filter, the payload is converted to JSON if it is published
+// as msgpack.
+func matchMessage(f *filter.WrappedFilter, md *StreamMessageMD, data *internal.StreamData) (bool, error) {
+	doc := filterDoc{
+		Name: md.EventName,
+	}
+
+	if len(data.RawData) > 0 {
+		raw, err := SanitizeUserData(internal.JsonEncoding, data)
+		if err != nil {
+			return false, err
+		}
+		doc.Data = raw
+	}
+
+	encoded, err := jsoniter.Marshal(&doc)
+	if err != nil {
+		return false, err
+	}
+
+	return f.Matches(encoded, nil), nil
+}
+
+// watcherFilters are the filters of the watchers of a channel. They are stored as a hash under the channel name keyed
+// by the watcher name, next to the consumer groups of the watchers, so a filter is restored with its watcher.
+type watcherFilters struct {
+	cache     cache.Cache
+	tableName string
+	channel   string
+}
+
+func newWatcherFilters(cache cache.Cache, tableName string, channel string) *watcherFilters {
+	return &watcherFilters{
+		cache:     cache,
+		tableName: tableName,
+		channel:   channel,
+	}
+}
+
+// Get returns the filter of the watcher, nil if the watcher doesn't have a filter.
+func (f *watcherFilters) Get(ctx context.Context, watcher string) ([]byte, error) {
+	reqFilter, err := f.cache.HGet(ctx, f.tableName, f.channel, watcher)
+	if err == cache.ErrKeyNotFound {
+		return nil, nil
+	}
+
+	return reqFilter, err
+}
+
+func (f *watcherFilters) Set(ctx context.Context, watcher string, reqFilter []byte) error {
+	_, err := f.cache.HSet(ctx, f.tableName, f.channel, map[string][]byte{watcher: reqFilter})
+	return err
+}
+
+func (f *watcherFilters) Delete(ctx context.Context, watcher string) error {
+	_, err := f.cache.HDel(ctx, f.tableName, f.channel, watcher)
+	return err
+}
+
+// DeleteAll removes the filters of all the watchers of the channel.
+func (f *watcherFilters) DeleteAll(ctx context.Context) error {
+	_, err := f.cache.Delete(ctx, f.tableName, f.channel)
+	return err
+}
@@ -0,0 +1,87 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/internal"
+)
+
+func TestMessageFilter(t *testing.T) {
+	f, err := NewMessageFilter(nil)
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	f, err = NewMessageFilter([]byte(`{}`))
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	_, err = NewMessageFilter([]byte(`{"data.room": {"$unknown": 1}}`))
+	require.Error(t, err)
+
+	f, err = NewMessageFilter([]byte(`{"name": "chat", "data.room": {"$gte": 2}}`))
+	require.NoError(t, err)
+
+	msgpack, err := JsonByteToMsgPack([]byte(`{"room": 2}`))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name  string
+		data  *internal.StreamData
+		match bool
+	}{
+		{"chat", internal.NewStreamData(internal.JsonEncoding, nil, []byte(`{"room": 3}`)), true},
+		{"chat", internal.NewStreamData(internal.JsonEncoding, nil, []byte(`{"room": 1}`)), false},
+		{"typing", internal.NewStreamData(internal.JsonEncoding, nil, []byte(`{"room": 3}`)), false},
+		{"chat", internal.NewStreamData(internal.MsgpackEncoding, nil, msgpack), true},
+		{"chat", internal.NewStreamData(internal.JsonEncoding, nil, nil), false},
+	}
+	for _, c := range cases {
+		matched, err := matchMessage(f, &StreamMessageMD{EventName: c.name}, c.data)
+		require.NoError(t, err)
+		require.Equal(t, c.match, matched, string(c.data.RawData))
+	}
+}
+
+func TestWatcherFilter(t *testing.T) {
+	ctx := context.TODO()
+	factory := newFactory(t)
+
+	channel, err := factory.GetOrCreateChannel(ctx, 1, 1, "filter_test")
+	require.NoError(t, err)
+	defer factory.DeleteChannel(ctx, channel)
+
+	watcher, err := channel.GetWatcher(ctx, "s1", "")
+	require.NoError(t, err)
+	require.Nil(t, watcher.Filter())
+	require.NoError(t, watcher.SetFilter(ctx, []byte(`{"name": "chat"}`)))
+
+	// a new server restores the watcher along with its filter from the cache
+	restored, err := factory.newChannel(ctx, channel.encName, channel.stream)
+	require.NoError(t, err)
+	watcher, err = restored.GetWatcher(ctx, "s1", "")
+	require.NoError(t, err)
+	require.Equal(t, []byte(`{"name": "chat"}`), watcher.Filter())
+
+	// an empty filter removes it
+	require.NoError(t, watcher.SetFilter(ctx, []byte(`{}`)))
+	require.Nil(t, watcher.Filter())
+	reqFilter, err := channel.filters.Get(ctx, "s1")
+	require.NoError(t, err)
+	require.Nil(t, reqFilter)
+}
@@ -91,6 +91,16 @@ func (h *HeartbeatTable) Ping(sessionId string) error {
 	return err
 }
 
+// Alive returns whether the heartbeat of the session is not yet expired.
+func (h *HeartbeatTable) Alive(ctx context.Context, sessionId string) (bool, error) {
+	count, err := h.cache.Exists(ctx, h.tableName, sessionId)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
 func (h *HeartbeatTable) GroupsExpired(groupsName []string) bool {
 	if h.lastHeartbeat.IsZero() {
 		return false
@@ -0,0 +1,207 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"context"
+	"time"
+
+	xredis "github.com/go-redis/redis/v8"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/store/cache"
+)
+
+const (
+	defaultHistoryLimit = 100
+	maxHistoryLimit     = 1000
+	// rewindBatchSize is the number of the stream entries read at once while looking for the rewind position.
+	rewindBatchSize = 100
+)
+
+// ChannelMessage is a message read from the channel history.
+type ChannelMessage struct {
+	Id   string
+	Md   *StreamMessageMD
+	Data *internal.StreamData
+}
+
+// HistoryQuery selects a page of the channel messages. The messages are between the inclusive From and To stream
+// IDs, and after the Cursor, which is the ID of the last message of the previous page, in the order of the query.
+type HistoryQuery struct {
+	From    string
+	To      string
+	Cursor  string
+	Limit   int64
+	Reverse bool
+}
+
+func NewHistoryQuery(req *api.HistoryRequest) (*HistoryQuery, error) {
+	query := &HistoryQuery{
+		From:    "-",
+		To:      "+",
+		Cursor:  req.GetCursor(),
+		Limit:   req.GetLimit(),
+		Reverse: req.GetReverse(),
+	}
+
+	switch {
+	case query.Limit < 0:
+		return nil, errors.InvalidArgument("limit can't be negative")
+	case query.Limit == 0:
+		query.Limit = defaultHistoryLimit
+	case query.Limit > maxHistoryLimit:
+		query.Limit = maxHistoryLimit
+	}
+
+	if req.GetStartTime() != nil {
+		query.From = cache.TimeStreamID(req.GetStartTime().AsTime())
+	}
+	if req.GetEndTime() != nil {
+		// the end time is exclusive, so the end is the last ID of the previous millisecond
+		end, err := cache.PrevStreamID(cache.TimeStreamID(req.GetEndTime().AsTime()))
+		if err != nil {
+			return nil, err
+		}
+		query.To = end
+	}
+	if req.GetStartTime() != nil && req.GetEndTime() != nil && !req.GetStartTime().AsTime().Before(req.GetEndTime().AsTime()) {
+		return nil, errors.InvalidArgument("start time should be before the end time")
+	}
+
+	return query, nil
+}
+
+// History returns a page of the messages of the channel, the presence events are skipped. The returned cursor is the
+// ID of the last message of the page, it is empty once there are no more messages.
+func (ch *Channel) History(ctx context.Context, query *HistoryQuery) ([]*ChannelMessage, string, error) {
+	from, to := query.From, query.To
+	if len(query.Cursor) > 0 {
+		var err error
+		if query.Reverse {
+			to, err = cache.PrevStreamID(query.Cursor)
+		} else {
+			from, err = cache.NextStreamID(query.Cursor)
+		}
+		if err != nil {
+			return nil, "", errors.InvalidArgument("invalid cursor '%s'", query.Cursor)
+		}
+	}
+
+	var page []*ChannelMessage
+	for {
+		var (
+			entries *cache.StreamMessages
+			err     error
+		)
+		if query.Reverse {
+			entries, err = ch.stream.RevRange(ctx, to, from, query.Limit)
+		} else {
+			entries, err = ch.stream.Range(ctx, from, to, query.Limit)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, m := range entries.Messages {
+			message, err := decodeChannelMessage(entries, m)
+			if err != nil {
+				return nil, "", err
+			}
+			if message.Md.DataType != MessageChannelData {
+				continue
+			}
+
+			if page = append(page, message); int64(len(page)) == query.Limit {
+				return page, message.Id, nil
+			}
+		}
+
+		if int64(len(entries.Messages)) < query.Limit {
+			// the range is exhausted
+			return page, "", nil
+		}
+
+		// the page is not full because of the presence events, continue after the last entry read
+		last := entries.Messages[len(entries.Messages)-1].ID
+		if query.Reverse {
+			to, err = cache.PrevStreamID(last)
+		} else {
+			from, err = cache.NextStreamID(last)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+	}
+}
+
+// RewindPosition returns the position of a new watcher so that the watcher is delivered the messages of the rewind
+// before the new messages.
+func (ch *Channel) RewindPosition(ctx context.Context, rewind *Rewind) (string, error) {
+	if rewind.Count == 0 {
+		return cache.PrevStreamID(cache.TimeStreamID(time.Now().Add(-rewind.Duration)))
+	}
+
+	remaining := rewind.Count
+	end := "+"
+	for {
+		entries, err := ch.stream.RevRange(ctx, end, "-", rewindBatchSize)
+		if err != nil {
+			return "", err
+		}
+
+		for _, m := range entries.Messages {
+			message, err := decodeChannelMessage(entries, m)
+			if err != nil {
+				return "", err
+			}
+			if message.Md.DataType != MessageChannelData {
+				continue
+			}
+
+			if remaining--; remaining == 0 {
+				return cache.PrevStreamID(message.Id)
+			}
+		}
+
+		if len(entries.Messages) < rewindBatchSize {
+			// the channel has fewer messages than the rewind, deliver all of them
+			return "0-0", nil
+		}
+
+		if end, err = cache.PrevStreamID(entries.Messages[len(entries.Messages)-1].ID); err != nil {
+			return "", err
+		}
+	}
+}
+
+func decodeChannelMessage(entries *cache.StreamMessages, m xredis.XMessage) (*ChannelMessage, error) {
+	data, err := entries.Decode(m)
+	if err != nil {
+		return nil, err
+	}
+
+	md, err := DecodeStreamMD(data.Md)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChannelMessage{
+		Id:   m.ID,
+		Md:   md,
+		Data: data,
+	}, nil
+}
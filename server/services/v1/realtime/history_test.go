@@ -0,0 +1,132 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/internal"
+)
+
+func TestHistory(t *testing.T) {
+	ctx := context.TODO()
+	factory := newFactory(t)
+
+	channel, err := factory.GetOrCreateChannel(ctx, 1, 1, "history_test")
+	require.NoError(t, err)
+	defer factory.DeleteChannel(ctx, channel)
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		data, err := NewMessageData(internal.JsonEncoding, "", "", fmt.Sprintf("m%d", i), &api.MessageEvent{Data: []byte(`{}`)})
+		require.NoError(t, err)
+		id, err := channel.PublishMessage(ctx, data)
+		require.NoError(t, err)
+		ids = append(ids, id)
+
+		// the presence events are interleaved with the messages and skipped by the history
+		presence, err := NewPresenceData(internal.JsonEncoding, "s1", "", PresenceUpdate, &api.PresenceMemberEvent{})
+		require.NoError(t, err)
+		_, err = channel.PublishPresence(ctx, presence)
+		require.NoError(t, err)
+	}
+
+	page := func(query *HistoryQuery) ([]string, string) {
+		messages, cursor, err := channel.History(ctx, query)
+		require.NoError(t, err)
+
+		var out []string
+		for _, m := range messages {
+			out = append(out, m.Id)
+		}
+		return out, cursor
+	}
+
+	t.Run("forward", func(t *testing.T) {
+		query := &HistoryQuery{From: "-", To: "+", Limit: 2}
+		messages, cursor := page(query)
+		require.Equal(t, ids[0:2], messages)
+		require.Equal(t, ids[1], cursor)
+
+		query.Cursor = cursor
+		messages, cursor = page(query)
+		require.Equal(t, ids[2:4], messages)
+
+		query.Cursor = cursor
+		messages, cursor = page(query)
+		require.Equal(t, ids[4:], messages)
+		require.Empty(t, cursor)
+	})
+	t.Run("reverse", func(t *testing.T) {
+		query := &HistoryQuery{From: "-", To: "+", Limit: 3, Reverse: true}
+		messages, cursor := page(query)
+		require.Equal(t, []string{ids[4], ids[3], ids[2]}, messages)
+
+		query.Cursor = cursor
+		messages, cursor = page(query)
+		require.Equal(t, []string{ids[1], ids[0]}, messages)
+		require.Empty(t, cursor)
+	})
+	t.Run("range", func(t *testing.T) {
+		messages, _ := page(&HistoryQuery{From: ids[1], To: ids[3], Limit: 10})
+		require.Equal(t, ids[1:4], messages)
+	})
+	t.Run("rewind", func(t *testing.T) {
+		position, err := channel.RewindPosition(ctx, &Rewind{Count: 2})
+		require.NoError(t, err)
+		messages, _ := page(&HistoryQuery{From: "-", To: "+", Cursor: position, Limit: 10})
+		require.Equal(t, ids[3:], messages)
+
+		position, err = channel.RewindPosition(ctx, &Rewind{Count: 10})
+		require.NoError(t, err)
+		require.Equal(t, "0-0", position)
+
+		position, err = channel.RewindPosition(ctx, &Rewind{Duration: time.Hour})
+		require.NoError(t, err)
+		messages, _ = page(&HistoryQuery{From: "-", To: "+", Cursor: position, Limit: 10})
+		require.Equal(t, ids, messages)
+	})
+	t.Run("retention", func(t *testing.T) {
+		require.NoError(t, factory.SetRetention(ctx, 1, 1, "history_test", Retention{MaxMessages: 4}))
+
+		retention, err := factory.GetRetention(ctx, 1, 1, "history_test")
+		require.NoError(t, err)
+		require.Equal(t, Retention{MaxMessages: 4}, retention)
+
+		// the last four entries are two messages and two presence events
+		messages, _ := page(&HistoryQuery{From: "-", To: "+", Limit: 10})
+		require.Equal(t, ids[3:], messages)
+	})
+}
+
+func TestParseRewind(t *testing.T) {
+	rewind, err := ParseRewind("10")
+	require.NoError(t, err)
+	require.Equal(t, &Rewind{Count: 10}, rewind)
+
+	rewind, err = ParseRewind("2m")
+	require.NoError(t, err)
+	require.Equal(t, &Rewind{Duration: 2 * time.Minute}, rewind)
+
+	for _, invalid := range []string{"0", "-1", "-5s", "abc"} {
+		_, err = ParseRewind(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"context"
+	"strings"
+
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/store/cache"
+)
+
+const (
+	presenceTable = "presence"
+	// presenceWatcherSuffix is appended to the session id to name the watcher of the presence events, so that the
+	// presence and the message subscriptions of a session are separate consumer groups on the channel stream.
+	presenceWatcherSuffix = "#presence"
+)
+
+// The names of the presence events.
+const (
+	PresenceEnter  = "enter"
+	PresenceUpdate = "update"
+	PresenceLeave  = "leave"
+)
+
+// Presence is the set of the members of a channel. The members are stored as a hash under the channel name keyed by
+// the session id, so the members are shared by all the servers. The value of a member is the stream data of its last
+// presence event which carries the data of the member in the encoding of its session.
+type Presence struct {
+	cache     cache.Cache
+	tableName string
+	channel   string
+	heartbeat *HeartbeatTable
+}
+
+func NewPresence(cache cache.Cache, tableName string, channel string, heartbeat *HeartbeatTable) *Presence {
+	return &Presence{
+		cache:     cache,
+		tableName: tableName,
+		channel:   channel,
+		heartbeat: heartbeat,
+	}
+}
+
+// PresenceMember is a member of the channel with its last presence data.
+type PresenceMember struct {
+	SessionId string
+	ClientId  string
+	Data      *internal.StreamData
+}
+
+func (p *Presence) Set(ctx context.Context, sessionId string, data *internal.StreamData) error {
+	encoded, err := internal.EncodeStreamData(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.cache.HSet(ctx, p.tableName, p.channel, map[string][]byte{sessionId: encoded})
+	return err
+}
+
+// Get returns the data of the member, cache.ErrKeyNotFound is returned if the session is not a member.
+func (p *Presence) Get(ctx context.Context, sessionId string) (*internal.StreamData, error) {
+	encoded, err := p.cache.HGet(ctx, p.tableName, p.channel, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return internal.DecodeStreamData(encoded)
+}
+
+// Remove removes the member and returns whether it was removed by this call, so that only one of the servers
+// publishes the leave event of an expired member.
+func (p *Presence) Remove(ctx context.Context, sessionId string) (bool, error) {
+	deleted, err := p.cache.HDel(ctx, p.tableName, p.channel, sessionId)
+	if err != nil {
+		return false, err
+	}
+
+	return deleted > 0, nil
+}
+
+// Members returns the data of all the members keyed by the session id.
+func (p *Presence) Members(ctx context.Context) (map[string]*internal.StreamData, error) {
+	fields, err := p.cache.HGetAll(ctx, p.tableName, p.channel)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]*internal.StreamData, len(fields))
+	for sessionId, encoded := range fields {
+		if members[sessionId], err = internal.DecodeStreamData(encoded); err != nil {
+			return nil, err
+		}
+	}
+
+	return members, nil
+}
+
+// Alive returns whether the heartbeat of the member session is not yet expired.
+func (p *Presence) Alive(ctx context.Context, sessionId string) (bool, error) {
+	return p.heartbeat.Alive(ctx, sessionId)
+}
+
+func presenceWatcherName(sessionId string) string {
+	return sessionId + presenceWatcherSuffix
+}
+
+// watcherSessionId returns the session id of the watcher, it is the inverse of presenceWatcherName for the presence
+// watchers and the watcher name itself for the message watchers.
+func watcherSessionId(watcherName string) string {
+	return strings.TrimSuffix(watcherName, presenceWatcherSuffix)
+}
+
+// sanitizePresenceData converts the data of the member to the encoding of the reader, a member may not have any data.
+func sanitizePresenceData(toEnc internal.UserDataEncType, data *internal.StreamData) ([]byte, error) {
+	if len(data.RawData) == 0 {
+		return nil, nil
+	}
+
+	return SanitizeUserData(toEnc, data)
+}
+
+func toPresenceMember(toEnc internal.UserDataEncType, member *PresenceMember) (*api.PresenceMember, error) {
+	data, err := sanitizePresenceData(toEnc, member.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.PresenceMember{
+		ClientId: member.ClientId,
+		Data:     data,
+	}, nil
+}
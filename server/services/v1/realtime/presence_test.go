@@ -0,0 +1,110 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/store/cache"
+)
+
+func TestPresence(t *testing.T) {
+	ctx := context.TODO()
+	factory := newFactory(t)
+
+	channel, err := factory.GetOrCreateChannel(ctx, 1, 1, "presence_test")
+	require.NoError(t, err)
+	defer factory.DeleteChannel(ctx, channel)
+
+	heartbeatTableName, err := factory.encoder.EncodeCacheTableName(1, 1, heartbeatTable)
+	require.NoError(t, err)
+	ping := func(sessionId string) {
+		require.NoError(t, NewHeartbeat(factory.cache, heartbeatTableName).Ping(sessionId))
+	}
+	enter := func(sessionId string, eventName string, data string) {
+		streamData, err := NewPresenceData(internal.JsonEncoding, sessionId, "", eventName, &api.PresenceMemberEvent{
+			Data: []byte(data),
+		})
+		require.NoError(t, err)
+		require.NoError(t, channel.EnterPresence(ctx, sessionId, streamData))
+	}
+	members := func() map[string]string {
+		present, err := channel.PresenceMembers(ctx)
+		require.NoError(t, err)
+
+		m := make(map[string]string)
+		for _, p := range present {
+			member, err := toPresenceMember(internal.JsonEncoding, p)
+			require.NoError(t, err)
+			m[member.ClientId] = string(member.Data)
+		}
+		return m
+	}
+
+	ping("s1")
+	ping("s2")
+
+	t.Run("enter_update", func(t *testing.T) {
+		enter("s1", PresenceEnter, `{"status":"online"}`)
+		enter("s2", PresenceEnter, `{"status":"away"}`)
+		require.Equal(t, map[string]string{"s1": `{"status":"online"}`, "s2": `{"status":"away"}`}, members())
+
+		enter("s1", PresenceUpdate, `{"status":"busy"}`)
+		require.Equal(t, map[string]string{"s1": `{"status":"busy"}`, "s2": `{"status":"away"}`}, members())
+	})
+	t.Run("leave", func(t *testing.T) {
+		require.NoError(t, channel.LeavePresence(ctx, "s2"))
+		require.Equal(t, map[string]string{"s1": `{"status":"busy"}`}, members())
+
+		// leaving again is a no-op
+		require.NoError(t, channel.LeavePresence(ctx, "s2"))
+	})
+	t.Run("expired_heartbeat", func(t *testing.T) {
+		// s3 never pinged, so it is treated as an expired session
+		enter("s3", PresenceEnter, "")
+		require.Equal(t, map[string]string{"s1": `{"status":"busy"}`}, members())
+
+		_, err := channel.presence.Get(ctx, "s3")
+		require.Equal(t, cache.ErrKeyNotFound, err)
+	})
+	t.Run("events", func(t *testing.T) {
+		messages, exists, err := channel.Read(ctx, "0")
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		var events []string
+		for _, m := range messages.Messages {
+			data, err := messages.Decode(m)
+			require.NoError(t, err)
+			md, err := DecodeStreamMD(data.Md)
+			require.NoError(t, err)
+			require.Equal(t, PresenceChannelData, md.DataType)
+
+			events = append(events, md.ClientId+":"+md.EventName)
+		}
+		require.Equal(t, []string{
+			"s1:enter", "s2:enter", "s1:update", "s2:leave", "s3:enter", "s3:leave",
+		}, events)
+	})
+}
+
+func TestWatcherSessionId(t *testing.T) {
+	require.Equal(t, "s1", watcherSessionId("s1"))
+	require.Equal(t, "s1", watcherSessionId(presenceWatcherName("s1")))
+}
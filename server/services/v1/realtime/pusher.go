@@ -19,20 +19,36 @@ import (
 	"github.com/rs/zerolog/log"
 	api "github.com/tigrisdata/tigris/api/server/v1"
 	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/query/filter"
 	"github.com/tigrisdata/tigris/store/cache"
 )
 
+// DevicePusher pushes the events of a channel to a device. Messages and presence events share the channel stream, a
+// pusher only pushes the events of its data type. The messages not matching the filter of the subscription are not
+// pushed.
 type DevicePusher struct {
 	channel    string
+	dataType   string
+	filter     *filter.WrappedFilter
 	sessionId  string
 	socketId   string
 	encType    internal.UserDataEncType
 	connection *websocket.Conn
 }
 
-func NewDevicePusher(session *Session, channel string) *DevicePusher {
+func NewDevicePusher(session *Session, channel string, filter *filter.WrappedFilter) *DevicePusher {
+	return newDevicePusher(session, channel, MessageChannelData, filter)
+}
+
+func NewPresencePusher(session *Session, channel string) *DevicePusher {
+	return newDevicePusher(session, channel, PresenceChannelData, nil)
+}
+
+func newDevicePusher(session *Session, channel string, dataType string, filter *filter.WrappedFilter) *DevicePusher {
 	return &DevicePusher{
 		channel:    channel,
+		dataType:   dataType,
+		filter:     filter,
 		sessionId:  session.id,
 		socketId:   session.socketId,
 		encType:    session.encType,
@@ -57,15 +73,39 @@ func (pusher *DevicePusher) Watch(events *cache.StreamMessages, err error) ([]st
 			continue
 		}
 		processed[i] = m.ID
+		if md.DataType != pusher.dataType {
+			continue
+		}
+		if md.DataType == PresenceChannelData {
+			pusher.sendPresence(md, data)
+			continue
+		}
 		if md.ClientId == pusher.sessionId {
 			continue
 		}
+		if !pusher.matches(md, data) {
+			continue
+		}
 		pusher.sendMessage(m.ID, md, data)
 	}
 
 	return processed, nil
 }
 
+func (pusher *DevicePusher) matches(md *StreamMessageMD, data *internal.StreamData) bool {
+	if pusher.filter == nil || pusher.filter.None() {
+		return true
+	}
+
+	matched, err := matchMessage(pusher.filter, md, data)
+	if err != nil {
+		log.Err(err).Msgf("filtering message failed")
+		return false
+	}
+
+	return matched
+}
+
 func (pusher *DevicePusher) sendMessage(msgId string, md *StreamMessageMD, data *internal.StreamData) {
 	rawData, err := SanitizeUserData(pusher.encType, data)
 	if err != nil {
@@ -83,3 +123,21 @@ func (pusher *DevicePusher) sendMessage(msgId string, md *StreamMessageMD, data
 	err = SendReply(pusher.connection, pusher.encType, api.EventType_message, message)
 	log.Err(err).Msgf("failed to push message")
 }
+
+func (pusher *DevicePusher) sendPresence(md *StreamMessageMD, data *internal.StreamData) {
+	rawData, err := sanitizePresenceData(pusher.encType, data)
+	if err != nil {
+		log.Err(err).Msgf("sanitizing presence data failed")
+		return
+	}
+
+	event := &api.PresenceMemberEvent{
+		Channel:  pusher.channel,
+		Name:     md.EventName,
+		ClientId: md.ClientId,
+		Data:     rawData,
+	}
+
+	err = SendReply(pusher.connection, pusher.encType, api.EventType_presence_member, event)
+	log.Err(err).Msgf("failed to push presence")
+}
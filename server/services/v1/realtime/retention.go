@@ -0,0 +1,107 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/store/cache"
+)
+
+const retentionTable = "retention"
+
+// Retention limits the messages kept in the channel stream. The oldest messages are trimmed once a limit is exceeded,
+// the channel is trimmed on every publish and periodically so that the idle channels expire their messages as well.
+// A zero limit is disabled.
+type Retention struct {
+	MaxMessages int64         `json:"max_messages,omitempty"`
+	MaxAge      time.Duration `json:"max_age,omitempty"`
+}
+
+func NewRetention(cfg *config.StreamRetentionConfig) Retention {
+	return Retention{
+		MaxMessages: cfg.MaxMessages,
+		MaxAge:      cfg.MaxAge,
+	}
+}
+
+func RetentionFromAPI(retention *api.ChannelRetention) (Retention, error) {
+	if retention == nil {
+		return Retention{}, errors.InvalidArgument("retention is required")
+	}
+	if retention.GetMaxMessages() < 0 || retention.GetMaxAgeSeconds() < 0 {
+		return Retention{}, errors.InvalidArgument("retention limits can't be negative")
+	}
+
+	return Retention{
+		MaxMessages: retention.GetMaxMessages(),
+		MaxAge:      time.Duration(retention.GetMaxAgeSeconds()) * time.Second,
+	}, nil
+}
+
+func (r Retention) ToAPI() *api.ChannelRetention {
+	return &api.ChannelRetention{
+		MaxMessages:   r.MaxMessages,
+		MaxAgeSeconds: int64(r.MaxAge / time.Second),
+	}
+}
+
+func (r Retention) IsEmpty() bool {
+	return r.MaxMessages == 0 && r.MaxAge == 0
+}
+
+func (r Retention) trimOptions(now time.Time) *cache.TrimOptions {
+	options := &cache.TrimOptions{
+		MaxLen: r.MaxMessages,
+	}
+	if r.MaxAge > 0 {
+		options.MinID = cache.TimeStreamID(now.Add(-r.MaxAge))
+	}
+
+	return options
+}
+
+// Rewind is the history delivered to a new subscription before the new messages, either the last Count messages or
+// the messages published in the last Duration.
+type Rewind struct {
+	Count    int64
+	Duration time.Duration
+}
+
+// ParseRewind parses the rewind of a subscription, a number is the count of the messages, otherwise it is a duration
+// like "30s" or "5m".
+func ParseRewind(rewind string) (*Rewind, error) {
+	if count, err := strconv.ParseInt(rewind, 10, 64); err == nil {
+		if count <= 0 {
+			return nil, fmt.Errorf("rewind count should be greater than zero")
+		}
+		return &Rewind{Count: count}, nil
+	}
+
+	duration, err := time.ParseDuration(rewind)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rewind '%s', expecting the count of the messages or a duration", rewind)
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("rewind duration should be greater than zero")
+	}
+
+	return &Rewind{Duration: duration}, nil
+}
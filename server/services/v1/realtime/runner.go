@@ -19,11 +19,13 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	api "github.com/tigrisdata/tigris/api/server/v1"
 	"github.com/tigrisdata/tigris/errors"
 	"github.com/tigrisdata/tigris/internal"
 	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/types"
 	"github.com/tigrisdata/tigris/store/cache"
 )
 
@@ -35,13 +37,15 @@ type RTMRunner interface {
 type RTMRunnerFactory struct {
 	cache   cache.Cache
 	factory *ChannelFactory
+	issuer  *TokenIssuer
 }
 
 // NewRTMRunnerFactory returns RTMRunnerFactory object.
-func NewRTMRunnerFactory(cache cache.Cache, factory *ChannelFactory) *RTMRunnerFactory {
+func NewRTMRunnerFactory(cache cache.Cache, factory *ChannelFactory, issuer *TokenIssuer) *RTMRunnerFactory {
 	return &RTMRunnerFactory{
 		cache:   cache,
 		factory: factory,
+		issuer:  issuer,
 	}
 }
 
@@ -60,6 +64,21 @@ func (f *RTMRunnerFactory) GetReadMessagesRunner(r *api.ReadMessagesRequest, str
 	}
 }
 
+func (f *RTMRunnerFactory) GetHistoryRunner(r *api.HistoryRequest) *HistoryRunner {
+	return &HistoryRunner{
+		baseRunner: newBaseRunner(f.cache, f.factory),
+		req:        r,
+	}
+}
+
+func (f *RTMRunnerFactory) GetTokenRunner(r *api.CreateRTTokenRequest) *TokenRunner {
+	return &TokenRunner{
+		baseRunner: newBaseRunner(f.cache, f.factory),
+		issuer:     f.issuer,
+		req:        r,
+	}
+}
+
 func (f *RTMRunnerFactory) GetChannelRunner() *ChannelRunner {
 	return &ChannelRunner{
 		baseRunner: newBaseRunner(f.cache, f.factory),
@@ -94,6 +113,10 @@ type MessagesRunner struct {
 }
 
 func (runner *MessagesRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	if err := AccessFromContext(ctx).Check(runner.req.Project, runner.req.Channel, OpPublish); err != nil {
+		return Response{}, err
+	}
+
 	project, err := runner.getProject(tenant, runner.req.Project)
 	if err != nil {
 		return Response{}, err
@@ -141,6 +164,10 @@ type ReadMessagesRunner struct {
 }
 
 func (runner *ReadMessagesRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	if err := AccessFromContext(ctx).Check(runner.req.Project, runner.req.Channel, OpSubscribe); err != nil {
+		return Response{}, err
+	}
+
 	project, err := runner.getProject(tenant, runner.req.Project)
 	if err != nil {
 		return Response{}, err
@@ -177,6 +204,11 @@ func (runner *ReadMessagesRunner) Run(ctx context.Context, tenant *metadata.Tena
 			if err != nil {
 				return Response{}, err
 			}
+			if md.DataType == PresenceChannelData {
+				id = m.ID
+				continue
+			}
+
 			rawData, err := SanitizeUserData(internal.JsonEncoding, data)
 			if err != nil {
 				return Response{}, err
@@ -209,12 +241,103 @@ func (runner *ReadMessagesRunner) Run(ctx context.Context, tenant *metadata.Tena
 	}
 }
 
+// HistoryRunner is to read a page of the messages of a channel.
+type HistoryRunner struct {
+	*baseRunner
+
+	req *api.HistoryRequest
+}
+
+func (runner *HistoryRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	if err := AccessFromContext(ctx).Check(runner.req.Project, runner.req.Channel, OpHistory); err != nil {
+		return Response{}, err
+	}
+
+	project, err := runner.getProject(tenant, runner.req.Project)
+	if err != nil {
+		return Response{}, err
+	}
+
+	query, err := NewHistoryQuery(runner.req)
+	if err != nil {
+		return Response{}, err
+	}
+
+	channel, err := runner.factory.GetChannel(ctx, tenant.GetNamespace().Id(), project.Id(), runner.req.Channel)
+	if err != nil {
+		return Response{}, err
+	}
+
+	messages, cursor, err := channel.History(ctx, query)
+	if err != nil {
+		return Response{}, err
+	}
+
+	messagesResp := make([]*api.Message, len(messages))
+	for i, m := range messages {
+		rawData, err := SanitizeUserData(internal.JsonEncoding, m.Data)
+		if err != nil {
+			return Response{}, err
+		}
+
+		messagesResp[i] = &api.Message{
+			Id:   &m.Id,
+			Name: m.Md.EventName,
+			Data: rawData,
+		}
+	}
+
+	return Response{
+		Response: &api.HistoryResponse{
+			Messages: messagesResp,
+			Cursor:   cursor,
+		},
+	}, nil
+}
+
+// TokenRunner is to issue the tokens of the realtime clients.
+type TokenRunner struct {
+	*baseRunner
+
+	issuer *TokenIssuer
+	req    *api.CreateRTTokenRequest
+}
+
+func (runner *TokenRunner) Run(_ context.Context, tenant *metadata.Tenant) (Response, error) {
+	if _, err := runner.getProject(tenant, runner.req.Project); err != nil {
+		return Response{}, err
+	}
+
+	capabilities, err := CapabilitiesFromAPI(runner.req.Capabilities)
+	if err != nil {
+		return Response{}, err
+	}
+
+	token, expiresAt, err := runner.issuer.Issue(tenant.GetNamespace().StrId(), &types.RealtimeAccess{
+		Project:      runner.req.Project,
+		ClientId:     runner.req.ClientId,
+		Capabilities: capabilities,
+	}, time.Duration(runner.req.ExpiresIn)*time.Second)
+	if err != nil {
+		return Response{}, err
+	}
+
+	return Response{
+		Response: &api.CreateRTTokenResponse{
+			AccessToken: token,
+			ExpiresAt:   expiresAt.Unix(),
+		},
+	}, nil
+}
+
 type ChannelRunner struct {
 	*baseRunner
 
 	channelReq        *api.GetRTChannelRequest
 	channelsReq       *api.GetRTChannelsRequest
 	listSubscriptions *api.ListSubscriptionRequest
+	presenceReq       *api.GetPresenceRequest
+	updateChannelReq  *api.UpdateRTChannelRequest
 }
 
 func (runner *ChannelRunner) SetChannelReq(req *api.GetRTChannelRequest) {
@@ -229,8 +352,73 @@ func (runner *ChannelRunner) SetListSubscriptionsReq(req *api.ListSubscriptionRe
 	runner.listSubscriptions = req
 }
 
+func (runner *ChannelRunner) SetUpdateChannelReq(req *api.UpdateRTChannelRequest) {
+	runner.updateChannelReq = req
+}
+
+func (runner *ChannelRunner) SetPresenceReq(req *api.GetPresenceRequest) {
+	runner.presenceReq = req
+}
+
 func (runner *ChannelRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
 	switch {
+	case runner.updateChannelReq != nil:
+		project, err := runner.getProject(tenant, runner.updateChannelReq.Project)
+		if err != nil {
+			return Response{}, err
+		}
+
+		retention, err := RetentionFromAPI(runner.updateChannelReq.Retention)
+		if err != nil {
+			return Response{}, err
+		}
+
+		// the channel is created so that the retention can be set before any message is published
+		if _, err = runner.factory.GetOrCreateChannel(ctx, tenant.GetNamespace().Id(), project.Id(), runner.updateChannelReq.Channel); err != nil {
+			return Response{}, err
+		}
+		if err = runner.factory.SetRetention(ctx, tenant.GetNamespace().Id(), project.Id(), runner.updateChannelReq.Channel, retention); err != nil {
+			return Response{}, err
+		}
+
+		return Response{
+			Response: &api.UpdateRTChannelResponse{
+				Channel:   runner.updateChannelReq.Channel,
+				Retention: retention.ToAPI(),
+			},
+		}, nil
+	case runner.presenceReq != nil:
+		if err := AccessFromContext(ctx).Check(runner.presenceReq.Project, runner.presenceReq.Channel, OpPresence); err != nil {
+			return Response{}, err
+		}
+
+		project, err := runner.getProject(tenant, runner.presenceReq.Project)
+		if err != nil {
+			return Response{}, err
+		}
+
+		channel, err := runner.factory.GetChannel(ctx, tenant.GetNamespace().Id(), project.Id(), runner.presenceReq.Channel)
+		if err != nil {
+			return Response{}, err
+		}
+
+		members, err := channel.PresenceMembers(ctx)
+		if err != nil {
+			return Response{}, err
+		}
+
+		membersResp := make([]*api.PresenceMember, len(members))
+		for i, m := range members {
+			if membersResp[i], err = toPresenceMember(internal.JsonEncoding, m); err != nil {
+				return Response{}, err
+			}
+		}
+
+		return Response{
+			Response: &api.GetPresenceResponse{
+				Members: membersResp,
+			},
+		}, nil
 	case runner.listSubscriptions != nil:
 		project, err := runner.getProject(tenant, runner.listSubscriptions.Project)
 		if err != nil {
@@ -272,6 +460,10 @@ func (runner *ChannelRunner) Run(ctx context.Context, tenant *metadata.Tenant) (
 			},
 		}, nil
 	default:
+		if err := AccessFromContext(ctx).CheckAny(runner.channelReq.Project, runner.channelReq.Channel); err != nil {
+			return Response{}, err
+		}
+
 		project, err := runner.getProject(tenant, runner.channelReq.Project)
 		if err != nil {
 			return Response{}, err
@@ -286,9 +478,15 @@ func (runner *ChannelRunner) Run(ctx context.Context, tenant *metadata.Tenant) (
 			return Response{}, errors.NotFound("channel '%s' not present ", runner.channelReq.Channel)
 		}
 
+		retention, err := runner.factory.GetRetention(ctx, tenant.GetNamespace().Id(), project.Id(), channels[0])
+		if err != nil {
+			return Response{}, err
+		}
+
 		return Response{
 			Response: &api.GetRTChannelResponse{
-				Channel: channels[0],
+				Channel:   channels[0],
+				Retention: retention.ToAPI(),
 			},
 		}, nil
 	}
@@ -105,6 +105,10 @@ func (s *Sessions) RemoveDevice(_ context.Context, session *Session) {
 
 func (s *Sessions) AddDevice(ctx context.Context, conn *websocket.Conn, params ConnectionParams) (*Session, error) {
 	if device, ok := s.devices[params.SessionId]; ok {
+		// a session can't be resumed with a token of other capabilities
+		if !device.access.Equal(AccessFromContext(ctx)) {
+			return nil, errors.PermissionDenied("session '%s' is connected with another token", params.SessionId)
+		}
 		return device, nil
 	}
 
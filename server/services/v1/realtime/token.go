@@ -0,0 +1,133 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/uuid"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/types"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	defaultTokenTTL = 1 * time.Hour
+	maxTokenTTL     = 24 * time.Hour
+
+	// realtimeTokenRole is the editor role so that the token passes the role check of the publish methods, the
+	// operations of the token are restricted by its capabilities.
+	realtimeTokenRole = "e"
+)
+
+type tokenClaims struct {
+	Tigris tokenTigrisClaims `json:"https://tigris"`
+}
+
+type tokenTigrisClaims struct {
+	NamespaceCode string                `json:"nc"`
+	Role          string                `json:"r"`
+	Realtime      *types.RealtimeAccess `json:"rt"`
+}
+
+// TokenIssuer issues the short-lived tokens of the realtime clients. The tokens are signed with the key of the
+// symmetric key validator they are issued for, so they are validated by the auth middleware like any other token.
+type TokenIssuer struct {
+	signer   jose.Signer
+	issuer   string
+	audience string
+}
+
+// NewTokenIssuer returns the issuer of the realtime tokens, it returns nil if there is no symmetric key validator
+// configured. The tokens carry the issuer and audience of the first symmetric key validator and are signed with its key.
+func NewTokenIssuer(cfg *config.AuthConfig) (*TokenIssuer, error) {
+	for i := range cfg.Validators {
+		v := &cfg.Validators[i]
+
+		key := cfg.ValidatorKey(v)
+		if len(key) == 0 {
+			continue
+		}
+
+		// the auth middleware validates the issuer in the normalized form
+		issuerURL, err := url.Parse(v.Issuer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid issuer '%s' of the realtime tokens: %w", v.Issuer, err)
+		}
+
+		signer, err := jose.NewSigner(
+			jose.SigningKey{Algorithm: jose.HS256, Key: key},
+			(&jose.SignerOptions{}).WithType("JWT"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the signer of the realtime tokens: %w", err)
+		}
+
+		return &TokenIssuer{
+			signer:   signer,
+			issuer:   issuerURL.String(),
+			audience: v.Audience,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// Issue returns a token of the namespace restricted to the channels of the project, along with its expiry. A zero
+// ttl issues the token for an hour.
+func (issuer *TokenIssuer) Issue(namespace string, access *types.RealtimeAccess, ttl time.Duration) (string, time.Time, error) {
+	if issuer == nil {
+		return "", time.Time{}, errors.Unimplemented("realtime tokens are not enabled")
+	}
+	if ttl < 0 || ttl > maxTokenTTL {
+		return "", time.Time{}, errors.InvalidArgument("token expiry should be between 0 and %d seconds", int64(maxTokenTTL.Seconds()))
+	}
+	if ttl == 0 {
+		ttl = defaultTokenTTL
+	}
+
+	subject := access.ClientId
+	if len(subject) == 0 {
+		subject = uuid.NewUUIDAsString()
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	registered := jwt.Claims{
+		Issuer:   issuer.issuer,
+		Subject:  subject,
+		Audience: jwt.Audience{issuer.audience},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(expiresAt),
+	}
+	claims := tokenClaims{
+		Tigris: tokenTigrisClaims{
+			NamespaceCode: namespace,
+			Role:          realtimeTokenRole,
+			Realtime:      access,
+		},
+	}
+
+	token, err := jwt.Signed(issuer.signer).Claims(registered).Claims(claims).CompactSerialize()
+	if err != nil {
+		return "", time.Time{}, errors.Internal("failed to sign the token")
+	}
+
+	return token, expiresAt, nil
+}
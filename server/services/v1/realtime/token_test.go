@@ -0,0 +1,97 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/types"
+)
+
+type testTokenClaims struct {
+	tokenClaims
+}
+
+func (*testTokenClaims) Validate(_ context.Context) error {
+	return nil
+}
+
+func TestTokenIssuer(t *testing.T) {
+	cfg := &config.AuthConfig{
+		Validators: []config.ValidatorConfig{
+			{Issuer: "https://issuer/", Algorithm: validator.HS256, Audience: "https://tigris-api"},
+		},
+		Gotrue: config.Gotrue{SharedSecret: "test-secret"},
+	}
+
+	issuer, err := NewTokenIssuer(&config.AuthConfig{Validators: cfg.Validators})
+	require.NoError(t, err)
+	require.Nil(t, issuer)
+	_, _, err = (*TokenIssuer)(nil).Issue("ns1", &types.RealtimeAccess{}, 0)
+	require.Error(t, err)
+
+	_, err = NewTokenIssuer(&config.AuthConfig{
+		Validators: []config.ValidatorConfig{{Issuer: "://issuer", Algorithm: validator.HS256}},
+		Gotrue:     cfg.Gotrue,
+	})
+	require.Error(t, err)
+
+	issuer, err = NewTokenIssuer(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, issuer)
+
+	access := &types.RealtimeAccess{
+		Project:      "p1",
+		ClientId:     "c1",
+		Capabilities: []types.ChannelCapability{{Channel: "chat:*", Operations: []string{OpPublish}}},
+	}
+
+	_, _, err = issuer.Issue("ns1", access, 48*time.Hour)
+	require.Error(t, err)
+
+	token, expiresAt, err := issuer.Issue("ns1", access, 0)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(defaultTokenTTL), expiresAt, time.Minute)
+
+	v, err := validator.New(
+		func(ctx context.Context) (any, error) {
+			return cfg.ValidatorKey(&cfg.Validators[0]), nil
+		},
+		validator.HS256,
+		cfg.Validators[0].Issuer,
+		[]string{cfg.Validators[0].Audience},
+		validator.WithCustomClaims(func() validator.CustomClaims {
+			return &testTokenClaims{}
+		}),
+	)
+	require.NoError(t, err)
+
+	validated, err := v.ValidateToken(context.TODO(), token)
+	require.NoError(t, err)
+
+	claims := validated.(*validator.ValidatedClaims)
+	require.Equal(t, "c1", claims.RegisteredClaims.Subject)
+	require.Equal(t, expiresAt.Unix(), claims.RegisteredClaims.Expiry)
+
+	custom := claims.CustomClaims.(*testTokenClaims)
+	require.Equal(t, "ns1", custom.Tigris.NamespaceCode)
+	require.Equal(t, realtimeTokenRole, custom.Tigris.Role)
+	require.Equal(t, access, custom.Tigris.Realtime)
+}
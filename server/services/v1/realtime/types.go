@@ -42,7 +42,7 @@ func NewStreamMessageMD(dataType string, clientId string, socketId string, event
 	}
 }
 
-func NewPresenceData(encType internal.UserDataEncType, clientId string, socketId string, eventName string, msg *api.MessageEvent) (*internal.StreamData, error) {
+func NewPresenceData(encType internal.UserDataEncType, clientId string, socketId string, eventName string, msg *api.PresenceMemberEvent) (*internal.StreamData, error) {
 	return newStreamData(PresenceChannelData, encType, clientId, socketId, eventName, msg.Data)
 }
 
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/rs/zerolog/log"
+	"github.com/tigrisdata/tigris/query/filter"
 	"github.com/tigrisdata/tigris/store/cache"
 )
 
@@ -35,6 +36,10 @@ type ChannelWatcher struct {
 	stream        cache.Stream
 	sigStop       chan struct{}
 	sigDisconnect chan struct{}
+	// filter is the message filter of the subscription, it is persisted in filters so that it is restored along with
+	// the consumer group when the session reconnects.
+	filter  []byte
+	filters *watcherFilters
 }
 
 func CreateWatcher(ctx context.Context, name string, pos string, existingPos string, stream cache.Stream) (*ChannelWatcher, error) {
@@ -78,6 +83,32 @@ func newWatcher(ctx context.Context, id string, stream cache.Stream) *ChannelWat
 	}
 }
 
+func (watcher *ChannelWatcher) Filter() []byte {
+	return watcher.filter
+}
+
+// SetFilter replaces the message filter of the watcher, an empty filter removes it.
+func (watcher *ChannelWatcher) SetFilter(ctx context.Context, reqFilter []byte) error {
+	if filter.None(reqFilter) {
+		reqFilter = nil
+	}
+
+	if watcher.filters != nil {
+		var err error
+		if reqFilter == nil {
+			err = watcher.filters.Delete(ctx, watcher.name)
+		} else {
+			err = watcher.filters.Set(ctx, watcher.name, reqFilter)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	watcher.filter = reqFilter
+	return nil
+}
+
 func (watcher *ChannelWatcher) StartWatching(watch Watch) {
 	watcher.watch = watch
 	go watcher.watchEvents()
@@ -106,6 +137,9 @@ func (watcher *ChannelWatcher) watchEvents() {
 			return
 		case <-watcher.sigDisconnect:
 			_ = watcher.stream.RemoveConsumerGroup(watcher.ctx, watcher.name)
+			if watcher.filters != nil {
+				_ = watcher.filters.Delete(watcher.ctx, watcher.name)
+			}
 			return
 		default:
 			resp, hasData, err := watcher.stream.ReadGroup(watcher.ctx, watcher.name, cache.ReadGroupPosCurrent)
@@ -22,6 +22,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/server/config"
 	"github.com/tigrisdata/tigris/server/metadata"
 	"github.com/tigrisdata/tigris/server/request"
 	"github.com/tigrisdata/tigris/server/services/v1/search"
@@ -42,16 +43,31 @@ type searchService struct {
 	versionH      *metadata.VersionHandler
 	sessions      search.Session
 	runnerFactory *search.RunnerFactory
+	reindexWorker *search.ReindexWorker
 }
 
 func newSearchService(store searchStore.Store, tenantMgr *metadata.TenantManager, txMgr *transaction.Manager) *searchService {
-	return &searchService{
+	s := &searchService{
 		txMgr:         txMgr,
 		tenantMgr:     tenantMgr,
 		versionH:      tenantMgr.GetVersionHandler(),
 		sessions:      search.NewSessionManager(txMgr, tenantMgr, metadata.NewCacheTracker(tenantMgr, txMgr)),
 		runnerFactory: search.NewRunnerFactory(store, tenantMgr.GetEncoder(), txMgr),
 	}
+
+	if config.DefaultConfig.Search.Reindex.WorkerEnabled {
+		s.reindexWorker = search.NewReindexWorker(store, tenantMgr, txMgr, &config.DefaultConfig.Search.Reindex)
+		s.reindexWorker.Start()
+	}
+
+	return s
+}
+
+// Stop stops the background workers of the service.
+func (s *searchService) Stop() {
+	if s.reindexWorker != nil {
+		s.reindexWorker.Stop()
+	}
 }
 
 func (s *searchService) RegisterHTTP(router chi.Router, inproc *inprocgrpc.Channel) error {
@@ -139,6 +155,123 @@ func (s *searchService) ListIndexes(ctx context.Context, req *api.ListIndexesReq
 	return resp.Response.(*api.ListIndexesResponse), nil
 }
 
+func (s *searchService) UpsertSynonym(ctx context.Context, req *api.UpsertSynonymRequest) (*api.UpsertSynonymResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	runner := s.runnerFactory.GetIndexRunner(accessToken)
+	runner.SetUpsertSynonymReq(req)
+
+	resp, err := s.sessions.TxExecute(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UpsertSynonymResponse{
+		Status: resp.Status,
+	}, nil
+}
+
+func (s *searchService) DeleteSynonym(ctx context.Context, req *api.DeleteSynonymRequest) (*api.DeleteSynonymResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	runner := s.runnerFactory.GetIndexRunner(accessToken)
+	runner.SetDeleteSynonymReq(req)
+
+	resp, err := s.sessions.TxExecute(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DeleteSynonymResponse{
+		Status: resp.Status,
+	}, nil
+}
+
+func (s *searchService) ListSynonyms(ctx context.Context, req *api.ListSynonymsRequest) (*api.ListSynonymsResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	runner := s.runnerFactory.GetIndexRunner(accessToken)
+	runner.SetListSynonymsReq(req)
+
+	resp, err := s.sessions.TxExecute(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Response.(*api.ListSynonymsResponse), nil
+}
+
+func (s *searchService) CreateOrUpdateAlias(ctx context.Context, req *api.CreateOrUpdateAliasRequest) (*api.CreateOrUpdateAliasResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	runner := s.runnerFactory.GetIndexRunner(accessToken)
+	runner.SetCreateOrUpdateAliasReq(req)
+
+	resp, err := s.sessions.TxExecute(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateOrUpdateAliasResponse{
+		Status: resp.Status,
+	}, nil
+}
+
+func (s *searchService) DeleteAlias(ctx context.Context, req *api.DeleteAliasRequest) (*api.DeleteAliasResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	runner := s.runnerFactory.GetIndexRunner(accessToken)
+	runner.SetDeleteAliasReq(req)
+
+	resp, err := s.sessions.TxExecute(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DeleteAliasResponse{
+		Status: resp.Status,
+	}, nil
+}
+
+func (s *searchService) ListAliases(ctx context.Context, req *api.ListAliasesRequest) (*api.ListAliasesResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	runner := s.runnerFactory.GetIndexRunner(accessToken)
+	runner.SetListAliasesReq(req)
+
+	resp, err := s.sessions.TxExecute(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Response.(*api.ListAliasesResponse), nil
+}
+
+// Reindex schedules the job which builds the index from its source in the background and then swaps the alias to
+// it. The alias keeps serving the previous index while the build is in progress, and the previous index is retained
+// until it is explicitly dropped. The progress of the job is returned by GetReindexJob.
+func (s *searchService) Reindex(ctx context.Context, req *api.ReindexRequest) (*api.ReindexResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	resp, err := s.sessions.TxExecute(ctx, s.runnerFactory.GetReindexRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Response.(*api.ReindexResponse), nil
+}
+
+func (s *searchService) GetReindexJob(ctx context.Context, req *api.GetReindexJobRequest) (*api.GetReindexJobResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	resp, err := s.sessions.TxExecute(ctx, s.runnerFactory.GetReindexJobQueryRunner(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Response.(*api.GetReindexJobResponse), nil
+}
+
 func (s *searchService) Get(ctx context.Context, req *api.GetDocumentRequest) (*api.GetDocumentResponse, error) {
 	accessToken, _ := request.GetAccessToken(ctx)
 
@@ -242,3 +375,15 @@ func (s *searchService) Search(req *api.SearchIndexRequest, stream api.Search_Se
 
 	return nil
 }
+
+func (s *searchService) MultiSearch(ctx context.Context, req *api.MultiSearchRequest) (*api.MultiSearchResponse, error) {
+	accessToken, _ := request.GetAccessToken(ctx)
+
+	runner := s.runnerFactory.GetMultiSearchRunner(req, accessToken)
+	resp, err := s.sessions.Execute(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Response.(*api.MultiSearchResponse), nil
+}
@@ -48,13 +48,14 @@ func newBaseRunner(store search.Store, encoder metadata.Encoder, txMgr *transact
 	}
 }
 
+// getIndex returns the search index, the indexName is either the name of the index or an alias pointing to it.
 func (*baseRunner) getIndex(tenant *metadata.Tenant, projName string, indexName string) (*schema.SearchIndex, error) {
 	project, err := tenant.GetProject(projName)
 	if err != nil {
 		return nil, err
 	}
 
-	index, found := project.GetSearch().GetIndex(indexName)
+	index, found := project.GetSearch().ResolveIndex(indexName)
 	if !found {
 		// this allows to trigger version check to reload if index already exists
 		return nil, metadata.NewSearchIndexNotFoundErr(indexName)
@@ -30,9 +30,9 @@ func createApiError(err error) error {
 	switch e := err.(type) {
 	case metadata.Error:
 		switch e.Code() {
-		case metadata.ErrCodeProjectNotFound, metadata.ErrCodeSearchIndexNotFound:
+		case metadata.ErrCodeProjectNotFound, metadata.ErrCodeSearchIndexNotFound, metadata.ErrCodeSearchAliasNotFound:
 			return apiErrors.NotFound(e.Error())
-		case metadata.ErrCodeSearchIndexExists:
+		case metadata.ErrCodeSearchIndexExists, metadata.ErrCodeSearchAliasExists:
 			return apiErrors.AlreadyExists(e.Error())
 		}
 	case search.Error:
@@ -0,0 +1,288 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/container"
+	"github.com/tigrisdata/tigris/query/filter"
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/metrics"
+	"github.com/tigrisdata/tigris/store/search"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
+)
+
+const maxMultiSearchQueries = 10
+
+// MultiSearchRunner runs the searches on multiple indexes of a project in a single call to the search store. The
+// results are either returned per search or merged into a single list ranked by the normalized scores.
+type MultiSearchRunner struct {
+	*baseRunner
+
+	req *api.MultiSearchRequest
+}
+
+type multiSearchQuery struct {
+	index   *schema.SearchIndex
+	query   *qsearch.Query
+	filter  *filter.WrappedFilter
+	pageNo  int32
+	request *api.SearchIndexRequest
+}
+
+func (runner *MultiSearchRunner) Run(ctx context.Context, tenant *metadata.Tenant) (Response, error) {
+	reqStatus, reqStatusExists := metrics.RequestStatusFromContext(ctx)
+	if reqStatus != nil && reqStatusExists {
+		reqStatus.SetApiSearchType()
+	}
+
+	if len(runner.req.Searches) == 0 {
+		return Response{}, errors.InvalidArgument("at least one search is required")
+	}
+	if len(runner.req.Searches) > maxMultiSearchQueries {
+		return Response{}, errors.InvalidArgument("multi search supports up to %d searches, found %d", maxMultiSearchQueries, len(runner.req.Searches))
+	}
+
+	queries, err := runner.buildQueries(tenant)
+	if err != nil {
+		return Response{}, err
+	}
+
+	indexQueries := make([]search.IndexQuery, len(queries))
+	for i, q := range queries {
+		indexQueries[i] = search.IndexQuery{
+			Table:  q.index.StoreIndexName(),
+			Query:  q.query,
+			PageNo: int(q.pageNo),
+		}
+	}
+	results, err := runner.store.MultiSearch(ctx, indexQueries)
+	if err != nil {
+		return Response{}, createApiError(err)
+	}
+
+	responses := make([]*api.SearchIndexResponse, len(queries))
+	for i, q := range queries {
+		if responses[i], err = runner.readResult(ctx, q, results[i]); err != nil {
+			return Response{}, err
+		}
+	}
+
+	if !runner.req.Merge {
+		return Response{
+			Response: &api.MultiSearchResponse{
+				Results: responses,
+			},
+		}, nil
+	}
+
+	return Response{
+		Response: runner.merge(responses),
+	}, nil
+}
+
+func (runner *MultiSearchRunner) buildQueries(tenant *metadata.Tenant) ([]*multiSearchQuery, error) {
+	queries := make([]*multiSearchQuery, len(runner.req.Searches))
+	for i, req := range runner.req.Searches {
+		if len(req.Project) > 0 && req.Project != runner.req.Project {
+			return nil, errors.InvalidArgument("all the searches should be on the project '%s'", runner.req.Project)
+		}
+		req.Project = runner.req.Project
+		if runner.req.Merge && req.PageSize == 0 {
+			req.PageSize = runner.req.PageSize
+		}
+
+		index, err := runner.getIndex(tenant, req.Project, req.Index)
+		if err != nil {
+			return nil, err
+		}
+
+		searchRunner := &SearchRunner{baseRunner: runner.baseRunner, req: req}
+		query, wrappedF, err := searchRunner.buildQuery(index)
+		if err != nil {
+			return nil, err
+		}
+		if runner.req.Merge && query.IsGroupByQuery() {
+			return nil, errors.InvalidArgument("group by is not supported when merging the results of the multi search")
+		}
+		if runner.req.Merge && req.Page > defaultPageNo {
+			return nil, errors.InvalidArgument("only the first page is returned when merging the results of the multi search")
+		}
+
+		pageNo := int32(defaultPageNo)
+		if req.Page > 0 {
+			pageNo = req.Page
+		}
+		queries[i] = &multiSearchQuery{
+			index:   index,
+			query:   query,
+			filter:  wrappedF,
+			pageNo:  pageNo,
+			request: req,
+		}
+	}
+
+	return queries, nil
+}
+
+// readResult reads the page of a search the same way as a single index search, the hits are tagged with the index.
+func (runner *MultiSearchRunner) readResult(ctx context.Context, q *multiSearchQuery, result tsApi.SearchResult) (*api.SearchIndexResponse, error) {
+	iterator := NewReader(ctx, runner.store, q.index, q.query).PrefetchedIterator(q.index, q.filter, q.pageNo, result)
+
+	resp := &api.SearchIndexResponse{}
+	matchedFields := container.NewHashSet()
+	if err := fillPage(resp, iterator, q.query, matchedFields); err != nil {
+		return nil, err
+	}
+	if iterator.Interrupted() != nil {
+		return nil, iterator.Interrupted()
+	}
+
+	for _, hit := range resp.Hits {
+		hit.Metadata.Index = q.index.Name
+	}
+	for _, group := range resp.Group {
+		for _, hit := range group.Hits {
+			hit.Metadata.Index = q.index.Name
+		}
+	}
+
+	resp.Facets = iterator.getFacets()
+	resp.Meta = &api.SearchMetadata{
+		Found:      iterator.getTotalFound(),
+		TotalPages: int32(math.Ceil(float64(iterator.getTotalFound()) / float64(q.query.PageSize))),
+		Page: &api.Page{
+			Current: q.pageNo,
+			Size:    int32(q.query.PageSize),
+		},
+		MatchedFields: matchedFields.ToList(),
+	}
+
+	return resp, nil
+}
+
+type mergedHit struct {
+	hit   *api.SearchHit
+	score float64
+}
+
+// merge ranks the hits of all the searches by their normalized scores. The scores of the different indexes are not
+// comparable as is, so the scores of every search are normalized to [0, 1] before the hits are merged. The scores are
+// normalized within the first page of every search, so the merged result is a single page and the next pages can't be
+// requested, found is still the total of all the searches.
+func (runner *MultiSearchRunner) merge(responses []*api.SearchIndexResponse) *api.MultiSearchResponse {
+	pageSize := int(runner.req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPerPage
+	}
+
+	var (
+		found  int64
+		merged []mergedHit
+	)
+	matchedFields := container.NewHashSet()
+	for _, resp := range responses {
+		found += resp.Meta.Found
+		for _, f := range resp.Meta.MatchedFields {
+			matchedFields.Insert(f)
+		}
+
+		scores := normalizedScores(resp.Hits)
+		for i, hit := range resp.Hits {
+			merged = append(merged, mergedHit{hit: hit, score: scores[i]})
+		}
+	}
+
+	// the ties keep the order of the searches in the request
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].score > merged[j].score })
+	if len(merged) > pageSize {
+		merged = merged[:pageSize]
+	}
+
+	hits := make([]*api.SearchHit, len(merged))
+	for i, m := range merged {
+		hits[i] = m.hit
+	}
+
+	return &api.MultiSearchResponse{
+		Hits: hits,
+		Meta: &api.SearchMetadata{
+			Found:      found,
+			TotalPages: 1,
+			Page: &api.Page{
+				Current: defaultPageNo,
+				Size:    int32(pageSize),
+			},
+			MatchedFields: matchedFields.ToList(),
+		},
+	}
+}
+
+// normalizedScores scales the scores of the hits of a search to [0, 1]. The text match score is divided by the best
+// score, the vector distance is min-max normalized with the closest hit scoring 1. When the hits don't all have the
+// same kind of score, like in a hybrid search, the rank of the hit is used.
+func normalizedScores(hits []*api.SearchHit) []float64 {
+	scores := make([]float64, len(hits))
+	if len(hits) == 0 {
+		return scores
+	}
+
+	textScores := make([]float64, len(hits))
+	hasText, hasDistance := true, true
+	anyText, anyDistance := false, false
+	minDistance, maxDistance, maxText := math.Inf(1), math.Inf(-1), 0.0
+	for i, hit := range hits {
+		match := hit.GetMetadata().GetMatch()
+		if score, err := strconv.ParseFloat(match.GetScore(), 64); err == nil && score > 0 {
+			textScores[i] = score
+			maxText = math.Max(maxText, score)
+			anyText = true
+		} else {
+			hasText = false
+		}
+		if match != nil && match.VectorDistance != nil {
+			minDistance = math.Min(minDistance, *match.VectorDistance)
+			maxDistance = math.Max(maxDistance, *match.VectorDistance)
+			anyDistance = true
+		} else {
+			hasDistance = false
+		}
+	}
+
+	for i, hit := range hits {
+		switch {
+		case hasText && !anyDistance:
+			scores[i] = textScores[i] / maxText
+		case hasDistance && !anyText:
+			if maxDistance == minDistance {
+				scores[i] = 1
+			} else {
+				scores[i] = (maxDistance - *hit.Metadata.Match.VectorDistance) / (maxDistance - minDistance)
+			}
+		default:
+			scores[i] = 1 - float64(i)/float64(len(hits))
+		}
+	}
+
+	return scores
+}
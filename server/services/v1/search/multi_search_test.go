@@ -0,0 +1,79 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+)
+
+func testSearchHit(index string, score string, distance *float64) *api.SearchHit {
+	return &api.SearchHit{
+		Metadata: &api.SearchHitMeta{
+			Index: index,
+			Match: &api.Match{Score: score, VectorDistance: distance},
+		},
+	}
+}
+
+func TestNormalizedScores(t *testing.T) {
+	d1, d2, d3 := 0.1, 0.3, 0.5
+
+	require.Equal(t, []float64{1, 0.5}, normalizedScores([]*api.SearchHit{
+		testSearchHit("a", "200", nil),
+		testSearchHit("a", "100", nil),
+	}))
+	require.Equal(t, []float64{1, 0.5, 0}, normalizedScores([]*api.SearchHit{
+		testSearchHit("a", "", &d1),
+		testSearchHit("a", "", &d2),
+		testSearchHit("a", "", &d3),
+	}))
+	// hybrid hits are ranked by their position
+	require.Equal(t, []float64{1, 0.5}, normalizedScores([]*api.SearchHit{
+		testSearchHit("a", "100", &d1),
+		testSearchHit("a", "", &d2),
+	}))
+	require.Empty(t, normalizedScores(nil))
+}
+
+func TestMultiSearchMerge(t *testing.T) {
+	runner := &MultiSearchRunner{req: &api.MultiSearchRequest{Merge: true, PageSize: 3}}
+
+	products := &api.SearchIndexResponse{
+		Hits: []*api.SearchHit{
+			testSearchHit("products", "1000", nil),
+			testSearchHit("products", "250", nil),
+		},
+		Meta: &api.SearchMetadata{Found: 2, MatchedFields: []string{"name"}},
+	}
+	articles := &api.SearchIndexResponse{
+		Hits: []*api.SearchHit{
+			testSearchHit("articles", "40", nil),
+			testSearchHit("articles", "30", nil),
+		},
+		Meta: &api.SearchMetadata{Found: 12, MatchedFields: []string{"title"}},
+	}
+
+	resp := runner.merge([]*api.SearchIndexResponse{products, articles})
+	require.Len(t, resp.Hits, 3)
+	require.Equal(t, "products", resp.Hits[0].Metadata.Index)
+	require.Equal(t, "articles", resp.Hits[1].Metadata.Index)
+	require.Equal(t, "30", resp.Hits[2].Metadata.Match.Score)
+	require.Equal(t, int64(14), resp.Meta.Found)
+	require.Equal(t, int32(1), resp.Meta.TotalPages)
+	require.ElementsMatch(t, []string{"name", "title"}, resp.Meta.MatchedFields)
+}
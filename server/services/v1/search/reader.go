@@ -26,6 +26,7 @@ import (
 	"github.com/tigrisdata/tigris/store/search"
 	"github.com/tigrisdata/tigris/util"
 	ulog "github.com/tigrisdata/tigris/util/log"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
 )
 
 const (
@@ -97,6 +98,8 @@ type pageReader struct {
 	store        search.Store
 	searchIndex  *schema.SearchIndex
 	cachedFacets map[string]*api.SearchFacet
+	// prefetched is the result of the first page when it is already searched.
+	prefetched []tsApi.SearchResult
 }
 
 func newPageReader(ctx context.Context, store search.Store, index *schema.SearchIndex, query *qsearch.Query, firstPage int32) *pageReader {
@@ -112,9 +115,14 @@ func newPageReader(ctx context.Context, store search.Store, index *schema.Search
 }
 
 func (p *pageReader) read() error {
-	result, err := p.store.Search(p.ctx, p.searchIndex.StoreIndexName(), p.query, p.pageNo)
-	if err != nil {
-		return err
+	result := p.prefetched
+	if result != nil {
+		p.prefetched = nil
+	} else {
+		var err error
+		if result, err = p.store.Search(p.ctx, p.searchIndex.StoreIndexName(), p.query, p.pageNo); err != nil {
+			return err
+		}
 	}
 
 	p.pageNo++
@@ -299,6 +307,14 @@ func (reader *Reader) SinglePageIterator(index *schema.SearchIndex, filter *filt
 	return NewFilterableSearchIterator(index, pageReader, filter, true)
 }
 
+// PrefetchedIterator iterates on a page that is already searched, like the pages of a multi search.
+func (reader *Reader) PrefetchedIterator(index *schema.SearchIndex, filter *filter.WrappedFilter, pageNo int32, result tsApi.SearchResult) *FilterableSearchIterator {
+	pageReader := newPageReader(reader.ctx, reader.store, reader.index, reader.query, pageNo)
+	pageReader.prefetched = []tsApi.SearchResult{result}
+
+	return NewFilterableSearchIterator(index, pageReader, filter, true)
+}
+
 func (reader *Reader) Iterator(index *schema.SearchIndex, filter *filter.WrappedFilter) *FilterableSearchIterator {
 	pageReader := newPageReader(reader.ctx, reader.store, reader.index, reader.query, defaultPageNo)
 
@@ -0,0 +1,923 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog/log"
+	api "github.com/tigrisdata/tigris/api/server/v1"
+	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/keys"
+	"github.com/tigrisdata/tigris/query/filter"
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	"github.com/tigrisdata/tigris/query/sort"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/server/metadata"
+	"github.com/tigrisdata/tigris/server/request"
+	"github.com/tigrisdata/tigris/server/services/v1/database"
+	"github.com/tigrisdata/tigris/server/transaction"
+	"github.com/tigrisdata/tigris/store/search"
+	"github.com/tigrisdata/tigris/util"
+	ulog "github.com/tigrisdata/tigris/util/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// reindexBatchSize is the number of documents read from the source and indexed in a single batch. For a
+	// collection source, this is also the number of rows read in a single transaction.
+	reindexBatchSize = 250
+	// reindexCatchUpRounds is the maximum number of the catch-up rounds before the alias is swapped, the rounds stop
+	// earlier once a round finds no document written to the source index.
+	reindexCatchUpRounds = 5
+	// reindexClockSkew is how far the catch-up cursors are rewound at the start of every round, so the documents
+	// written by the servers with a lagging clock are not skipped.
+	reindexClockSkew = time.Second
+)
+
+// reindexSpec is the reindex request persisted in the job. The source index is resolved when the job is scheduled,
+// so swapping the alias while the job is running doesn't change the source.
+type reindexSpec struct {
+	Alias       string `json:"alias"`
+	Index       string `json:"index"`
+	SourceIndex string `json:"source_index,omitempty"`
+	Creator     string `json:"creator,omitempty"`
+}
+
+// reindexCursor is the position of a scan of the source index in the order of a timestamp. The ids are the documents
+// already copied with the timestamp of the cursor and the page is the next page of these documents, so the documents
+// sharing a timestamp are neither skipped nor copied again.
+type reindexCursor struct {
+	TS   int64    `json:"ts,omitempty"`
+	Ids  []string `json:"ids,omitempty"`
+	Page int      `json:"page,omitempty"`
+}
+
+// reindexPosition is the resume position of the reindex job. The key is the last indexed row of the source collection.
+// The cursors are the scans of the source index by the creation and the update time, since is when the build started.
+type reindexPosition struct {
+	Key     []byte        `json:"key,omitempty"`
+	Since   int64         `json:"since,omitempty"`
+	Created reindexCursor `json:"created"`
+	Updated reindexCursor `json:"updated"`
+	Pruned  bool          `json:"pruned,omitempty"`
+}
+
+// reindexTask is the queue item payload of the scheduled reindex job. The job id is stored under its own name so that
+// the other queue workers skip the item.
+type reindexTask struct {
+	Namespace    string `json:"namespace"`
+	ReindexJobId string `json:"reindex_job_id"`
+}
+
+// ReindexRunner validates the reindex request and schedules the job building the index in the background. The
+// searches on the alias are served by the previous index until the job swaps the alias.
+type ReindexRunner struct {
+	*baseRunner
+
+	req *api.ReindexRequest
+}
+
+func (runner *ReindexRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, error) {
+	currentSub, err := request.GetCurrentSub(ctx)
+	if err != nil && config.DefaultConfig.Auth.Enabled {
+		return Response{}, errors.Internal("Failed to get current sub for the request")
+	}
+
+	if len(runner.req.GetAlias()) == 0 || len(runner.req.GetIndex()) == 0 {
+		return Response{}, errors.InvalidArgument("alias name and index are required")
+	}
+
+	project, err := tenant.GetProject(runner.req.GetProject())
+	if err != nil {
+		return Response{}, createApiError(err)
+	}
+
+	target, found := project.GetSearch().GetIndex(runner.req.GetIndex())
+	if !found {
+		return Response{}, createApiError(metadata.NewSearchIndexNotFoundErr(runner.req.GetIndex()))
+	}
+
+	spec := &reindexSpec{
+		Alias:   runner.req.GetAlias(),
+		Index:   target.Name,
+		Creator: currentSub,
+	}
+	if target.Source.Type != schema.SearchSourceTigris || len(runner.req.GetSourceIndex()) > 0 {
+		source, err := runner.getSourceIndex(project, target)
+		if err != nil {
+			return Response{}, err
+		}
+		spec.SourceIndex = source.Name
+	}
+
+	job, err := ScheduleReindexJob(ctx, tx, tenant, project.Name(), spec)
+	if err != nil {
+		return Response{}, createApiError(err)
+	}
+
+	return Response{
+		Status: database.CreatedStatus,
+		Response: &api.ReindexResponse{
+			JobId:  job.Id,
+			Status: database.CreatedStatus,
+		},
+	}, nil
+}
+
+func (runner *ReindexRunner) getSourceIndex(project *metadata.Project, target *schema.SearchIndex) (*schema.SearchIndex, error) {
+	sourceName := runner.req.GetSourceIndex()
+	if len(sourceName) == 0 {
+		var found bool
+		if sourceName, found = project.GetSearch().GetAlias(runner.req.GetAlias()); !found {
+			return nil, errors.InvalidArgument("source index is required as the alias '%s' doesn't exist", runner.req.GetAlias())
+		}
+	}
+	if sourceName == target.Name {
+		return nil, errors.InvalidArgument("source index and the index to build can't be the same '%s'", sourceName)
+	}
+
+	source, found := project.GetSearch().GetIndex(sourceName)
+	if !found {
+		return nil, createApiError(metadata.NewSearchIndexNotFoundErr(sourceName))
+	}
+
+	return source, nil
+}
+
+// ScheduleReindexJob stores the reindex job and enqueues it for the reindex worker.
+func ScheduleReindexJob(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant, project string, spec *reindexSpec) (*metadata.JobMetadata, error) {
+	job := metadata.NewJobMetadata(metadata.ReindexJobType, project, "", spec.Index)
+
+	var err error
+	if job.Spec, err = jsoniter.Marshal(spec); err != nil {
+		return nil, err
+	}
+
+	if err = tenant.MetaStore.Job().Create(ctx, tx, tenant.GetNamespace().Id(), job); err != nil {
+		return nil, err
+	}
+
+	task, err := jsoniter.Marshal(&reindexTask{Namespace: tenant.GetNamespace().StrId(), ReindexJobId: job.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	item := metadata.NewQueueItem(0, task)
+	item.Id = job.Id
+
+	return job, tenant.MetaStore.Queue().Enqueue(ctx, tx, item, 0)
+}
+
+// ReindexJobQueryRunner returns the state of the reindex job.
+type ReindexJobQueryRunner struct {
+	*baseRunner
+
+	req *api.GetReindexJobRequest
+}
+
+func (runner *ReindexJobQueryRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, error) {
+	job, err := tenant.MetaStore.Job().Get(ctx, tx, tenant.GetNamespace().Id(), runner.req.GetJobId())
+	if err != nil && err != errors.ErrNotFound {
+		return Response{}, err
+	}
+
+	if job == nil || job.Type != metadata.ReindexJobType || job.Project != runner.req.GetProject() {
+		return Response{}, errors.NotFound("reindex job '%s' not found", runner.req.GetJobId())
+	}
+
+	return Response{
+		Response: &api.GetReindexJobResponse{
+			Job: ReindexJobToAPI(job),
+		},
+	}, nil
+}
+
+// ReindexJobToAPI converts the job metadata to the API representation.
+func ReindexJobToAPI(job *metadata.JobMetadata) *api.ReindexJob {
+	var spec reindexSpec
+
+	// written by the server, the zero value is fine for the reporting
+	_ = jsoniter.Unmarshal(job.Spec, &spec)
+
+	return &api.ReindexJob{
+		Id:          job.Id,
+		State:       string(job.State),
+		Alias:       spec.Alias,
+		Index:       spec.Index,
+		SourceIndex: spec.SourceIndex,
+		Indexed:     job.Processed,
+		Message:     job.Message,
+		CreatedAt:   timestamppb.New(job.CreatedAt),
+		UpdatedAt:   timestamppb.New(job.UpdatedAt),
+	}
+}
+
+// Reindexer builds a search index from its source and then swaps the alias to it. The source is either the Tigris
+// collection the index is sourced from or another search index. The progress is persisted in the job after every
+// batch, an interrupted job continues from the last persisted position.
+//
+// The source index keeps serving the writes on the alias while it is copied, so the copy is a scan of the source in
+// the order of the creation time followed by the catch-up rounds. A round copies the documents created or updated
+// since the previous round, the documents deleted from the source are pruned from the index once the copy is done.
+// Both scans page by the timestamp rather than by the offset, so the writes on the source don't shift the pages. Only
+// the writes made between the last round and the swap, typically a few milliseconds, are not copied.
+type Reindexer struct {
+	*baseRunner
+
+	versionH      *metadata.VersionHandler
+	tenantTracker *metadata.CacheTracker
+}
+
+func NewReindexer(store search.Store, tenantMgr *metadata.TenantManager, txMgr *transaction.Manager) *Reindexer {
+	return &Reindexer{
+		baseRunner:    newBaseRunner(store, tenantMgr.GetEncoder(), txMgr, nil),
+		versionH:      tenantMgr.GetVersionHandler(),
+		tenantTracker: metadata.NewCacheTracker(tenantMgr, txMgr),
+	}
+}
+
+// Reindex runs the job till completion and returns the final state of the job.
+func (r *Reindexer) Reindex(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) (*metadata.JobMetadata, error) {
+	if job.IsTerminal() {
+		return job, nil
+	}
+
+	reindexErr := r.reindex(ctx, tenant, job)
+	if reindexErr != nil && ctx.Err() != nil {
+		// interrupted, the job keeps running and continues from the last persisted position
+		return job, reindexErr
+	}
+	if reindexErr == nil {
+		// the job is completed in the transaction swapping the alias
+		return job, nil
+	}
+
+	job.State = metadata.JobFailed
+	job.Message = reindexErr.Error()
+	if err := r.withTx(ctx, func(tx transaction.Tx) error {
+		return r.saveJob(ctx, tx, tenant, job)
+	}); err != nil {
+		return job, err
+	}
+
+	return job, reindexErr
+}
+
+func (r *Reindexer) reindex(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata) error {
+	var (
+		spec reindexSpec
+		pos  reindexPosition
+	)
+	if err := jsoniter.Unmarshal(job.Spec, &spec); err != nil {
+		return err
+	}
+	if len(job.Position) > 0 {
+		if err := jsoniter.Unmarshal(job.Position, &pos); err != nil {
+			return err
+		}
+	}
+
+	// the index may be created through another server
+	if _, err := r.tenantTracker.InstantTracking(ctx, nil, tenant); err != nil {
+		return err
+	}
+
+	project, err := tenant.GetProject(job.Project)
+	if err != nil {
+		return createApiError(err)
+	}
+
+	target, found := project.GetSearch().GetIndex(spec.Index)
+	if !found {
+		return createApiError(metadata.NewSearchIndexNotFoundErr(spec.Index))
+	}
+
+	if len(spec.SourceIndex) == 0 {
+		err = r.fromCollection(ctx, tenant, job, &pos, project, target)
+	} else {
+		source, found := project.GetSearch().GetIndex(spec.SourceIndex)
+		if !found {
+			return createApiError(metadata.NewSearchIndexNotFoundErr(spec.SourceIndex))
+		}
+		err = r.fromIndex(ctx, tenant, job, &pos, source, target)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = r.withTx(ctx, func(tx transaction.Tx) error {
+		if err := tenant.CreateOrUpdateSearchAlias(ctx, tx, project, spec.Alias, target.Name, spec.Creator); err != nil {
+			return err
+		}
+
+		job.State = metadata.JobCompleted
+		job.Position = nil
+		if err := r.saveJob(ctx, tx, tenant, job); err != nil {
+			return err
+		}
+
+		return r.versionH.Increment(ctx, tx)
+	}); err != nil {
+		return createApiError(err)
+	}
+
+	log.Info().Msgf("Reindexed '%d' documents in the index '%s' for the alias '%s'", job.Processed, target.Name, spec.Alias)
+
+	return nil
+}
+
+// fromIndex copies the documents of the source index to the target index, then prunes the deleted documents and
+// catches up with the writes made to the source meanwhile.
+func (r *Reindexer) fromIndex(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata, pos *reindexPosition,
+	source *schema.SearchIndex, target *schema.SearchIndex,
+) error {
+	if pos.Since == 0 {
+		pos.Since = time.Now().UnixNano()
+		pos.Updated.TS = pos.Since
+	}
+
+	for round := 0; ; round++ {
+		if round > 0 {
+			pos.Created.rewind()
+			pos.Updated.rewind()
+		}
+
+		created, err := r.copyChanged(ctx, tenant, job, pos, &pos.Created, schema.ReservedFields[schema.CreatedAt], source, target)
+		if err != nil {
+			return err
+		}
+		updated, err := r.copyChanged(ctx, tenant, job, pos, &pos.Updated, schema.ReservedFields[schema.UpdatedAt], source, target)
+		if err != nil {
+			return err
+		}
+
+		if !pos.Pruned {
+			if err = r.prune(ctx, source, target); err != nil {
+				return err
+			}
+			pos.Pruned = true
+			if err = r.savePosition(ctx, tenant, job, pos); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if created+updated == 0 || round >= reindexCatchUpRounds {
+			return nil
+		}
+	}
+}
+
+// rewind moves the cursor back by the clock skew, the documents copied again are replaced with the same content.
+func (c *reindexCursor) rewind() {
+	c.TS -= reindexClockSkew.Nanoseconds()
+	c.Ids = nil
+	c.Page = 0
+}
+
+// copyChanged copies the documents of the source index with the timestamp field at or after the cursor in the order
+// of the field and returns the number of the copied documents. The documents updated during the scan move past the
+// cursor, so they are copied again by the same scan.
+func (r *Reindexer) copyChanged(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata, pos *reindexPosition,
+	cursor *reindexCursor, field string, source *schema.SearchIndex, target *schema.SearchIndex,
+) (int64, error) {
+	var copied int64
+	for {
+		rows, err := r.readChanged(ctx, source, field, cursor)
+		if err != nil {
+			return copied, err
+		}
+
+		var (
+			batch    = make([][]byte, 0, len(rows))
+			batchIds = make([]string, 0, len(rows))
+			lastTS   = cursor.TS
+			lastIds  []string
+		)
+		for _, row := range rows {
+			ts := row.CreatedAt
+			if field == schema.ReservedFields[schema.UpdatedAt] {
+				ts = row.UpdatedAt
+			}
+			if ts == nil {
+				continue
+			}
+
+			id, err := r.documentId(source, row.Document)
+			if err != nil {
+				return copied, err
+			}
+
+			nano := ts.UnixNano()
+			if nano == cursor.TS && containsId(cursor.Ids, id) {
+				// already copied by the previous page
+				continue
+			}
+			if nano != lastTS {
+				lastTS, lastIds = nano, nil
+			}
+			lastIds = append(lastIds, id)
+
+			batch = append(batch, row.Document)
+			batchIds = append(batchIds, id)
+		}
+
+		if err = r.indexBatch(ctx, target, batch); err != nil {
+			return copied, err
+		}
+		copied += int64(len(batch))
+		job.Processed += int64(len(batch))
+
+		if lastTS == cursor.TS {
+			// the whole page shares the timestamp of the cursor, the next page has the rest of these documents
+			cursor.Ids = append(cursor.Ids, lastIds...)
+			cursor.Page++
+		} else {
+			cursor.TS, cursor.Ids, cursor.Page = lastTS, lastIds, 0
+		}
+		if err = r.savePosition(ctx, tenant, job, pos); err != nil {
+			return copied, err
+		}
+
+		if len(rows) < reindexBatchSize {
+			return copied, nil
+		}
+	}
+}
+
+// readChanged reads a page of the source documents with the timestamp field at or after the cursor.
+func (r *Reindexer) readChanged(ctx context.Context, source *schema.SearchIndex, field string, cursor *reindexCursor) ([]*Row, error) {
+	ts := internal.CreateNewTimestamp(cursor.TS).ToRFC3339()
+	wrapped, err := filter.NewFactory(source.QueryableFields, nil).WrappedFilter([]byte(fmt.Sprintf(`{"%s":{"$gte":"%s"}}`, field, ts)))
+	if err != nil {
+		return nil, err
+	}
+
+	ordering := sort.Ordering{{Name: field, Ascending: true}}
+	searchQ := qsearch.NewBuilder().
+		PageSize(reindexBatchSize).
+		Filter(wrapped).
+		SortOrder(&ordering).
+		Build()
+
+	// the timestamps are not part of the documents returned by the store, the filter is only applied by the store
+	iterator := NewReader(ctx, r.store, source, searchQ).SinglePageIterator(source, filter.NewWrappedFilter(nil), int32(defaultPageNo+cursor.Page))
+
+	var (
+		row  ResultRow
+		rows []*Row
+	)
+	for iterator.Next(&row) {
+		rows = append(rows, row.Rows...)
+	}
+	if err = iterator.Interrupted(); err != nil {
+		return nil, createApiError(err)
+	}
+
+	return rows, nil
+}
+
+// prune removes the documents deleted from the source index. The target index is only written by the job, so its
+// pages are stable and the deleted ids are removed once the whole index is read.
+func (r *Reindexer) prune(ctx context.Context, source *schema.SearchIndex, target *schema.SearchIndex) error {
+	searchQ := qsearch.NewBuilder().
+		PageSize(reindexBatchSize).
+		Filter(filter.NewWrappedFilter(nil)).
+		Build()
+
+	iterator := NewReader(ctx, r.store, target, searchQ).Iterator(target, filter.NewWrappedFilter(nil))
+
+	var (
+		row     ResultRow
+		page    = make([]string, 0, reindexBatchSize)
+		deleted []string
+	)
+	for iterator.Next(&row) {
+		for _, doc := range row.Rows {
+			id, err := r.documentId(target, doc.Document)
+			if err != nil {
+				return err
+			}
+			page = append(page, id)
+		}
+
+		if len(page) >= reindexBatchSize {
+			missing, err := r.missingIds(ctx, source, page)
+			if err != nil {
+				return err
+			}
+			deleted = append(deleted, missing...)
+			page = page[:0]
+		}
+	}
+	if err := iterator.Interrupted(); err != nil {
+		return createApiError(err)
+	}
+
+	missing, err := r.missingIds(ctx, source, page)
+	if err != nil {
+		return err
+	}
+	deleted = append(deleted, missing...)
+
+	for _, id := range deleted {
+		if err = r.store.DeleteDocument(ctx, target.StoreIndexName(), id); err != nil && !search.IsErrNotFound(err) {
+			return createApiError(err)
+		}
+	}
+
+	return r.execInStorage(ctx, target.StoreIndexName(), deleted, func(_ int, tx transaction.Tx, key keys.Key) error {
+		return tx.Delete(ctx, key)
+	})
+}
+
+// missingIds returns the ids which are not in the index.
+func (r *Reindexer) missingIds(ctx context.Context, index *schema.SearchIndex, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	result, err := r.store.GetDocuments(ctx, index.StoreIndexName(), ids)
+	if err != nil {
+		return nil, createApiError(err)
+	}
+
+	found := make(map[string]struct{}, len(ids))
+	if result.Hits != nil {
+		for _, hit := range *result.Hits {
+			if hit.Document == nil {
+				continue
+			}
+			if id, ok := (*hit.Document)[schema.SearchId].(string); ok {
+				found[id] = struct{}{}
+			}
+		}
+	}
+
+	var missing []string
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	return missing, nil
+}
+
+// documentId returns the id of the document returned by the search of the index.
+func (*Reindexer) documentId(index *schema.SearchIndex, raw []byte) (string, error) {
+	doc, err := util.JSONToMap(raw)
+	if err != nil {
+		return "", err
+	}
+
+	return newWriteTransformer(index, internal.NewTimestamp(), false).getOrGenerateId(raw, doc)
+}
+
+func containsId(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fromCollection indexes all the documents of the source collection of the target index. The rows are read in
+// batches, each batch in its own transaction so that building a large index is not bounded by the transaction limits.
+func (r *Reindexer) fromCollection(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata, pos *reindexPosition,
+	project *metadata.Project, target *schema.SearchIndex,
+) error {
+	db, err := project.GetDatabase(metadata.NewDatabaseNameWithBranch(project.Name(), target.Source.DatabaseBranch))
+	if err != nil {
+		return createApiError(err)
+	}
+	if db == nil {
+		return errors.NotFound("database branch '%s' not found", target.Source.DatabaseBranch)
+	}
+	coll := db.GetCollection(target.Source.CollectionName)
+	if coll == nil {
+		return errors.NotFound("collection '%s' not found", target.Source.CollectionName)
+	}
+
+	for {
+		batch, lastKey, err := r.readCollectionBatch(ctx, coll, pos.Key)
+		if err != nil {
+			return createApiError(err)
+		}
+		if err = r.indexBatch(ctx, target, batch); err != nil {
+			return err
+		}
+		job.Processed += int64(len(batch))
+		if len(batch) < reindexBatchSize {
+			return nil
+		}
+
+		pos.Key = lastKey
+		if err = r.savePosition(ctx, tenant, job, pos); err != nil {
+			return err
+		}
+	}
+}
+
+// readCollectionBatch reads the next batch of rows of the collection after the "last" key.
+func (r *Reindexer) readCollectionBatch(ctx context.Context, coll *schema.DefaultCollection, last []byte) ([][]byte, []byte, error) {
+	tx, err := r.txMgr.StartTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	reader := database.NewDatabaseReader(ctx, tx)
+
+	var iterator database.Iterator
+	if last == nil {
+		iterator, err = reader.ScanTable(coll.EncodedName, false)
+	} else {
+		var from keys.Key
+		if from, err = keys.FromBinary(coll.EncodedName, last); err != nil {
+			return nil, nil, err
+		}
+		iterator, err = reader.ScanIterator(from, nil, false)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		row   database.Row
+		batch = make([][]byte, 0, reindexBatchSize)
+	)
+	for len(batch) < reindexBatchSize && iterator.Next(&row) {
+		if last != nil && bytes.Equal(row.Key, last) {
+			// the scan is inclusive of the last key that is already indexed
+			continue
+		}
+
+		batch = append(batch, row.Data.RawData)
+		last = row.Key
+	}
+
+	return batch, last, iterator.Interrupted()
+}
+
+// indexBatch replaces the documents in the target index. The build is stopped on the first document that is failed
+// to index so that the alias is never swapped to an incomplete index.
+func (r *Reindexer) indexBatch(ctx context.Context, target *schema.SearchIndex, documents [][]byte) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	var buffer bytes.Buffer
+	ids, serialized, batchErrors, validDocs := r.encodeDocuments(target, documents, &buffer, false)
+	for i, err := range batchErrors {
+		if err != nil {
+			return errors.InvalidArgument("failed to reindex the document '%s': %s", ids[i], err.Error())
+		}
+	}
+
+	storeResponses, err := r.store.IndexDocuments(ctx, target.StoreIndexName(), &buffer, search.IndexDocumentsOptions{
+		Action:    search.Replace,
+		BatchSize: validDocs,
+	})
+	if err != nil {
+		return createApiError(err)
+	}
+	for i, resp := range storeResponses {
+		if !resp.Success {
+			return errors.InvalidArgument("failed to reindex the document '%s': %s", ids[i], resp.Error)
+		}
+	}
+
+	if err = r.execInStorage(ctx, target.StoreIndexName(), ids, func(index int, tx transaction.Tx, key keys.Key) error {
+		return tx.Replace(ctx, key, internal.NewTableData(serialized[index]), false)
+	}); err != nil {
+		return createApiError(err)
+	}
+
+	return nil
+}
+
+func (r *Reindexer) savePosition(ctx context.Context, tenant *metadata.Tenant, job *metadata.JobMetadata, pos *reindexPosition) error {
+	var err error
+	if job.Position, err = jsoniter.Marshal(pos); err != nil {
+		return err
+	}
+
+	return r.withTx(ctx, func(tx transaction.Tx) error {
+		return r.saveJob(ctx, tx, tenant, job)
+	})
+}
+
+func (*Reindexer) saveJob(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant, job *metadata.JobMetadata) error {
+	return tenant.MetaStore.Job().Update(ctx, tx, tenant.GetNamespace().Id(), job)
+}
+
+func (r *Reindexer) withTx(ctx context.Context, fn func(tx transaction.Tx) error) error {
+	tx, err := r.txMgr.StartTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ReindexWorker runs the reindex jobs scheduled in the metadata queue. A job is leased while it runs and the lease is
+// renewed periodically, if the worker dies the lease expires and another worker resumes the job from its last
+// persisted position.
+type ReindexWorker struct {
+	txMgr     *transaction.Manager
+	tenantMgr *metadata.TenantManager
+	queue     *metadata.QueueSubspace
+	reindexer *Reindexer
+	cfg       *config.SearchReindexConfig
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+func NewReindexWorker(store search.Store, tenantMgr *metadata.TenantManager, txMgr *transaction.Manager, cfg *config.SearchReindexConfig) *ReindexWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &ReindexWorker{
+		txMgr:     txMgr,
+		tenantMgr: tenantMgr,
+		queue:     metadata.NewMetadataDictionary(metadata.DefaultNameRegistry).Queue(),
+		reindexer: NewReindexer(store, tenantMgr, txMgr),
+		cfg:       cfg,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (w *ReindexWorker) Start() {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-ticker.C:
+				if err := w.runNext(w.ctx); err != nil && w.ctx.Err() == nil {
+					log.Err(err).Msg("reindex worker failed to run the job")
+				}
+			}
+		}
+	}()
+}
+
+// Stop interrupts the running job and waits for the worker to exit. The interrupted job keeps its lease, it is
+// resumed from the last persisted position once the lease expires.
+func (w *ReindexWorker) Stop() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+// runNext claims the first ready reindex job and runs it.
+func (w *ReindexWorker) runNext(ctx context.Context) error {
+	item, task, err := w.claim(ctx)
+	if err != nil || item == nil {
+		return err
+	}
+
+	tenant, err := w.tenantMgr.GetTenant(ctx, task.Namespace)
+	if err != nil {
+		return err
+	}
+
+	job, err := w.getJob(ctx, tenant, task.ReindexJobId)
+	if err == errors.ErrNotFound {
+		// the job was removed, nothing to run
+		return w.complete(ctx, item)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = w.run(ctx, tenant, item, job); err != nil {
+		log.Err(err).Str("job", job.Id).Msg("reindex job failed")
+	}
+
+	return nil
+}
+
+// run builds the index of the leased job, the lease is renewed while the job is running and the queue item is
+// completed once the job reaches the terminal state.
+func (w *ReindexWorker) run(ctx context.Context, tenant *metadata.Tenant, item *metadata.QueueItem, job *metadata.JobMetadata) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		w.renewLease(runCtx, cancel, item)
+	}()
+
+	job, err := w.reindexer.Reindex(runCtx, tenant, job)
+	cancel()
+	<-renewed
+
+	if err != nil && !job.IsTerminal() {
+		// the lease expires and the job is resumed from the last persisted position
+		return err
+	}
+
+	if cerr := w.complete(ctx, item); cerr != nil {
+		log.Err(cerr).Str("job", job.Id).Msg("failed to complete reindex queue item")
+	}
+
+	return err
+}
+
+func (w *ReindexWorker) claim(ctx context.Context) (*metadata.QueueItem, *reindexTask, error) {
+	tx, err := w.txMgr.StartTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var task reindexTask
+	items, err := w.queue.PeekMatching(ctx, tx, 1, func(item *metadata.QueueItem) bool {
+		task = reindexTask{}
+		// the queue is shared with the other workers, their items are skipped
+		return jsoniter.Unmarshal(item.Data, &task) == nil && task.ReindexJobId != ""
+	})
+	if err != nil || len(items) == 0 {
+		return nil, nil, err
+	}
+
+	item, err := w.queue.ObtainLease(ctx, tx, &items[0], w.cfg.LeaseTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return item, &task, nil
+}
+
+func (w *ReindexWorker) renewLease(ctx context.Context, cancel context.CancelFunc, item *metadata.QueueItem) {
+	ticker := time.NewTicker(w.cfg.LeaseTime / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.reindexer.withTx(ctx, func(tx transaction.Tx) error {
+				return w.queue.RenewLease(ctx, tx, item, w.cfg.LeaseTime)
+			}); ulog.E(err) {
+				// the job may be claimed by another worker, stop reindexing
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func (w *ReindexWorker) complete(ctx context.Context, item *metadata.QueueItem) error {
+	return w.reindexer.withTx(ctx, func(tx transaction.Tx) error {
+		return w.queue.Complete(ctx, tx, item)
+	})
+}
+
+func (w *ReindexWorker) getJob(ctx context.Context, tenant *metadata.Tenant, id string) (job *metadata.JobMetadata, err error) {
+	err = w.reindexer.withTx(ctx, func(tx transaction.Tx) error {
+		job, err = tenant.MetaStore.Job().Get(ctx, tx, tenant.GetNamespace().Id(), id)
+		return err
+	})
+
+	return
+}
@@ -14,7 +14,11 @@
 
 package search
 
-import api "github.com/tigrisdata/tigris/api/server/v1"
+import (
+	"context"
+
+	api "github.com/tigrisdata/tigris/api/server/v1"
+)
 
 type Streaming interface {
 	api.Search_SearchServer
@@ -24,4 +28,8 @@ type Response struct {
 	api.Response
 
 	Status string
+
+	// postCommit is run by TxExecute once the transaction is committed, it is used to apply the changes to the
+	// search store that can't be rolled back with the transaction.
+	postCommit func(ctx context.Context) error
 }
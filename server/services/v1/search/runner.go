@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/buger/jsonparser"
 	jsoniter "github.com/json-iterator/go"
@@ -91,6 +92,27 @@ func (f *RunnerFactory) GetSearchRunner(r *api.SearchIndexRequest, streaming Str
 	}
 }
 
+func (f *RunnerFactory) GetMultiSearchRunner(r *api.MultiSearchRequest, accessToken *types.AccessToken) *MultiSearchRunner {
+	return &MultiSearchRunner{
+		baseRunner: newBaseRunner(f.store, f.encoder, f.txMgr, accessToken),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetReindexRunner(r *api.ReindexRequest, accessToken *types.AccessToken) *ReindexRunner {
+	return &ReindexRunner{
+		baseRunner: newBaseRunner(f.store, f.encoder, f.txMgr, accessToken),
+		req:        r,
+	}
+}
+
+func (f *RunnerFactory) GetReindexJobQueryRunner(r *api.GetReindexJobRequest, accessToken *types.AccessToken) *ReindexJobQueryRunner {
+	return &ReindexJobQueryRunner{
+		baseRunner: newBaseRunner(f.store, f.encoder, f.txMgr, accessToken),
+		req:        r,
+	}
+}
+
 func (f *RunnerFactory) GetCreateRunner(accessToken *types.AccessToken) *CreateRunner {
 	return &CreateRunner{
 		baseRunner: newBaseRunner(f.store, f.encoder, f.txMgr, accessToken),
@@ -582,62 +604,13 @@ func (runner *SearchRunner) Run(ctx context.Context, tenant *metadata.Tenant) (R
 		return Response{}, err
 	}
 
-	wrappedF, err := filter.NewFactory(index.QueryableFields, value.NewCollationFrom(runner.req.Collation)).WrappedFilter(runner.req.Filter)
+	searchQ, wrappedF, err := runner.buildQuery(index)
 	if err != nil {
 		return Response{}, err
 	}
-
-	facets, err := runner.getFacetFields(index)
-	if err != nil {
-		return Response{}, err
-	}
-
-	fieldSelection, err := runner.getFieldSelection(index)
-	if err != nil {
-		return Response{}, err
-	}
-
-	searchFields, err := runner.getSearchFields(index)
-	if err != nil {
-		return Response{}, err
-	}
-
-	sortOrder, err := runner.getSortOrdering(index)
-	if err != nil {
-		return Response{}, err
-	}
-
-	groupBy, err := runner.getGroupBy(index)
-	if err != nil {
-		return Response{}, err
-	}
-
-	vecSearch, err := runner.getVectorSearch(index)
-	if err != nil {
-		return Response{}, err
-	}
-
-	pageSize := int(runner.req.PageSize)
-	if pageSize == 0 {
-		pageSize = defaultPerPage
-	}
+	pageSize := searchQ.PageSize
 	var totalPages *int32
 
-	searchQ := qsearch.NewBuilder().
-		Query(runner.req.Q).
-		SearchFields(searchFields).
-		Facets(facets).
-		PageSize(pageSize).
-		Filter(wrappedF).
-		ReadFields(fieldSelection).
-		SortOrder(sortOrder).
-		GroupBy(groupBy).
-		VectorSearch(vecSearch).
-		Build()
-	if searchQ.IsQAndVectorBoth() {
-		return Response{}, errors.InvalidArgument("Currently either full text or vector search is supported")
-	}
-
 	searchReader := NewReader(ctx, runner.store, index, searchQ)
 	var iterator *FilterableSearchIterator
 	if runner.req.Page != 0 {
@@ -657,53 +630,8 @@ func (runner *SearchRunner) Run(ctx context.Context, tenant *metadata.Tenant) (R
 	matchedFields := container.NewHashSet()
 	for {
 		resp := &api.SearchIndexResponse{}
-		var rows ResultRow
-		for iterator.Next(&rows) {
-			var indexedDocs []*api.SearchHit
-			for _, row := range rows.Rows {
-				if searchQ.ReadFields != nil {
-					// apply field selection
-					newValue, err := searchQ.ReadFields.Apply(row.Document)
-					if ulog.E(err) {
-						return Response{}, err
-					}
-					row.Document = newValue
-				}
-
-				metadata := &api.SearchHitMeta{}
-				if row.CreatedAt != nil {
-					metadata.CreatedAt = row.CreatedAt.GetProtoTS()
-				}
-				if row.UpdatedAt != nil {
-					metadata.UpdatedAt = row.UpdatedAt.GetProtoTS()
-				}
-				metadata.Match = row.Match
-				if metadata.Match != nil {
-					for _, f := range metadata.Match.Fields {
-						if f != nil {
-							matchedFields.Insert(f.Name)
-						}
-					}
-				}
-
-				indexedDocs = append(indexedDocs, &api.SearchHit{
-					Data:     row.Document,
-					Metadata: metadata,
-				})
-			}
-
-			if len(rows.Group) > 0 {
-				resp.Group = append(resp.Group, &api.GroupedSearchHits{
-					GroupKeys: rows.Group,
-					Hits:      indexedDocs,
-				})
-			} else {
-				resp.Hits = append(resp.Hits, indexedDocs...)
-			}
-
-			if len(resp.Hits) == pageSize || len(resp.Group) == pageSize {
-				break
-			}
+		if err := fillPage(resp, iterator, searchQ, matchedFields); err != nil {
+			return Response{}, err
 		}
 
 		resp.Facets = iterator.getFacets()
@@ -744,33 +672,270 @@ func (runner *SearchRunner) Run(ctx context.Context, tenant *metadata.Tenant) (R
 	return Response{}, nil
 }
 
-func (runner *SearchRunner) getSearchFields(index *schema.SearchIndex) ([]string, error) {
-	searchFields := runner.req.SearchFields
-	if len(searchFields) == 0 {
+// fillPage reads the hits or the groups of a page from the iterator into the response.
+func fillPage(resp *api.SearchIndexResponse, iterator *FilterableSearchIterator, searchQ *qsearch.Query, matchedFields container.HashSet) error {
+	var rows ResultRow
+	for iterator.Next(&rows) {
+		var indexedDocs []*api.SearchHit
+		for _, row := range rows.Rows {
+			hit, err := toSearchHit(row, searchQ, matchedFields)
+			if err != nil {
+				return err
+			}
+			indexedDocs = append(indexedDocs, hit)
+		}
+
+		if len(rows.Group) > 0 {
+			resp.Group = append(resp.Group, &api.GroupedSearchHits{
+				GroupKeys: rows.Group,
+				Hits:      indexedDocs,
+			})
+		} else {
+			resp.Hits = append(resp.Hits, indexedDocs...)
+		}
+
+		if len(resp.Hits) == searchQ.PageSize || len(resp.Group) == searchQ.PageSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+// toSearchHit applies the field selection of the query on the row and collects the fields it matched on.
+func toSearchHit(row *Row, searchQ *qsearch.Query, matchedFields container.HashSet) (*api.SearchHit, error) {
+	if searchQ.ReadFields != nil {
+		// apply field selection
+		newValue, err := searchQ.ReadFields.Apply(row.Document)
+		if ulog.E(err) {
+			return nil, err
+		}
+		row.Document = newValue
+	}
+
+	metadata := &api.SearchHitMeta{}
+	if row.CreatedAt != nil {
+		metadata.CreatedAt = row.CreatedAt.GetProtoTS()
+	}
+	if row.UpdatedAt != nil {
+		metadata.UpdatedAt = row.UpdatedAt.GetProtoTS()
+	}
+	metadata.Match = row.Match
+	if metadata.Match != nil {
+		for _, f := range metadata.Match.Fields {
+			if f != nil {
+				matchedFields.Insert(f.Name)
+			}
+		}
+	}
+
+	return &api.SearchHit{
+		Data:     row.Document,
+		Metadata: metadata,
+	}, nil
+}
+
+// buildQuery validates the request against the index schema and builds the search query with its filter.
+func (runner *SearchRunner) buildQuery(index *schema.SearchIndex) (*qsearch.Query, *filter.WrappedFilter, error) {
+	wrappedF, err := filter.NewFactory(index.QueryableFields, value.NewCollationFrom(runner.req.Collation)).WrappedFilter(runner.req.Filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	facets, err := runner.getFacetFields(index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fieldSelection, err := runner.getFieldSelection(index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings, err := runner.getSettings(index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	searchFields, weights, err := runner.getSearchFields(index, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sortOrder, err := runner.getSortOrdering(index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupBy, err := runner.getGroupBy(index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vecSearch, err := runner.getVectorSearch(index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	highlight, err := qsearch.BuildHighlight(runner.req.Highlight, index.GetQueryableField)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ranking, err := runner.getRanking(index, settings, weights, len(vecSearch.VectorF) > 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ranking.HasDecay() && sortOrder == nil && len(groupBy.Fields) > 0 {
+		return nil, nil, errors.InvalidArgument("ranking rules with the decay are not supported with group by")
+	}
+
+	pageSize := int(runner.req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPerPage
+	}
+
+	searchQ := qsearch.NewBuilder().
+		Query(qsearch.RemoveStopWords(runner.req.Q, settings.StopWords)).
+		SearchFields(searchFields).
+		Facets(facets).
+		PageSize(pageSize).
+		Filter(wrappedF).
+		ReadFields(fieldSelection).
+		SortOrder(sortOrder).
+		GroupBy(groupBy).
+		VectorSearch(vecSearch).
+		Highlight(highlight).
+		Typo(&qsearch.TypoTolerance{
+			NumTypos:    settings.NumTypos,
+			Prefix:      settings.Prefix,
+			MinLen1Typo: settings.MinLen1Typo,
+			MinLen2Typo: settings.MinLen2Typo,
+		}).
+		Ranking(ranking).
+		Build()
+	if searchQ.IsQAndVectorBoth() && (searchQ.SortOrder != nil || searchQ.IsGroupByQuery()) {
+		return nil, nil, errors.InvalidArgument("sort and group by are not supported with the hybrid text and vector search")
+	}
+
+	return searchQ, wrappedF, nil
+}
+
+// getSearchFields returns the fields to search with their weights. A field is boosted in the request as "field^weight",
+// otherwise the weight is from the ranking settings and defaults to 1.
+func (runner *SearchRunner) getSearchFields(index *schema.SearchIndex, settings schema.SearchSettings) ([]string, []int, error) {
+	var configured map[string]int
+	if settings.Ranking != nil {
+		configured = settings.Ranking.Weights
+	}
+	weightOf := func(name string) int {
+		if w, ok := configured[name]; ok {
+			return w
+		}
+		return 1
+	}
+
+	var (
+		searchFields []string
+		weights      []int
+	)
+	if len(runner.req.SearchFields) == 0 {
 		// this is to include all searchable fields if not present in the query
 		for _, cf := range index.QueryableFields {
 			if cf.DataType == schema.StringType && cf.SearchIndexed {
 				searchFields = append(searchFields, cf.InMemoryName())
+				weights = append(weights, weightOf(cf.Name()))
 			}
 		}
 	} else {
-		for i, sf := range searchFields {
-			cf, err := index.GetQueryableField(sf)
+		for _, sf := range runner.req.SearchFields {
+			name, boost, err := qsearch.ParseFieldBoost(sf)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
+			}
+			cf, err := index.GetQueryableField(name)
+			if err != nil {
+				return nil, nil, err
 			}
 			if !cf.SearchIndexed {
-				return nil, errors.InvalidArgument("`%s` is not a searchable field. Only indexed fields can be queried", sf)
+				return nil, nil, errors.InvalidArgument("`%s` is not a searchable field. Only indexed fields can be queried", name)
 			}
 			if cf.SearchIndexed && (cf.DataType == schema.Int32Type || cf.DataType == schema.Int64Type || cf.DataType == schema.DoubleType) {
-				return nil, errors.InvalidArgument("`%s` is not a searchable field. Only indexed fields can be queried", sf)
+				return nil, nil, errors.InvalidArgument("`%s` is not a searchable field. Only indexed fields can be queried", name)
+			}
+			if !strings.Contains(sf, "^") {
+				boost = weightOf(name)
+			}
+			searchFields = append(searchFields, cf.InMemoryName())
+			weights = append(weights, boost)
+		}
+	}
+	return searchFields, weights, nil
+}
+
+// getSettings returns the index settings with the settings of the request applied on top of them.
+func (runner *SearchRunner) getSettings(index *schema.SearchIndex) (schema.SearchSettings, error) {
+	querySettings, err := schema.UnmarshalQuerySettings(runner.req.Settings)
+	if err != nil {
+		return schema.SearchSettings{}, err
+	}
+	if querySettings != nil && querySettings.Ranking != nil {
+		if err = querySettings.Ranking.ValidateFields(index.QueryableFields); err != nil {
+			return schema.SearchSettings{}, err
+		}
+	}
+
+	return index.Settings.Override(querySettings), nil
+}
+
+// getRanking converts the ranking settings to the ranking of the query. The rules are not applied to the vector search
+// as the hits are ranked by the vector distance, and an explicit sort in the request takes precedence over the rules.
+func (runner *SearchRunner) getRanking(index *schema.SearchIndex, settings schema.SearchSettings, weights []int, vectorSearch bool) (*qsearch.Ranking, error) {
+	ranking := &qsearch.Ranking{Weights: weights}
+	if !ranking.HasWeights() {
+		ranking.Weights = nil
+	}
+	if settings.Ranking == nil {
+		if ranking.Weights == nil {
+			return nil, nil
+		}
+		return ranking, nil
+	}
+
+	ranking.Buckets = settings.Ranking.Buckets
+	ranking.Pinned = settings.Ranking.Pinned
+	ranking.Hidden = settings.Ranking.Hidden
+	if vectorSearch {
+		return ranking, nil
+	}
+
+	now := time.Now()
+	for _, rule := range settings.Ranking.Rules {
+		cf, err := index.GetQueryableField(rule.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		queryRule := qsearch.RankingRule{
+			Field:     cf.InMemoryName(),
+			Ascending: rule.IsAscending(),
+		}
+		if rule.Decay != nil {
+			origin, scale, err := rule.Decay.Bounds(cf.DataType, now)
+			if err != nil {
+				return nil, err
 			}
-			if cf.InMemoryName() != cf.Name() {
-				searchFields[i] = cf.InMemoryName()
+			queryRule.Decay = &qsearch.Decay{
+				Function: rule.Decay.Function,
+				Origin:   origin,
+				Scale:    scale,
+				Decay:    rule.Decay.Decay,
 			}
 		}
+		ranking.Rules = append(ranking.Rules, queryRule)
 	}
-	return searchFields, nil
+
+	return ranking, nil
 }
 
 func (runner *SearchRunner) getFacetFields(index *schema.SearchIndex) (qsearch.Facets, error) {
@@ -844,6 +1009,12 @@ func (runner *SearchRunner) getSortOrdering(index *schema.SearchIndex) (*sort.Or
 			(*ordering)[i].Name = cf.InMemoryName()
 		}
 
+		if sf.Near != nil {
+			if cf.DataType != schema.GeoPointType || !cf.SearchIndexed {
+				return nil, errors.InvalidArgument("Cannot sort by distance on `%s` field. Only 'geopoint' fields are supported", sf.Name)
+			}
+			continue
+		}
 		if !cf.Sortable {
 			return nil, errors.InvalidArgument("Cannot sort on `%s` field", sf.Name)
 		}
@@ -895,6 +1066,7 @@ func (runner *SearchRunner) getVectorSearch(index *schema.SearchIndex) (qsearch.
 	if f.Dimensions != nil && *f.Dimensions != len(vectorSearch.VectorV) {
 		return qsearch.VectorSearch{}, errors.InvalidArgument("query vector is not same size as dimensions, expected size: %d", *f.Dimensions)
 	}
+	vectorSearch.Metric = f.Distance
 
 	return vectorSearch, nil
 }
@@ -906,6 +1078,14 @@ type IndexRunner struct {
 	get    *api.GetIndexRequest
 	delete *api.DeleteIndexRequest
 	list   *api.ListIndexesRequest
+
+	upsertSynonym *api.UpsertSynonymRequest
+	deleteSynonym *api.DeleteSynonymRequest
+	listSynonyms  *api.ListSynonymsRequest
+
+	createAlias *api.CreateOrUpdateAliasRequest
+	deleteAlias *api.DeleteAliasRequest
+	listAliases *api.ListAliasesRequest
 }
 
 func (runner *IndexRunner) SetCreateIndexReq(create *api.CreateOrUpdateIndexRequest) {
@@ -924,6 +1104,30 @@ func (runner *IndexRunner) SetListIndexesReq(list *api.ListIndexesRequest) {
 	runner.list = list
 }
 
+func (runner *IndexRunner) SetUpsertSynonymReq(upsert *api.UpsertSynonymRequest) {
+	runner.upsertSynonym = upsert
+}
+
+func (runner *IndexRunner) SetDeleteSynonymReq(drop *api.DeleteSynonymRequest) {
+	runner.deleteSynonym = drop
+}
+
+func (runner *IndexRunner) SetListSynonymsReq(list *api.ListSynonymsRequest) {
+	runner.listSynonyms = list
+}
+
+func (runner *IndexRunner) SetCreateOrUpdateAliasReq(create *api.CreateOrUpdateAliasRequest) {
+	runner.createAlias = create
+}
+
+func (runner *IndexRunner) SetDeleteAliasReq(drop *api.DeleteAliasRequest) {
+	runner.deleteAlias = drop
+}
+
+func (runner *IndexRunner) SetListAliasesReq(list *api.ListAliasesRequest) {
+	runner.listAliases = list
+}
+
 func (runner *IndexRunner) Run(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant) (Response, error) {
 	currentSub, err := request.GetCurrentSub(ctx)
 	if err != nil && config.DefaultConfig.Auth.Enabled {
@@ -943,12 +1147,18 @@ func (runner *IndexRunner) Run(ctx context.Context, tx transaction.Tx, tenant *m
 			return Response{}, createApiError(err)
 		}
 		factory.Sub = currentSub
+
+		var previous []schema.SearchSynonym
+		if index, err := tenant.GetSearchIndex(ctx, tx, project, factory.Name); err == nil {
+			previous = index.Settings.Synonyms
+		}
 		if err = tenant.CreateSearchIndex(ctx, tx, project, factory); err != nil {
 			return Response{}, createApiError(err)
 		}
 
 		return Response{
-			Status: database.CreatedStatus,
+			Status:     database.CreatedStatus,
+			postCommit: syncSynonyms(tenant, project, factory.Name, previous),
 		}, nil
 	case runner.get != nil:
 		project, err := tenant.GetProject(runner.get.GetProject())
@@ -1023,7 +1233,144 @@ func (runner *IndexRunner) Run(ctx context.Context, tx transaction.Tx, tenant *m
 				Indexes: indexesResp,
 			},
 		}, nil
+	case runner.upsertSynonym != nil:
+		project, index, err := runner.getIndexForSynonyms(ctx, tx, tenant, runner.upsertSynonym.GetProject(), runner.upsertSynonym.GetIndex())
+		if err != nil {
+			return Response{}, err
+		}
+
+		settings := index.Settings.WithSynonym(schema.SearchSynonym{
+			Id:       runner.upsertSynonym.GetId(),
+			Root:     runner.upsertSynonym.GetRoot(),
+			Synonyms: runner.upsertSynonym.GetSynonyms(),
+		})
+		if err = tenant.UpdateSearchIndexSettings(ctx, tx, project, index.Name, settings); err != nil {
+			return Response{}, createApiError(err)
+		}
+
+		return Response{
+			Status:     database.UpdatedStatus,
+			postCommit: syncSynonyms(tenant, project, index.Name, index.Settings.Synonyms),
+		}, nil
+	case runner.deleteSynonym != nil:
+		project, index, err := runner.getIndexForSynonyms(ctx, tx, tenant, runner.deleteSynonym.GetProject(), runner.deleteSynonym.GetIndex())
+		if err != nil {
+			return Response{}, err
+		}
+		if _, found := index.Settings.GetSynonym(runner.deleteSynonym.GetId()); !found {
+			return Response{}, errors.NotFound("synonym set '%s' not found", runner.deleteSynonym.GetId())
+		}
+
+		settings := index.Settings.WithoutSynonym(runner.deleteSynonym.GetId())
+		if err = tenant.UpdateSearchIndexSettings(ctx, tx, project, index.Name, settings); err != nil {
+			return Response{}, createApiError(err)
+		}
+
+		return Response{
+			Status:     database.DeletedStatus,
+			postCommit: syncSynonyms(tenant, project, index.Name, index.Settings.Synonyms),
+		}, nil
+	case runner.listSynonyms != nil:
+		_, index, err := runner.getIndexForSynonyms(ctx, tx, tenant, runner.listSynonyms.GetProject(), runner.listSynonyms.GetIndex())
+		if err != nil {
+			return Response{}, err
+		}
+
+		synonyms := make([]*api.Synonym, 0, len(index.Settings.Synonyms))
+		for _, syn := range index.Settings.Synonyms {
+			synonyms = append(synonyms, &api.Synonym{
+				Id:       syn.Id,
+				Root:     syn.Root,
+				Synonyms: syn.Synonyms,
+			})
+		}
+
+		return Response{
+			Response: &api.ListSynonymsResponse{
+				Synonyms: synonyms,
+			},
+		}, nil
+	case runner.createAlias != nil:
+		project, err := tenant.GetProject(runner.createAlias.GetProject())
+		if err != nil {
+			return Response{}, createApiError(err)
+		}
+		if len(runner.createAlias.GetName()) == 0 || len(runner.createAlias.GetIndex()) == 0 {
+			return Response{}, errors.InvalidArgument("alias name and index are required")
+		}
+		if err = tenant.CreateOrUpdateSearchAlias(ctx, tx, project, runner.createAlias.GetName(), runner.createAlias.GetIndex(), currentSub); err != nil {
+			return Response{}, createApiError(err)
+		}
+
+		return Response{
+			Status: database.UpdatedStatus,
+		}, nil
+	case runner.deleteAlias != nil:
+		project, err := tenant.GetProject(runner.deleteAlias.GetProject())
+		if err != nil {
+			return Response{}, createApiError(err)
+		}
+		if err = tenant.DeleteSearchAlias(ctx, tx, project, runner.deleteAlias.GetName()); err != nil {
+			return Response{}, createApiError(err)
+		}
+
+		return Response{
+			Status: database.DeletedStatus,
+		}, nil
+	case runner.listAliases != nil:
+		project, err := tenant.GetProject(runner.listAliases.GetProject())
+		if err != nil {
+			return Response{}, createApiError(err)
+		}
+
+		aliases, err := tenant.ListSearchAliases(ctx, tx, project)
+		if err != nil {
+			return Response{}, createApiError(err)
+		}
+
+		aliasesResp := make([]*api.AliasInfo, 0, len(aliases))
+		for _, alias := range aliases {
+			aliasesResp = append(aliasesResp, &api.AliasInfo{
+				Name:      alias.Name,
+				Index:     alias.Index,
+				CreatedAt: alias.CreatedAt,
+				UpdatedAt: alias.UpdatedAt,
+			})
+		}
+
+		return Response{
+			Response: &api.ListAliasesResponse{
+				Aliases: aliasesResp,
+			},
+		}, nil
 	}
 
 	return Response{}, nil
 }
+
+func (*IndexRunner) getIndexForSynonyms(ctx context.Context, tx transaction.Tx, tenant *metadata.Tenant, projectName string, indexName string) (*metadata.Project, *schema.SearchIndex, error) {
+	project, err := tenant.GetProject(projectName)
+	if err != nil {
+		return nil, nil, createApiError(err)
+	}
+
+	index, err := tenant.GetSearchIndex(ctx, tx, project, indexName)
+	if err != nil {
+		return nil, nil, createApiError(err)
+	}
+
+	return project, index, nil
+}
+
+// syncSynonyms returns the post commit hook pushing the synonyms of the committed index to the search store, the
+// previous synonyms are the ones the index had before the transaction.
+func syncSynonyms(tenant *metadata.Tenant, project *metadata.Project, indexName string, previous []schema.SearchSynonym) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		index, err := tenant.GetSearchIndex(ctx, nil, project, indexName)
+		if err != nil {
+			return err
+		}
+
+		return tenant.SyncSearchSynonyms(ctx, index.StoreIndexName(), previous, index.Settings.Synonyms)
+	}
+}
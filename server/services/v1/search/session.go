@@ -114,5 +114,10 @@ func (sessions *SessionManager) TxExecute(ctx context.Context, runner TxRunner)
 	if err = tx.Commit(ctx); err != nil {
 		return Response{}, createApiError(err)
 	}
+	if resp.postCommit != nil {
+		if err = resp.postCommit(ctx); ulog.E(err) {
+			return Response{}, createApiError(err)
+		}
+	}
 	return resp, nil
 }
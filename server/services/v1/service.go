@@ -34,6 +34,11 @@ type Service interface {
 	RegisterGRPC(grpc *grpc.Server) error
 }
 
+// Stopper is implemented by the services running background workers, the workers are stopped on the server shutdown.
+type Stopper interface {
+	Stop()
+}
+
 func GetRegisteredServicesRealtime(kvStore kv.TxStore, searchStore search.Store, tenantMgr *metadata.TenantManager, txMgr *transaction.Manager) []Service {
 	var v1Services []Service
 	v1Services = append(v1Services, newRealtimeService(kvStore, searchStore, tenantMgr, txMgr))
@@ -49,13 +54,14 @@ func GetRegisteredServices(kvStore kv.TxStore, searchStore search.Store, tenantM
 	userStore := metadata.NewUserStore(metadata.DefaultNameRegistry)
 
 	authProvider := auth.NewProvider(userStore, txMgr)
-	v1Services = append(v1Services, newApiService(kvStore, searchStore, tenantMgr, txMgr, authProvider))
+	apiSvc := newApiService(kvStore, searchStore, tenantMgr, txMgr, authProvider)
+	v1Services = append(v1Services, apiSvc)
 
 	if config.DefaultConfig.Auth.EnableOauth {
 		v1Services = append(v1Services, newAuthService(authProvider, auth.NewDefaultUsersManager(tenantMgr)))
 	}
 	if config.DefaultConfig.Management.Enabled {
-		v1Services = append(v1Services, newManagementService(authProvider, txMgr, tenantMgr, userStore, tenantMgr.GetNamespaceStore(), bProvider))
+		v1Services = append(v1Services, newManagementService(authProvider, txMgr, tenantMgr, userStore, tenantMgr.GetNamespaceStore(), bProvider, apiSvc.sessions))
 	}
 
 	v1Services = append(v1Services, newObservabilityService(tenantMgr))
@@ -33,6 +33,12 @@ var (
 
 	// ErrSessionIsGone is returned when the session is gone but getting used.
 	ErrSessionIsGone = errors.Internal("session is gone")
+
+	// ErrSessionIdleTimeout is returned when the session was rolled back by the server because it was idle for too long.
+	ErrSessionIdleTimeout = errors.Aborted("transaction was rolled back because it was idle longer than the idle timeout")
+
+	// ErrSessionKilled is returned when the session was rolled back by the administrator.
+	ErrSessionKilled = errors.Aborted("transaction was rolled back by the administrator")
 )
 
 // BaseTx interface exposes base methods that can be used on a transactional object.
@@ -50,6 +56,7 @@ type BaseTx interface {
 	AtomicAdd(ctx context.Context, key keys.Key, value int64) error
 	AtomicRead(ctx context.Context, key keys.Key) (int64, error)
 	RangeSize(ctx context.Context, table []byte, lKey keys.Key, rKey keys.Key) (size int64, err error)
+	GetReadVersion(ctx context.Context) (int64, error)
 }
 
 type Tx interface {
@@ -299,6 +306,18 @@ func (s *TxSession) RangeSize(ctx context.Context, _ []byte, lKey keys.Key, rKey
 	return s.kTx.RangeSize(ctx, rKey.Table(), nil, kv.BuildKey(rKey.IndexParts()...))
 }
 
+// GetReadVersion returns the version at which the reads of the session are performed.
+func (s *TxSession) GetReadVersion(ctx context.Context) (int64, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if err := s.validateSession(); err != nil {
+		return 0, err
+	}
+
+	return s.kTx.GetReadVersion(ctx)
+}
+
 func (s *TxSession) Commit(ctx context.Context) error {
 	s.Lock()
 	defer s.Unlock()
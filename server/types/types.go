@@ -30,4 +30,22 @@ var MyOrigin, _ = os.Hostname()
 type AccessToken struct {
 	Namespace string
 	Sub       string
+	// Realtime is set for the tokens issued to the realtime clients, it restricts the token to the channels of a
+	// project
+	Realtime *RealtimeAccess
+}
+
+// RealtimeAccess is the realtime claim of an access token. The client is allowed to perform only the operations of
+// the capabilities on the channels matching their patterns.
+type RealtimeAccess struct {
+	Project      string              `json:"p"`
+	ClientId     string              `json:"cid,omitempty"`
+	Capabilities []ChannelCapability `json:"c"`
+}
+
+// ChannelCapability allows the operations on the channels matching the pattern. A pattern ending with "*" matches
+// the channels with the prefix.
+type ChannelCapability struct {
+	Channel    string   `json:"ch"`
+	Operations []string `json:"op"`
 }
@@ -15,18 +15,28 @@
 package cache
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
-	"time"
+	"sync"
 
 	xredis "github.com/go-redis/redis/v8"
 	"github.com/tigrisdata/tigris/internal"
 	"github.com/tigrisdata/tigris/server/config"
+	ulog "github.com/tigrisdata/tigris/util/log"
 )
 
+// maxCompareAndSetRetries is the number of times the compare and set is retried when the key is modified concurrently.
+const maxCompareAndSetRetries = 8
+
 type cache struct {
 	*xredis.Client
+
+	limitsMu sync.Mutex
+	limits   map[string]*cachedLimits
 }
 
 func newCache(cfg *config.CacheConfig) *cache {
@@ -37,60 +47,68 @@ func newCache(cfg *config.CacheConfig) *cache {
 
 	return &cache{
 		Client: rdb,
+		limits: make(map[string]*cachedLimits),
 	}
 }
 
 func (c *cache) Set(ctx context.Context, tableName string, key string, value *internal.CacheData, options *SetOptions) error {
 	cacheKey := encodeToCacheKey(tableName, key)
-	enc, err := internal.EncodeCacheData(value)
+	enc, err := encodeCacheValue(value)
 	if err != nil {
 		return err
 	}
 
-	args := xredis.SetArgs{}
+	if err = c.admit(ctx, tableName, key); err != nil {
+		return err
+	}
 
-	if options != nil && options.EX > 0 {
-		args.TTL = time.Duration(options.EX) * time.Second
-	} else if options != nil && options.PX > 0 {
-		args.TTL = time.Duration(options.PX) * time.Millisecond
+	args := xredis.SetArgs{
+		TTL: options.ttl(),
 	}
 
-	if options != nil && options.XX {
+	switch {
+	case options != nil && options.XX:
 		args.Mode = "XX"
-
-		if _, err = c.Client.SetArgs(ctx, cacheKey, enc, args).Result(); err != nil && err == xredis.Nil {
-			return ErrKeyNotFound
-		}
-		return err
-	} else if options != nil && options.NX {
+	case options != nil && options.NX:
 		args.Mode = "NX"
+	}
 
-		if _, err = c.Client.SetArgs(ctx, cacheKey, enc, args).Result(); err != nil && err == xredis.Nil {
-			return ErrKeyAlreadyExists
-		}
+	_, err = c.Client.SetArgs(ctx, cacheKey, enc, args).Result()
+	switch {
+	case err == xredis.Nil && args.Mode == "XX":
+		return ErrKeyNotFound
+	case err == xredis.Nil:
+		return ErrKeyAlreadyExists
+	case err != nil:
 		return err
 	}
 
-	_, err = c.Client.SetArgs(ctx, cacheKey, enc, args).Result()
-	return err
+	return c.track(ctx, tableName, key)
 }
 
 func (c *cache) GetSet(ctx context.Context, tableName string, key string, value *internal.CacheData) (*internal.CacheData, error) {
 	cacheKey := encodeToCacheKey(tableName, key)
-	enc, err := internal.EncodeCacheData(value)
+	enc, err := encodeCacheValue(value)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = c.admit(ctx, tableName, key); err != nil {
+		return nil, err
+	}
+
 	val, err := c.Client.GetSet(ctx, cacheKey, enc).Bytes()
 	if err != nil && err != xredis.Nil {
+		return nil, toCacheError(err)
+	}
+	if err = c.track(ctx, tableName, key); err != nil {
 		return nil, err
 	}
 	if len(val) == 0 {
 		return nil, nil
 	}
 
-	return internal.DecodeCacheData(val)
+	return decodeCacheValue(val)
 }
 
 func (c *cache) Get(ctx context.Context, tableName string, key string, options *GetOptions) (*internal.CacheData, error) {
@@ -109,13 +127,20 @@ func (c *cache) Get(ctx context.Context, tableName string, key string, options *
 		value, err = c.Client.Get(ctx, cacheKey).Bytes()
 	}
 	if err != nil && err == xredis.Nil {
+		c.touchKey(ctx, tableName, key, false)
 		return nil, ErrKeyNotFound
 	}
 	if err != nil {
-		return nil, err
+		return nil, toCacheError(err)
 	}
 
-	return internal.DecodeCacheData(value)
+	c.touchKey(ctx, tableName, key, true)
+	if options != nil && (options.GetDelete || options.Expiry > 0) {
+		// the value is already read, so a failure is only logged, the key is forgotten by its next miss or expiry
+		_ = ulog.E(c.track(ctx, tableName, key))
+	}
+
+	return decodeCacheValue(value)
 }
 
 func (c *cache) Delete(ctx context.Context, tableName string, keys ...string) (int64, error) {
@@ -128,7 +153,36 @@ func (c *cache) Delete(ctx context.Context, tableName string, keys ...string) (i
 		cacheKeys[i] = encodeToCacheKey(tableName, k)
 	}
 
-	return c.Client.Del(ctx, cacheKeys...).Result()
+	deleted, err := c.Client.Del(ctx, cacheKeys...).Result()
+	if err != nil {
+		return 0, err
+	}
+	if err = c.track(ctx, tableName, keys...); err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+func (c *cache) Unlink(ctx context.Context, tableName string, keys ...string) (int64, error) {
+	if len(keys) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	cacheKeys := make([]string, len(keys))
+	for i, k := range keys {
+		cacheKeys[i] = encodeToCacheKey(tableName, k)
+	}
+
+	unlinked, err := c.Client.Unlink(ctx, cacheKeys...).Result()
+	if err != nil {
+		return 0, err
+	}
+	if err = c.track(ctx, tableName, keys...); err != nil {
+		return 0, err
+	}
+
+	return unlinked, nil
 }
 
 func (c *cache) Exists(ctx context.Context, tableName string, keys ...string) (int64, error) {
@@ -148,12 +202,207 @@ func (c *cache) Keys(ctx context.Context, tableName string, pattern string) ([]s
 	return c.Client.Keys(ctx, encodeToCacheKey(tableName, pattern)).Result()
 }
 
-func (c *cache) Scan(ctx context.Context, tableName string, cursor uint64, count int64, pattern string) ([]string, uint64) {
+func (c *cache) Scan(ctx context.Context, tableName string, cursor uint64, count int64, pattern string) ([]string, uint64, error) {
 	if count > config.DefaultConfig.Cache.MaxScan {
 		count = config.DefaultConfig.Cache.MaxScan
 	}
-	scanCmd := c.Client.Scan(ctx, cursor, encodeToCacheKey(tableName, pattern), count)
-	return scanCmd.Val()
+
+	keys, next, err := c.Client.Scan(ctx, cursor, encodeToCacheKey(tableName, pattern), count).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return keys, next, nil
+}
+
+func (c *cache) MGet(ctx context.Context, tableName string, keys ...string) ([]*internal.CacheData, error) {
+	if len(keys) == 0 {
+		return nil, ErrEmptyKey
+	}
+
+	cacheKeys := make([]string, len(keys))
+	for i, k := range keys {
+		cacheKeys[i] = encodeToCacheKey(tableName, k)
+	}
+
+	values, err := c.Client.MGet(ctx, cacheKeys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var hits, misses []string
+	result := make([]*internal.CacheData, len(values))
+	for i, v := range values {
+		enc, ok := v.(string)
+		if !ok {
+			misses = append(misses, keys[i])
+			continue
+		}
+
+		hits = append(hits, keys[i])
+		if result[i], err = decodeCacheValue([]byte(enc)); err != nil {
+			return nil, err
+		}
+	}
+	c.touch(ctx, tableName, hits, misses)
+
+	return result, nil
+}
+
+func (c *cache) MSet(ctx context.Context, tableName string, values map[string]*internal.CacheData) error {
+	if len(values) == 0 {
+		return ErrEmptyKey
+	}
+
+	keys := make([]string, 0, len(values))
+	pairs := make([]any, 0, 2*len(values))
+	for k, v := range values {
+		enc, err := encodeCacheValue(v)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, k)
+		pairs = append(pairs, encodeToCacheKey(tableName, k), enc)
+	}
+
+	// the keys are admitted, written and accounted by separate commands, so the concurrent writes may exceed the
+	// limits of the table
+	if err := c.admit(ctx, tableName, keys...); err != nil {
+		return err
+	}
+	if _, err := c.Client.MSet(ctx, pairs...).Result(); err != nil {
+		return err
+	}
+
+	return c.track(ctx, tableName, keys...)
+}
+
+// incrScript increments the counter and sets the expiry only if the counter doesn't have one, so the expiry starts
+// when the counter is created.
+var incrScript = xredis.NewScript(`
+local v = redis.call('INCRBY', KEYS[1], ARGV[1])
+if tonumber(ARGV[2]) > 0 and redis.call('PTTL', KEYS[1]) == -1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return v
+`)
+
+func (c *cache) Incr(ctx context.Context, tableName string, key string, delta int64, options *IncrOptions) (int64, error) {
+	cacheKey := encodeToCacheKey(tableName, key)
+	if err := c.admit(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	value, err := incrScript.Run(ctx, c.Client, []string{cacheKey}, delta, options.ttl().Milliseconds()).Int64()
+	if err != nil && strings.Contains(err.Error(), errStrNotInteger) {
+		// the integer set as a value is encoded, it is converted to a counter first
+		value, err = c.incrEncoded(ctx, cacheKey, delta, options)
+	}
+	if err != nil {
+		return 0, toCacheError(err)
+	}
+	if err = c.track(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	return value, nil
+}
+
+// incrEncoded increments the integer value set by Set, the value is replaced by the counter if the key is not modified
+// after it is read.
+func (c *cache) incrEncoded(ctx context.Context, cacheKey string, delta int64, options *IncrOptions) (int64, error) {
+	var counter int64
+	incr := func(tx *xredis.Tx) error {
+		current, err := tx.Get(ctx, cacheKey).Bytes()
+		if err != nil {
+			return err
+		}
+
+		var ok bool
+		if counter, ok = counterValue(current); !ok || overflows(counter, delta) {
+			return ErrNotInteger
+		}
+		counter += delta
+
+		expiry, err := tx.PTTL(ctx, cacheKey).Result()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe xredis.Pipeliner) error {
+			pipe.SetArgs(ctx, cacheKey, strconv.FormatInt(counter, 10), xredis.SetArgs{KeepTTL: true})
+			// the expiry is only set if the key doesn't have one, as by incrScript
+			if ttl := options.ttl(); ttl > 0 && expiry < 0 {
+				pipe.PExpire(ctx, cacheKey, ttl)
+			}
+			return nil
+		})
+		return err
+	}
+
+	for i := 0; i < maxCompareAndSetRetries; i++ {
+		err := c.Client.Watch(ctx, incr, cacheKey)
+		switch {
+		case err == nil:
+			return counter, nil
+		case err == xredis.Nil:
+			// removed meanwhile, the counter starts from zero
+			return incrScript.Run(ctx, c.Client, []string{cacheKey}, delta, options.ttl().Milliseconds()).Int64()
+		case err != xredis.TxFailedErr:
+			return 0, err
+		}
+	}
+
+	return 0, ErrConflict
+}
+
+func (c *cache) Decr(ctx context.Context, tableName string, key string, delta int64, options *IncrOptions) (int64, error) {
+	return c.Incr(ctx, tableName, key, -delta, options)
+}
+
+// CompareAndSet watches the key so that the value is only set if the key is not modified after the comparison, the
+// comparison is retried if the key is modified concurrently.
+func (c *cache) CompareAndSet(ctx context.Context, tableName string, key string, expected []byte, value *internal.CacheData, options *SetOptions) (bool, error) {
+	cacheKey := encodeToCacheKey(tableName, key)
+	enc, err := encodeCacheValue(value)
+	if err != nil {
+		return false, err
+	}
+	if err = c.admit(ctx, tableName, key); err != nil {
+		return false, err
+	}
+
+	swapped := false
+	compareAndSet := func(tx *xredis.Tx) error {
+		current, err := tx.Get(ctx, cacheKey).Bytes()
+		if err != nil && err != xredis.Nil {
+			return err
+		}
+
+		if matches, err := matchesExpected(current, err != xredis.Nil, expected); err != nil || !matches {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe xredis.Pipeliner) error {
+			pipe.Set(ctx, cacheKey, enc, options.ttl())
+			return nil
+		})
+		swapped = err == nil
+		return err
+	}
+
+	for i := 0; i < maxCompareAndSetRetries; i++ {
+		if err = c.Client.Watch(ctx, compareAndSet, cacheKey); err != xredis.TxFailedErr {
+			if swapped {
+				if err := c.track(ctx, tableName, key); err != nil {
+					return false, err
+				}
+			}
+			return swapped, toCacheError(err)
+		}
+	}
+
+	return false, ErrConflict
 }
 
 func (c *cache) ListStreams(ctx context.Context, streamNamePrefix string) ([]string, error) {
@@ -202,6 +451,72 @@ func (c *cache) CreateStream(ctx context.Context, streamName string) (Stream, er
 	return NewStream(c, streamName), nil
 }
 
+// encodeCacheValue encodes the value set by the user, so it is returned byte for byte whatever it contains.
+func encodeCacheValue(value *internal.CacheData) ([]byte, error) {
+	return internal.EncodeCacheData(value)
+}
+
+// decodeCacheValue decodes the stored value. The counters of Incr are stored as plain numbers so that they are
+// incremented atomically by the cache, they are told apart from the encoded values by the type of the encoding.
+func decodeCacheValue(enc []byte) (*internal.CacheData, error) {
+	if isCounter(enc) {
+		return &internal.CacheData{RawData: enc}, nil
+	}
+
+	return internal.DecodeCacheData(enc)
+}
+
+// isCounter returns true if the stored value is a counter of Incr, an encoded value starts with the type of the
+// encoding which is never the first byte of a number.
+func isCounter(enc []byte) bool {
+	return len(enc) > 0 && enc[0] != internal.CacheDataType
+}
+
+// counterValue returns the integer of the stored value, either a counter of Incr or an integer in the canonical form
+// set by Set.
+func counterValue(enc []byte) (int64, bool) {
+	if isCounter(enc) {
+		return parseCounter(enc)
+	}
+
+	data, err := internal.DecodeCacheData(enc)
+	if err != nil {
+		return 0, false
+	}
+
+	return parseCounter(data.GetRawData())
+}
+
+// parseCounter returns the integer if the value is an integer in the canonical form.
+func parseCounter(value []byte) (int64, bool) {
+	counter, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil || strconv.FormatInt(counter, 10) != string(value) {
+		return 0, false
+	}
+
+	return counter, true
+}
+
+// overflows returns true if adding the delta to the counter overflows.
+func overflows(counter int64, delta int64) bool {
+	return (delta > 0 && counter > math.MaxInt64-delta) || (delta < 0 && counter < math.MinInt64-delta)
+}
+
+// matchesExpected compares the current encoded value of the key with the expected raw value, a nil expected value
+// matches a missing key.
+func matchesExpected(current []byte, exists bool, expected []byte) (bool, error) {
+	if expected == nil || !exists {
+		return expected == nil && !exists, nil
+	}
+
+	data, err := decodeCacheValue(current)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(data.GetRawData(), expected), nil
+}
+
 func encodeToCacheKey(tableName string, key string) string {
 	return tableName + ":" + key
 }
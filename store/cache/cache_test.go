@@ -17,6 +17,7 @@ package cache
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"testing"
 
@@ -25,7 +26,7 @@ import (
 	"github.com/tigrisdata/tigris/server/config"
 )
 
-func dropCacheTable(t *testing.T, c *cache, tableName string) {
+func dropCacheTable(t *testing.T, c Cache, tableName string) {
 	keys, err := c.Keys(context.TODO(), tableName, "*")
 	require.NoError(t, err)
 
@@ -37,7 +38,14 @@ func dropCacheTable(t *testing.T, c *cache, tableName string) {
 }
 
 func TestRedis(t *testing.T) {
-	c := newCache(config.GetTestCacheConfig())
+	testCache(t, newCache(config.GetTestCacheConfig()))
+}
+
+func TestMemoryCache(t *testing.T) {
+	testCache(t, newMemCache())
+}
+
+func testCache(t *testing.T, c Cache) {
 	ctx := context.TODO()
 	tableName := "cache_test"
 
@@ -110,6 +118,22 @@ func TestRedis(t *testing.T) {
 		require.Equal(t, ErrKeyNotFound, err)
 	})
 
+	t.Run("unlink", func(t *testing.T) {
+		defer dropCacheTable(t, c, tableName)
+
+		s1 := []byte(`{"a": "b"}`)
+		require.NoError(t, c.Set(ctx, tableName, "key1", internal.NewCacheData(s1), nil))
+		require.NoError(t, c.Set(ctx, tableName, "key2", internal.NewCacheData(s1), nil))
+
+		unlinked, err := c.Unlink(ctx, tableName, "key1", "key2", "key3")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), unlinked)
+
+		exists, err := c.Exists(ctx, tableName, "key1", "key2")
+		require.NoError(t, err)
+		require.Equal(t, int64(0), exists)
+	})
+
 	t.Run("keys", func(t *testing.T) {
 		defer dropCacheTable(t, c, tableName)
 
@@ -123,6 +147,184 @@ func TestRedis(t *testing.T) {
 		require.Equal(t, []string{encodeToCacheKey(tableName, "key1"), encodeToCacheKey(tableName, "key2")}, keys)
 	})
 
+	t.Run("mget_mset", func(t *testing.T) {
+		defer dropCacheTable(t, c, tableName)
+
+		s1, s2 := []byte(`{"a": "b"}`), []byte(`10`)
+		require.NoError(t, c.MSet(ctx, tableName, map[string]*internal.CacheData{
+			"key1": internal.NewCacheData(s1),
+			"key2": internal.NewCacheData(s2),
+		}))
+
+		values, err := c.MGet(ctx, tableName, "key2", "missing", "key1")
+		require.NoError(t, err)
+		require.Len(t, values, 3)
+		require.Equal(t, s2, values[0].RawData)
+		require.Nil(t, values[1])
+		require.Equal(t, s1, values[2].RawData)
+
+		_, err = c.MGet(ctx, tableName)
+		require.Equal(t, ErrEmptyKey, err)
+	})
+
+	t.Run("incr_decr", func(t *testing.T) {
+		defer dropCacheTable(t, c, tableName)
+
+		value, err := c.Incr(ctx, tableName, "counter", 5, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(5), value)
+		value, err = c.Decr(ctx, tableName, "counter", 7, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(-2), value)
+
+		g, err := c.Get(ctx, tableName, "counter", nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte(`-2`), g.RawData)
+
+		// the counter set as a value can be incremented
+		require.NoError(t, c.Set(ctx, tableName, "set_counter", internal.NewCacheData([]byte(`41`)), nil))
+		value, err = c.Incr(ctx, tableName, "set_counter", 1, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), value)
+
+		require.NoError(t, c.Set(ctx, tableName, "doc", internal.NewCacheData([]byte(`{"a": 1}`)), nil))
+		_, err = c.Incr(ctx, tableName, "doc", 1, nil)
+		require.Equal(t, ErrNotInteger, err)
+
+		// the values looking like numbers are returned as they are set
+		for _, v := range []string{`00123`, ` 7`, `8`} {
+			require.NoError(t, c.Set(ctx, tableName, "number", internal.NewCacheData([]byte(v)), nil))
+			g, err = c.Get(ctx, tableName, "number", nil)
+			require.NoError(t, err)
+			require.Equal(t, []byte(v), g.RawData)
+		}
+	})
+
+	t.Run("compare_and_set", func(t *testing.T) {
+		defer dropCacheTable(t, c, tableName)
+
+		s1, s2 := []byte(`{"v": 1}`), []byte(`{"v": 2}`)
+		swapped, err := c.CompareAndSet(ctx, tableName, "key1", nil, internal.NewCacheData(s1), nil)
+		require.NoError(t, err)
+		require.True(t, swapped)
+
+		swapped, err = c.CompareAndSet(ctx, tableName, "key1", nil, internal.NewCacheData(s2), nil)
+		require.NoError(t, err)
+		require.False(t, swapped)
+
+		swapped, err = c.CompareAndSet(ctx, tableName, "key1", s2, internal.NewCacheData(s2), nil)
+		require.NoError(t, err)
+		require.False(t, swapped)
+
+		swapped, err = c.CompareAndSet(ctx, tableName, "key1", s1, internal.NewCacheData(s2), nil)
+		require.NoError(t, err)
+		require.True(t, swapped)
+
+		g, err := c.Get(ctx, tableName, "key1", nil)
+		require.NoError(t, err)
+		require.Equal(t, s2, g.RawData)
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		defer dropCacheTable(t, c, tableName)
+
+		added, err := c.HSet(ctx, tableName, "user1", map[string][]byte{"name": []byte(`"alice"`), "age": []byte(`30`)})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), added)
+		added, err = c.HSet(ctx, tableName, "user1", map[string][]byte{"age": []byte(`31`), "city": []byte(`"sf"`)})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), added)
+
+		value, err := c.HGet(ctx, tableName, "user1", "age")
+		require.NoError(t, err)
+		require.Equal(t, []byte(`31`), value)
+		_, err = c.HGet(ctx, tableName, "user1", "missing")
+		require.Equal(t, ErrKeyNotFound, err)
+
+		fields, err := c.HGetAll(ctx, tableName, "user1")
+		require.NoError(t, err)
+		require.Equal(t, map[string][]byte{"name": []byte(`"alice"`), "age": []byte(`31`), "city": []byte(`"sf"`)}, fields)
+
+		deleted, err := c.HDel(ctx, tableName, "user1", "city", "missing")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), deleted)
+
+		fields, err = c.HGetAll(ctx, tableName, "missing")
+		require.NoError(t, err)
+		require.Empty(t, fields)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		defer dropCacheTable(t, c, tableName)
+
+		length, err := c.RPush(ctx, tableName, "list1", []byte(`2`), []byte(`3`))
+		require.NoError(t, err)
+		require.Equal(t, int64(2), length)
+		length, err = c.LPush(ctx, tableName, "list1", []byte(`1`), []byte(`0`))
+		require.NoError(t, err)
+		require.Equal(t, int64(4), length)
+
+		values, err := c.LRange(ctx, tableName, "list1", 0, -1)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte(`0`), []byte(`1`), []byte(`2`), []byte(`3`)}, values)
+		values, err = c.LRange(ctx, tableName, "list1", -2, 10)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte(`2`), []byte(`3`)}, values)
+
+		value, err := c.LPop(ctx, tableName, "list1")
+		require.NoError(t, err)
+		require.Equal(t, []byte(`0`), value)
+		value, err = c.RPop(ctx, tableName, "list1")
+		require.NoError(t, err)
+		require.Equal(t, []byte(`3`), value)
+
+		_, err = c.LPop(ctx, tableName, "missing")
+		require.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("sorted_set", func(t *testing.T) {
+		defer dropCacheTable(t, c, tableName)
+
+		added, err := c.ZAdd(ctx, tableName, "board", ScoredMember{Member: []byte(`"a"`), Score: 10},
+			ScoredMember{Member: []byte(`"b"`), Score: 30}, ScoredMember{Member: []byte(`"c"`), Score: 20})
+		require.NoError(t, err)
+		require.Equal(t, int64(3), added)
+		added, err = c.ZAdd(ctx, tableName, "board", ScoredMember{Member: []byte(`"a"`), Score: 40})
+		require.NoError(t, err)
+		require.Equal(t, int64(0), added)
+
+		members, err := c.ZRange(ctx, tableName, "board", &RangeQuery{Start: 0, Stop: 1, Reverse: true})
+		require.NoError(t, err)
+		require.Equal(t, []ScoredMember{{Member: []byte(`"a"`), Score: 40}, {Member: []byte(`"b"`), Score: 30}}, members)
+
+		members, err = c.ZRange(ctx, tableName, "board", &RangeQuery{ByScore: true, Min: 15, Max: math.Inf(1)})
+		require.NoError(t, err)
+		require.Equal(t, []ScoredMember{{Member: []byte(`"c"`), Score: 20}, {Member: []byte(`"b"`), Score: 30}, {Member: []byte(`"a"`), Score: 40}}, members)
+
+		members, err = c.ZRange(ctx, tableName, "board", &RangeQuery{ByScore: true, Min: 15, Max: 30, Reverse: true})
+		require.NoError(t, err)
+		require.Equal(t, []ScoredMember{{Member: []byte(`"b"`), Score: 30}, {Member: []byte(`"c"`), Score: 20}}, members)
+	})
+
+	t.Run("wrong_type", func(t *testing.T) {
+		defer dropCacheTable(t, c, tableName)
+
+		_, err := c.HSet(ctx, tableName, "hash1", map[string][]byte{"f": []byte(`1`)})
+		require.NoError(t, err)
+
+		_, err = c.Get(ctx, tableName, "hash1", nil)
+		require.Equal(t, ErrWrongType, err)
+		_, err = c.RPush(ctx, tableName, "hash1", []byte(`1`))
+		require.Equal(t, ErrWrongType, err)
+		_, err = c.ZRange(ctx, tableName, "hash1", &RangeQuery{Stop: -1})
+		require.Equal(t, ErrWrongType, err)
+
+		// the structures are deleted like the values
+		deleted, err := c.Delete(ctx, tableName, "hash1")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), deleted)
+	})
+
 	t.Run("scan", func(t *testing.T) {
 		defer dropCacheTable(t, c, tableName)
 
@@ -134,11 +336,13 @@ func TestRedis(t *testing.T) {
 		var totalKeys []string
 		var cursor uint64
 
-		keys, cursor := c.Scan(ctx, tableName, cursor, 10, "*")
+		keys, cursor, err := c.Scan(ctx, tableName, cursor, 10, "*")
+		require.NoError(t, err)
 		totalKeys = append(totalKeys, keys...)
 
 		for cursor != 0 {
-			keys, cursor = c.Scan(ctx, tableName, cursor, 10, "*")
+			keys, cursor, err = c.Scan(ctx, tableName, cursor, 10, "*")
+			require.NoError(t, err)
 			totalKeys = append(totalKeys, keys...)
 		}
 		require.Equal(t, 50, len(totalKeys))
@@ -23,6 +23,9 @@ type ErrCode byte
 const (
 	errStrNoSuchKey              = "ERR no such key"
 	errStrConsGroupAlreadyExists = "Consumer Group name already exists"
+	errStrNotInteger             = "not an integer"
+	errStrWrongType              = "WRONGTYPE"
+	errStrCacheFull              = "CACHEFULL"
 )
 
 const (
@@ -31,6 +34,13 @@ const (
 	ErrCodeKeyNotFound      ErrCode = 0x03
 	ErrCodeKeyAlreadyExists ErrCode = 0x04
 	ErrCodeEmptyKey         ErrCode = 0x05
+	ErrCodeGroupExists      ErrCode = 0x06
+	ErrCodeGroupNotFound    ErrCode = 0x07
+	ErrCodeInvalidStreamID  ErrCode = 0x08
+	ErrCodeNotInteger       ErrCode = 0x09
+	ErrCodeWrongType        ErrCode = 0x0A
+	ErrCodeCacheFull        ErrCode = 0x0B
+	ErrCodeConflict         ErrCode = 0x0C
 )
 
 var (
@@ -41,6 +51,20 @@ var (
 	ErrKeyNotFound      = NewCacheError(ErrCodeKeyNotFound, "key not found")
 	ErrKeyAlreadyExists = NewCacheError(ErrCodeKeyAlreadyExists, "key already exists")
 	ErrEmptyKey         = NewCacheError(ErrCodeEmptyKey, "key is empty")
+	// ErrGroupAlreadyExists is returned when a consumer group already exists.
+	ErrGroupAlreadyExists = NewCacheError(ErrCodeGroupExists, errStrConsGroupAlreadyExists)
+	// ErrGroupNotFound is returned when a consumer group or the stream does not exist.
+	ErrGroupNotFound   = NewCacheError(ErrCodeGroupNotFound, "consumer group not found")
+	ErrInvalidStreamID = NewCacheError(ErrCodeInvalidStreamID, "invalid stream ID specified")
+	// ErrNotInteger is returned when the value of the key is not an integer or the result overflows.
+	ErrNotInteger = NewCacheError(ErrCodeNotInteger, "value is not an integer or out of range")
+	// ErrWrongType is returned when the operation is not supported by the type of the value of the key.
+	ErrWrongType = NewCacheError(ErrCodeWrongType, "operation against a key holding the wrong kind of value")
+	// ErrCacheFull is returned when the write exceeds the limits of the cache and the eviction policy doesn't allow
+	// evicting the keys.
+	ErrCacheFull = NewCacheError(ErrCodeCacheFull, "cache is full")
+	// ErrConflict is returned when the key keeps being modified concurrently and the operation can't be applied.
+	ErrConflict = NewCacheError(ErrCodeConflict, "key is modified concurrently")
 )
 
 type Error struct {
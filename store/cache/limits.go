@@ -0,0 +1,474 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	xredis "github.com/go-redis/redis/v8"
+	ulog "github.com/tigrisdata/tigris/util/log"
+)
+
+// The usage of a table with limits is tracked in four keys next to the keys of the table. They share the hash tag of
+// the table, so the scripts updating them run on a single node of a Redis Cluster, and they don't match the keys of
+// the table so Scan never returns them:
+//
+//   - "{<table>}#usage" is a sorted set of the keys of the table, the score is the last access time with the LRU
+//     policy or the number of the accesses with the LFU policy. The lowest score is evicted first.
+//   - "{<table>}#sizes" is a hash of the memory used by the keys as reported by MEMORY USAGE.
+//   - "{<table>}#expiry" is a sorted set of the keys with a TTL, the score is the expiry time. The expired keys are
+//     removed from the usage by the next script, without waiting for them to be accessed.
+//   - "{<table>}#stats" is a hash of the limits of the table and of the bytes, hits, misses and evictions counters.
+//
+// The scripts only access these keys, the size and the TTL of the keys of the table are read by the client and the
+// evicted keys are removed by the client. A key is accounted with its current size and TTL, so the accounting is
+// idempotent and the usage left behind by a failed write is fixed by retrying the write. The tables without limits
+// only count the hits and the misses, their keys are not tracked.
+//
+// The limits are approximate. The keys of the table don't share the hash tag of the usage keys, so on a Redis Cluster
+// a script can't both write the keys and account them, and the admission, the write and the accounting are separate
+// commands. The writes admitted concurrently by a table with the TTL-only policy may exceed its limits by the keys
+// of those writes, and a table with the LRU or LFU policy exceeds its limits until the written keys are accounted and
+// the evicted keys are removed.
+
+const (
+	statsPolicy    = "policy"
+	statsMaxKeys   = "max_keys"
+	statsMaxBytes  = "max_bytes"
+	statsBytes     = "bytes"
+	statsHits      = "hits"
+	statsMisses    = "misses"
+	statsEvictions = "evictions"
+
+	// limitsRefresh is how long the limits of a table are cached by the server, so the limits set through another
+	// server are applied once they are refreshed. The keys written meanwhile are accounted by their next write.
+	limitsRefresh = 10 * time.Second
+	// purgeBatch is the maximum number of the expired keys removed from the usage by a single script.
+	purgeBatch = 1000
+)
+
+// usageFunctions are shared by the scripts, KEYS are the keys returned by usageKeys.
+var usageFunctions = `
+local function forget(k)
+	local old = tonumber(redis.call('HGET', KEYS[2], k) or 0)
+	redis.call('ZREM', KEYS[1], k)
+	redis.call('ZREM', KEYS[3], k)
+	redis.call('HDEL', KEYS[2], k)
+	redis.call('HINCRBY', KEYS[4], 'bytes', -old)
+end
+
+local function purge(now)
+	local expired = redis.call('ZRANGEBYSCORE', KEYS[3], '-inf', now, 'LIMIT', 0, ` + strconv.Itoa(purgeBatch) + `)
+	for _, k in ipairs(expired) do
+		forget(k)
+	end
+	return #expired
+end
+`
+
+// trackScript accounts the written or deleted keys and returns the keys of the table to evict to keep the table within
+// its limits, the written keys are never evicted. The evicted keys stay accounted until the client removes them and
+// calls forgetScript. ARGV[1] is the current time in milliseconds, the rest are the triples of the key, its size or -1
+// if the key doesn't exist and its TTL in milliseconds or 0 if it doesn't expire.
+var trackScript = xredis.NewScript(usageFunctions + `
+local now = tonumber(ARGV[1])
+purge(now)
+
+local policy = redis.call('HGET', KEYS[4], 'policy')
+local written = {}
+for i = 2, #ARGV, 3 do
+	local k, size, ttl = ARGV[i], tonumber(ARGV[i + 1]), tonumber(ARGV[i + 2])
+	if size < 0 then
+		forget(k)
+	else
+		written[k] = true
+		local old = tonumber(redis.call('HGET', KEYS[2], k) or 0)
+		redis.call('HSET', KEYS[2], k, size)
+		redis.call('HINCRBY', KEYS[4], 'bytes', size - old)
+		if policy == 'lfu' then
+			redis.call('ZINCRBY', KEYS[1], 1, k)
+		else
+			redis.call('ZADD', KEYS[1], now, k)
+		end
+		if ttl > 0 then
+			redis.call('ZADD', KEYS[3], now + ttl, k)
+		else
+			redis.call('ZREM', KEYS[3], k)
+		end
+	end
+end
+
+if policy ~= 'lru' and policy ~= 'lfu' then
+	return {}
+end
+
+local maxKeys = tonumber(redis.call('HGET', KEYS[4], 'max_keys') or 0)
+local maxBytes = tonumber(redis.call('HGET', KEYS[4], 'max_bytes') or 0)
+local keys = redis.call('ZCARD', KEYS[1])
+local bytes = tonumber(redis.call('HGET', KEYS[4], 'bytes') or 0)
+
+local victims = {}
+local offset = 0
+while (maxKeys > 0 and keys > maxKeys) or (maxBytes > 0 and bytes > maxBytes) do
+	local victim = redis.call('ZRANGE', KEYS[1], offset, offset)[1]
+	if not victim then
+		break
+	end
+	offset = offset + 1
+	if not written[victim] then
+		victims[#victims + 1] = victim
+		keys = keys - 1
+		bytes = bytes - tonumber(redis.call('HGET', KEYS[2], victim) or 0)
+	end
+end
+
+return victims
+`)
+
+// forgetScript removes the evicted keys from the usage. ARGV[1] is the number of the keys actually removed by the
+// client and the rest are the evicted keys.
+var forgetScript = xredis.NewScript(usageFunctions + `
+for i = 2, #ARGV do
+	forget(ARGV[i])
+end
+
+if tonumber(ARGV[1]) > 0 then
+	redis.call('HINCRBY', KEYS[4], 'evictions', ARGV[1])
+end
+
+return 0
+`)
+
+// touchScript records the reads of the keys. ARGV[1] is the current time in milliseconds, ARGV[2] is the number of the
+// hits and the rest are the keys read, the hits first. A missed key doesn't exist anymore, so it is removed from the
+// usage.
+var touchScript = xredis.NewScript(usageFunctions + `
+local policy = redis.call('HGET', KEYS[4], 'policy')
+local hits = tonumber(ARGV[2])
+
+for i = 3, 2 + hits do
+	if policy == 'lfu' then
+		if redis.call('ZSCORE', KEYS[1], ARGV[i]) then
+			redis.call('ZINCRBY', KEYS[1], 1, ARGV[i])
+		end
+	else
+		redis.call('ZADD', KEYS[1], 'XX', ARGV[1], ARGV[i])
+	end
+end
+
+for i = 3 + hits, #ARGV do
+	forget(ARGV[i])
+end
+
+if hits > 0 then
+	redis.call('HINCRBY', KEYS[4], 'hits', hits)
+end
+if #ARGV - 2 - hits > 0 then
+	redis.call('HINCRBY', KEYS[4], 'misses', #ARGV - 2 - hits)
+end
+
+return 0
+`)
+
+// admitScript returns 0 if the keys can't be written because the table with the TTL-only policy is at its limits.
+// ARGV[1] is the current time in milliseconds and the rest are the written keys. The expired keys are removed from the
+// usage before the limits are checked.
+var admitScript = xredis.NewScript(usageFunctions + `
+if redis.call('HGET', KEYS[4], 'policy') ~= 'ttl' then
+	return 1
+end
+purge(tonumber(ARGV[1]))
+
+local maxKeys = tonumber(redis.call('HGET', KEYS[4], 'max_keys') or 0)
+local maxBytes = tonumber(redis.call('HGET', KEYS[4], 'max_bytes') or 0)
+
+-- the replaced keys don't add to the number of the keys
+local keys = redis.call('ZCARD', KEYS[1])
+for i = 2, #ARGV do
+	if not redis.call('ZSCORE', KEYS[1], ARGV[i]) then
+		keys = keys + 1
+	end
+end
+local bytes = tonumber(redis.call('HGET', KEYS[4], 'bytes') or 0)
+
+if (maxKeys > 0 and keys > maxKeys) or (maxBytes > 0 and bytes >= maxBytes) then
+	return 0
+end
+
+return 1
+`)
+
+// purgeScript removes the expired keys from the usage and returns the number of the removed keys. ARGV[1] is the
+// current time in milliseconds.
+var purgeScript = xredis.NewScript(usageFunctions + `
+return purge(tonumber(ARGV[1]))
+`)
+
+// usageKeys returns the keys tracking the usage of the table.
+func usageKeys(tableName string) []string {
+	tag := "{" + tableName + "}"
+	return []string{tag + "#usage", tag + "#sizes", tag + "#expiry", tag + "#stats"}
+}
+
+// cachedLimits are the limits of the table as last read from the stats of the table.
+type cachedLimits struct {
+	limits Limits
+	loaded time.Time
+}
+
+// tableLimits returns the limits of the table, the limits are cached for limitsRefresh.
+func (c *cache) tableLimits(ctx context.Context, tableName string) (*Limits, error) {
+	c.limitsMu.Lock()
+	cached, ok := c.limits[tableName]
+	c.limitsMu.Unlock()
+	if ok && time.Since(cached.loaded) < limitsRefresh {
+		return &cached.limits, nil
+	}
+
+	values, err := c.Client.HMGet(ctx, usageKeys(tableName)[3], statsPolicy, statsMaxKeys, statsMaxBytes).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var limits Limits
+	if s, ok := values[0].(string); ok {
+		limits.Policy = EvictionPolicy(s)
+	}
+	if s, ok := values[1].(string); ok {
+		limits.MaxKeys, _ = strconv.ParseInt(s, 10, 64)
+	}
+	if s, ok := values[2].(string); ok {
+		limits.MaxBytes, _ = strconv.ParseInt(s, 10, 64)
+	}
+	c.cacheLimits(tableName, &limits)
+
+	return &limits, nil
+}
+
+func (c *cache) cacheLimits(tableName string, limits *Limits) {
+	c.limitsMu.Lock()
+	defer c.limitsMu.Unlock()
+
+	if limits == nil {
+		delete(c.limits, tableName)
+		return
+	}
+	c.limits[tableName] = &cachedLimits{limits: *limits, loaded: time.Now()}
+}
+
+// admit returns ErrCacheFull if the keys can't be written because the table is at its limits. The keys are admitted
+// before they are written and accounted, so the limits are approximate for the concurrent writes.
+func (c *cache) admit(ctx context.Context, tableName string, keys ...string) error {
+	limits, err := c.tableLimits(ctx, tableName)
+	if err != nil || !limits.limited() || limits.Policy != EvictionTTLOnly {
+		return err
+	}
+
+	args := make([]any, 0, 1+len(keys))
+	args = append(args, time.Now().UnixMilli())
+	for _, k := range keys {
+		args = append(args, k)
+	}
+
+	allowed, err := admitScript.Run(ctx, c.Client, usageKeys(tableName), args...).Int64()
+	if err != nil {
+		return err
+	}
+	if allowed == 0 {
+		return ErrCacheFull
+	}
+
+	return nil
+}
+
+// track accounts the written or deleted keys and evicts the keys of the table exceeding the limits.
+func (c *cache) track(ctx context.Context, tableName string, keys ...string) error {
+	limits, err := c.tableLimits(ctx, tableName)
+	if err != nil || !limits.limited() {
+		return err
+	}
+
+	sizes := make([]*xredis.IntCmd, len(keys))
+	ttls := make([]*xredis.DurationCmd, len(keys))
+	if _, err = c.Client.Pipelined(ctx, func(pipe xredis.Pipeliner) error {
+		for i, k := range keys {
+			cacheKey := encodeToCacheKey(tableName, k)
+			sizes[i] = pipe.MemoryUsage(ctx, cacheKey)
+			ttls[i] = pipe.PTTL(ctx, cacheKey)
+		}
+		return nil
+	}); err != nil && err != xredis.Nil {
+		return err
+	}
+
+	args := make([]any, 0, 1+3*len(keys))
+	args = append(args, time.Now().UnixMilli())
+	for i, k := range keys {
+		size, err := sizes[i].Result()
+		if err == xredis.Nil {
+			// the key doesn't exist
+			size = -1
+		} else if err != nil {
+			return err
+		}
+
+		// the TTL of a missing key or of a key without expiry is negative
+		ttl := ttls[i].Val().Milliseconds()
+		if ttl < 0 {
+			ttl = 0
+		}
+		args = append(args, k, size, ttl)
+	}
+
+	return c.evict(ctx, tableName, args...)
+}
+
+// evict runs trackScript and removes the returned keys. A key which fails to be removed stays accounted, so it is
+// selected again by the next write.
+func (c *cache) evict(ctx context.Context, tableName string, args ...any) error {
+	victims, err := trackScript.Run(ctx, c.Client, usageKeys(tableName), args...).StringSlice()
+	if err != nil || len(victims) == 0 {
+		return err
+	}
+
+	unlinked := make([]*xredis.IntCmd, len(victims))
+	if _, err = c.Client.Pipelined(ctx, func(pipe xredis.Pipeliner) error {
+		for i, k := range victims {
+			unlinked[i] = pipe.Unlink(ctx, encodeToCacheKey(tableName, k))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	forgetArgs := make([]any, 0, 1+len(victims))
+	forgetArgs = append(forgetArgs, 0)
+	var evicted int64
+	for i, k := range victims {
+		// a key removed meanwhile is forgotten but it is not counted as evicted
+		evicted += unlinked[i].Val()
+		forgetArgs = append(forgetArgs, k)
+	}
+	forgetArgs[0] = evicted
+
+	return forgetScript.Run(ctx, c.Client, usageKeys(tableName), forgetArgs...).Err()
+}
+
+// touch records the reads of the keys, only the hits and the misses are counted for the tables without limits. The
+// reads are served regardless, so a failure is only logged.
+func (c *cache) touch(ctx context.Context, tableName string, hits []string, misses []string) {
+	limits, err := c.tableLimits(ctx, tableName)
+	if ulog.E(err) {
+		return
+	}
+
+	if !limits.limited() {
+		_, err = c.Client.Pipelined(ctx, func(pipe xredis.Pipeliner) error {
+			if len(hits) > 0 {
+				pipe.HIncrBy(ctx, usageKeys(tableName)[3], statsHits, int64(len(hits)))
+			}
+			if len(misses) > 0 {
+				pipe.HIncrBy(ctx, usageKeys(tableName)[3], statsMisses, int64(len(misses)))
+			}
+			return nil
+		})
+		_ = ulog.E(err)
+		return
+	}
+
+	args := make([]any, 0, 2+len(hits)+len(misses))
+	args = append(args, time.Now().UnixMilli(), len(hits))
+	for _, k := range hits {
+		args = append(args, k)
+	}
+	for _, k := range misses {
+		args = append(args, k)
+	}
+
+	_ = ulog.E(touchScript.Run(ctx, c.Client, usageKeys(tableName), args...).Err())
+}
+
+// touchKey records the read of the key, the read is a hit if the key is found.
+func (c *cache) touchKey(ctx context.Context, tableName string, key string, found bool) {
+	if found {
+		c.touch(ctx, tableName, []string{key}, nil)
+	} else {
+		c.touch(ctx, tableName, nil, []string{key})
+	}
+}
+
+func (c *cache) SetLimits(ctx context.Context, tableName string, limits *Limits) error {
+	if err := c.Client.HSet(ctx, usageKeys(tableName)[3],
+		statsPolicy, string(limits.Policy),
+		statsMaxKeys, limits.MaxKeys,
+		statsMaxBytes, limits.MaxBytes,
+	).Err(); err != nil {
+		return err
+	}
+	c.cacheLimits(tableName, limits)
+
+	// evict the keys exceeding the new limits
+	return c.evict(ctx, tableName, time.Now().UnixMilli())
+}
+
+func (c *cache) Stats(ctx context.Context, tableName string) (*Stats, error) {
+	keys := usageKeys(tableName)
+
+	// the expired keys are removed from the usage in batches, the remaining ones are removed by the next writes
+	for i := 0; i < 10; i++ {
+		purged, err := purgeScript.Run(ctx, c.Client, keys, time.Now().UnixMilli()).Int64()
+		if err != nil {
+			return nil, err
+		}
+		if purged < purgeBatch {
+			break
+		}
+	}
+
+	var (
+		card   *xredis.IntCmd
+		values *xredis.SliceCmd
+	)
+	if _, err := c.Client.Pipelined(ctx, func(pipe xredis.Pipeliner) error {
+		card = pipe.ZCard(ctx, keys[0])
+		values = pipe.HMGet(ctx, keys[3], statsBytes, statsHits, statsMisses, statsEvictions)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	counters := make([]int64, 4)
+	for i, v := range values.Val() {
+		if s, ok := v.(string); ok {
+			counters[i], _ = strconv.ParseInt(s, 10, 64)
+		}
+	}
+
+	return &Stats{
+		Keys:      card.Val(),
+		Bytes:     counters[0],
+		Hits:      counters[1],
+		Misses:    counters[2],
+		Evictions: counters[3],
+	}, nil
+}
+
+func (c *cache) DropStats(ctx context.Context, tableName string) error {
+	c.cacheLimits(tableName, nil)
+
+	return c.Client.Unlink(ctx, usageKeys(tableName)...).Err()
+}
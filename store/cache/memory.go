@@ -0,0 +1,1126 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	xredis "github.com/go-redis/redis/v8"
+	"github.com/tigrisdata/tigris/internal"
+	"github.com/tigrisdata/tigris/server/config"
+)
+
+const (
+	// memExpireSample is the number of keys checked for expiry on every write, the rest of the expired keys are
+	// removed once they are accessed.
+	memExpireSample   = 20
+	readBlockDuration = 1 * time.Second
+	// memStreamMaxLen caps the entries kept by a stream. Redis keeps the streams until they are trimmed, the memory
+	// cache can't spill to disk so the oldest entries of a stream exceeding the cap are dropped, like a trim with
+	// an approximate MAXLEN.
+	memStreamMaxLen = 100000
+)
+
+var (
+	sharedMemCache     *memCache
+	sharedMemCacheOnce sync.Once
+)
+
+// getMemCache returns the in-memory cache of this process, the callers share it the same way they would share the
+// Redis server.
+func getMemCache() *memCache {
+	sharedMemCacheOnce.Do(func() {
+		sharedMemCache = newMemCache()
+	})
+
+	return sharedMemCache
+}
+
+type memKind byte
+
+const (
+	memValue memKind = iota
+	memHash
+	memList
+	memSortedSet
+)
+
+// memEntry is the value of a key, it is one of the encoded value, the hash, the list or the sorted set as per the kind.
+// The entry keeps its table, its accounted size and its usage for the limits of the table.
+type memEntry struct {
+	kind    memKind
+	value   []byte
+	hash    map[string][]byte
+	list    [][]byte
+	zset    map[string]float64
+	expires time.Time
+
+	table    string
+	size     int64
+	accessed time.Time
+	freq     int64
+}
+
+func (e *memEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// memCache is the in-process implementation of the Cache with the same semantics as the Redis backed cache. The keys
+// and the streams are kept in a single keyspace guarded by a mutex, the blocking stream reads are woken up by the
+// writers of the stream.
+type memCache struct {
+	sync.Mutex
+
+	entries map[string]*memEntry
+	tables  map[string]*memTable
+	streams map[string]*memStream
+}
+
+func newMemCache() *memCache {
+	return &memCache{
+		entries: make(map[string]*memEntry),
+		tables:  make(map[string]*memTable),
+		streams: make(map[string]*memStream),
+	}
+}
+
+// getEntry returns the live entry of the key, an expired entry is removed. Caller must hold the lock.
+func (c *memCache) getEntry(key string, now time.Time) *memEntry {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if e.expired(now) {
+		c.removeEntry(key)
+		return nil
+	}
+
+	return e
+}
+
+// expireSample removes the expired keys among a sample of the keys. Caller must hold the lock.
+func (c *memCache) expireSample(now time.Time) {
+	checked := 0
+	for k, e := range c.entries {
+		if checked == memExpireSample {
+			return
+		}
+		if e.expired(now) {
+			c.removeEntry(k)
+		}
+		checked++
+	}
+}
+
+func (c *memCache) Set(_ context.Context, tableName string, key string, value *internal.CacheData, options *SetOptions) error {
+	enc, err := encodeCacheValue(value)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	existing := c.getEntry(cacheKey, now)
+	if options != nil && options.XX && existing == nil {
+		return ErrKeyNotFound
+	}
+	if options != nil && options.NX && existing != nil {
+		return ErrKeyAlreadyExists
+	}
+	if err = c.admit(tableName, cacheKey, now); err != nil {
+		return err
+	}
+
+	c.setEntry(tableName, cacheKey, enc, options.ttl(), now)
+
+	return nil
+}
+
+// setEntry sets the value of the key replacing the expiry. Caller must hold the lock.
+func (c *memCache) setEntry(tableName string, cacheKey string, enc []byte, ttl time.Duration, now time.Time) {
+	entry := &memEntry{value: enc}
+	if ttl > 0 {
+		entry.expires = now.Add(ttl)
+	}
+
+	c.putEntry(tableName, cacheKey, entry, now)
+}
+
+func (c *memCache) GetSet(_ context.Context, tableName string, key string, value *internal.CacheData) (*internal.CacheData, error) {
+	enc, err := encodeCacheValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	existing := c.getEntry(cacheKey, now)
+	if existing != nil && existing.kind != memValue {
+		return nil, ErrWrongType
+	}
+	if err = c.admit(tableName, cacheKey, now); err != nil {
+		return nil, err
+	}
+	c.putEntry(tableName, cacheKey, &memEntry{value: enc}, now)
+	if existing == nil {
+		return nil, nil
+	}
+
+	return decodeCacheValue(existing.value)
+}
+
+func (c *memCache) Get(_ context.Context, tableName string, key string, options *GetOptions) (*internal.CacheData, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	e := c.getEntry(cacheKey, now)
+	if e == nil {
+		c.miss(tableName)
+		return nil, ErrKeyNotFound
+	}
+	if e.kind != memValue {
+		return nil, ErrWrongType
+	}
+	c.hit(e, now)
+
+	switch {
+	case options != nil && options.Expiry > 0:
+		e.expires = now.Add(options.Expiry)
+	case options != nil && options.GetDelete:
+		c.removeEntry(cacheKey)
+	}
+
+	return decodeCacheValue(e.value)
+}
+
+func (c *memCache) Delete(_ context.Context, tableName string, keys ...string) (int64, error) {
+	if len(keys) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	var deleted int64
+	for _, k := range keys {
+		cacheKey := encodeToCacheKey(tableName, k)
+		if c.getEntry(cacheKey, now) != nil {
+			c.removeEntry(cacheKey)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+// Unlink is the same as Delete, the memory of the removed entries is reclaimed by the garbage collector anyway.
+func (c *memCache) Unlink(ctx context.Context, tableName string, keys ...string) (int64, error) {
+	return c.Delete(ctx, tableName, keys...)
+}
+
+func (c *memCache) Exists(_ context.Context, tableName string, keys ...string) (int64, error) {
+	var cacheKeys []string
+	if len(keys) == 0 {
+		cacheKeys = append(cacheKeys, tableName)
+	} else {
+		for _, k := range keys {
+			cacheKeys = append(cacheKeys, encodeToCacheKey(tableName, k))
+		}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	var count int64
+	for _, k := range cacheKeys {
+		if _, ok := c.streams[k]; ok || c.getEntry(k, now) != nil {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// matchingKeys returns the sorted live keys matching the pattern. Caller must hold the lock.
+func (c *memCache) matchingKeys(pattern string) []string {
+	now := time.Now()
+	var keys []string
+	for k, e := range c.entries {
+		if e.expired(now) {
+			c.removeEntry(k)
+			continue
+		}
+		if matchPattern(pattern, k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (c *memCache) Keys(_ context.Context, tableName string, pattern string) ([]string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.matchingKeys(encodeToCacheKey(tableName, pattern)), nil
+}
+
+// Scan iterates over the keys of the table in the order of their hash, the cursor is the hash following the last
+// examined key. Like Redis the cursor doesn't depend on the position of the key, so the keys added or removed during
+// the iteration don't cause the remaining keys to be skipped, and the count is the number of the examined keys, so a
+// page may have less keys than the count when the keys don't match the pattern. The keys sharing a hash are examined
+// in the same page. The keys are kept ordered by the hash, so a page costs O(count * log N).
+func (c *memCache) Scan(_ context.Context, tableName string, cursor uint64, count int64, pattern string) ([]string, uint64, error) {
+	if count > config.DefaultConfig.Cache.MaxScan {
+		count = config.DefaultConfig.Cache.MaxScan
+	}
+	if count <= 0 {
+		count = 10
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	t, ok := c.tables[tableName]
+	if !ok {
+		return nil, 0, nil
+	}
+
+	now := time.Now()
+	pattern = encodeToCacheKey(tableName, pattern)
+
+	var (
+		keys     []string
+		expired  []string
+		examined int64
+		n        = t.scan.seek(cursor)
+	)
+	for ; n != nil; n = n.next[0] {
+		if examined >= count && n.hash != cursor {
+			break
+		}
+		examined++
+		cursor = n.hash
+
+		if c.entries[n.key].expired(now) {
+			expired = append(expired, n.key)
+			continue
+		}
+		if matchPattern(pattern, n.key) {
+			keys = append(keys, n.key)
+		}
+	}
+
+	// removed once the iteration is done as the removal changes the index
+	for _, k := range expired {
+		c.removeEntry(k)
+	}
+
+	if n == nil || cursor == math.MaxUint64 {
+		return keys, 0, nil
+	}
+
+	return keys, cursor + 1, nil
+}
+
+func (c *memCache) MGet(_ context.Context, tableName string, keys ...string) ([]*internal.CacheData, error) {
+	if len(keys) == 0 {
+		return nil, ErrEmptyKey
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	result := make([]*internal.CacheData, len(keys))
+	for i, k := range keys {
+		e := c.getEntry(encodeToCacheKey(tableName, k), now)
+		if e == nil || e.kind != memValue {
+			c.miss(tableName)
+			continue
+		}
+		c.hit(e, now)
+
+		data, err := decodeCacheValue(e.value)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = data
+	}
+
+	return result, nil
+}
+
+func (c *memCache) MSet(_ context.Context, tableName string, values map[string]*internal.CacheData) error {
+	if len(values) == 0 {
+		return ErrEmptyKey
+	}
+
+	encoded := make(map[string][]byte, len(values))
+	for k, v := range values {
+		enc, err := encodeCacheValue(v)
+		if err != nil {
+			return err
+		}
+		encoded[encodeToCacheKey(tableName, k)] = enc
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	for cacheKey := range encoded {
+		if err := c.admit(tableName, cacheKey, now); err != nil {
+			return err
+		}
+	}
+	for cacheKey, enc := range encoded {
+		c.setEntry(tableName, cacheKey, enc, 0, now)
+	}
+
+	return nil
+}
+
+func (c *memCache) Incr(_ context.Context, tableName string, key string, delta int64, options *IncrOptions) (int64, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	e := c.getEntry(cacheKey, now)
+	if e == nil {
+		if err := c.admit(tableName, cacheKey, now); err != nil {
+			return 0, err
+		}
+		c.setEntry(tableName, cacheKey, []byte(strconv.FormatInt(delta, 10)), options.ttl(), now)
+		return delta, nil
+	}
+
+	if e.kind != memValue {
+		return 0, ErrWrongType
+	}
+	counter, ok := counterValue(e.value)
+	if !ok || overflows(counter, delta) {
+		return 0, ErrNotInteger
+	}
+
+	counter += delta
+	e.value = []byte(strconv.FormatInt(counter, 10))
+	if e.expires.IsZero() && options.ttl() > 0 {
+		e.expires = now.Add(options.ttl())
+	}
+	c.updated(cacheKey, e, now)
+
+	return counter, nil
+}
+
+func (c *memCache) Decr(ctx context.Context, tableName string, key string, delta int64, options *IncrOptions) (int64, error) {
+	return c.Incr(ctx, tableName, key, -delta, options)
+}
+
+func (c *memCache) CompareAndSet(_ context.Context, tableName string, key string, expected []byte, value *internal.CacheData, options *SetOptions) (bool, error) {
+	enc, err := encodeCacheValue(value)
+	if err != nil {
+		return false, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+
+	var current []byte
+	e := c.getEntry(cacheKey, now)
+	if e != nil && e.kind != memValue {
+		return false, ErrWrongType
+	}
+	if e != nil {
+		current = e.value
+	}
+	if matches, err := matchesExpected(current, e != nil, expected); err != nil || !matches {
+		return false, err
+	}
+	if err = c.admit(tableName, cacheKey, now); err != nil {
+		return false, err
+	}
+
+	c.setEntry(tableName, cacheKey, enc, options.ttl(), now)
+
+	return true, nil
+}
+
+func (c *memCache) ListStreams(_ context.Context, streamNamePrefix string) ([]string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	var names []string
+	for name := range c.streams {
+		if matchPattern(streamNamePrefix, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func (c *memCache) GetStream(_ context.Context, streamName string) (Stream, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.streams[streamName]; !ok {
+		return nil, ErrStreamNotFound
+	}
+
+	return &memStreamHandle{cache: c, name: streamName}, nil
+}
+
+func (c *memCache) DeleteStream(_ context.Context, streamName string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	c.deleteStream(streamName)
+	return nil
+}
+
+// deleteStream removes the stream and wakes up the readers blocked on it. Caller must hold the lock.
+func (c *memCache) deleteStream(streamName string) {
+	if s, ok := c.streams[streamName]; ok {
+		delete(c.streams, streamName)
+		s.wakeup()
+	}
+}
+
+// CreateOrGetStream creates the stream along with the default consumer group if it doesn't exist.
+func (c *memCache) CreateOrGetStream(_ context.Context, streamName string) (Stream, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	s, ok := c.streams[streamName]
+	if !ok {
+		s = newMemStream()
+		c.streams[streamName] = s
+	}
+	if _, ok = s.groups[DefaultGroup]; !ok {
+		s.groups[DefaultGroup] = &memGroup{pending: make(map[streamID]struct{})}
+	}
+
+	return &memStreamHandle{cache: c, name: streamName}, nil
+}
+
+// CreateStream will throw an error if stream already exists.
+func (c *memCache) CreateStream(ctx context.Context, streamName string) (Stream, error) {
+	c.Lock()
+	_, ok := c.streams[streamName]
+	c.Unlock()
+	if ok {
+		return nil, ErrStreamAlreadyExists
+	}
+
+	return c.CreateOrGetStream(ctx, streamName)
+}
+
+// streamID is the Redis compatible "<milliseconds>-<sequence>" identifier of a stream entry.
+type streamID struct {
+	ms  uint64
+	seq uint64
+}
+
+func parseStreamID(id string) (streamID, error) {
+	ms, seq, hasSeq := strings.Cut(id, "-")
+
+	var (
+		parsed streamID
+		err    error
+	)
+	if parsed.ms, err = strconv.ParseUint(ms, 10, 64); err != nil {
+		return parsed, ErrInvalidStreamID
+	}
+	if hasSeq {
+		if parsed.seq, err = strconv.ParseUint(seq, 10, 64); err != nil {
+			return parsed, ErrInvalidStreamID
+		}
+	}
+
+	return parsed, nil
+}
+
+func (id streamID) String() string {
+	return strconv.FormatUint(id.ms, 10) + "-" + strconv.FormatUint(id.seq, 10)
+}
+
+func (id streamID) less(other streamID) bool {
+	return id.ms < other.ms || (id.ms == other.ms && id.seq < other.seq)
+}
+
+type memStreamEntry struct {
+	id      streamID
+	message xredis.XMessage
+}
+
+type memGroup struct {
+	lastDelivered streamID
+	consumers     int64
+	pending       map[streamID]struct{}
+}
+
+type memStream struct {
+	entries []memStreamEntry
+	lastID  streamID
+	groups  map[string]*memGroup
+	// added is closed when an entry is added or the stream is deleted, the blocked readers wait on it.
+	added chan struct{}
+}
+
+func newMemStream() *memStream {
+	return &memStream{
+		groups: make(map[string]*memGroup),
+		added:  make(chan struct{}),
+	}
+}
+
+func (s *memStream) wakeup() {
+	close(s.added)
+	s.added = make(chan struct{})
+}
+
+// resolve returns the position, "$" is the last entry of the stream.
+func (s *memStream) resolve(pos string) (streamID, error) {
+	if pos == ConsumerGroupDefaultCurrentPos {
+		return s.lastID, nil
+	}
+
+	return parseStreamID(pos)
+}
+
+// after returns the entries with the id greater than the position.
+func (s *memStream) after(pos streamID) []xredis.XMessage {
+	i := sort.Search(len(s.entries), func(i int) bool {
+		return pos.less(s.entries[i].id)
+	})
+
+	messages := make([]xredis.XMessage, 0, len(s.entries)-i)
+	for ; i < len(s.entries); i++ {
+		messages = append(messages, s.entries[i].message)
+	}
+
+	return messages
+}
+
+// memStreamHandle is the Stream of the in-memory cache, the stream state is looked up on every call so that the
+// handle observes the deletion of the stream.
+type memStreamHandle struct {
+	cache *memCache
+	name  string
+}
+
+func (h *memStreamHandle) Name() string {
+	return h.name
+}
+
+// stream returns the stream state. Caller must hold the lock.
+func (h *memStreamHandle) stream() (*memStream, bool) {
+	s, ok := h.cache.streams[h.name]
+	return s, ok
+}
+
+func (h *memStreamHandle) Add(_ context.Context, value *internal.StreamData) (string, error) {
+	enc, err := internal.EncodeStreamData(value)
+	if err != nil {
+		return "", err
+	}
+
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	s, ok := h.stream()
+	if !ok {
+		s = newMemStream()
+		h.cache.streams[h.name] = s
+	}
+
+	id := streamID{ms: uint64(time.Now().UnixMilli())}
+	if !s.lastID.less(id) {
+		id = streamID{ms: s.lastID.ms, seq: s.lastID.seq + 1}
+	}
+	s.lastID = id
+	s.entries = append(s.entries, memStreamEntry{
+		id: id,
+		message: xredis.XMessage{
+			ID:     id.String(),
+			Values: map[string]any{payloadKey: string(enc)},
+		},
+	})
+	// the stream is trimmed once it is a tenth over the cap, so the entries are not copied on every add
+	if len(s.entries) > memStreamMaxLen+memStreamMaxLen/10 {
+		s.drop(len(s.entries) - memStreamMaxLen)
+	}
+	s.wakeup()
+
+	return id.String(), nil
+}
+
+// block calls read until it returns messages, the stream is deleted, the context is done or the timeout expires.
+// The read is called with the lock held.
+func (h *memStreamHandle) block(ctx context.Context, timeout time.Duration, read func(s *memStream) ([]xredis.XMessage, error)) (*StreamMessages, bool, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		h.cache.Lock()
+		s, ok := h.stream()
+		if !ok {
+			h.cache.Unlock()
+			return nil, false, nil
+		}
+		messages, err := read(s)
+		added := s.added
+		h.cache.Unlock()
+
+		if err != nil {
+			return nil, true, err
+		}
+		if len(messages) > 0 {
+			return &StreamMessages{
+				XStream: xredis.XStream{Stream: h.name, Messages: messages},
+			}, true, nil
+		}
+
+		select {
+		case <-added:
+		case <-timer.C:
+			return nil, false, nil
+		case <-ctx.Done():
+			return nil, true, ctx.Err()
+		}
+	}
+}
+
+func (h *memStreamHandle) Read(ctx context.Context, pos string) (*StreamMessages, bool, error) {
+	h.cache.Lock()
+	s, ok := h.stream()
+	if !ok {
+		s = newMemStream()
+	}
+	from, err := s.resolve(pos)
+	h.cache.Unlock()
+	if err != nil {
+		return nil, true, err
+	}
+
+	return h.block(ctx, readBlockDuration, func(s *memStream) ([]xredis.XMessage, error) {
+		return s.after(from), nil
+	})
+}
+
+// ReadGroup delivers the new entries to the group for ReadGroupPosCurrent and adds them to the pending entries,
+// any other position returns the pending entries after it without blocking.
+func (h *memStreamHandle) ReadGroup(ctx context.Context, group string, pos ReadGroupPos) (*StreamMessages, bool, error) {
+	if pos != ReadGroupPosCurrent {
+		return h.readPending(group, pos)
+	}
+
+	return h.block(ctx, BlockReadGroupDuration, func(s *memStream) ([]xredis.XMessage, error) {
+		g, ok := s.groups[group]
+		if !ok {
+			return nil, ErrGroupNotFound
+		}
+		if g.consumers == 0 {
+			g.consumers = 1
+		}
+
+		messages := s.after(g.lastDelivered)
+		for _, m := range messages {
+			id, _ := parseStreamID(m.ID)
+			g.pending[id] = struct{}{}
+			g.lastDelivered = id
+		}
+
+		return messages, nil
+	})
+}
+
+func (h *memStreamHandle) readPending(group string, pos ReadGroupPos) (*StreamMessages, bool, error) {
+	from, err := parseStreamID(string(pos))
+	if err != nil {
+		return nil, true, err
+	}
+
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	s, ok := h.stream()
+	if !ok {
+		return nil, true, ErrGroupNotFound
+	}
+	g, ok := s.groups[group]
+	if !ok {
+		return nil, true, ErrGroupNotFound
+	}
+
+	var messages []xredis.XMessage
+	for _, m := range s.after(from) {
+		id, _ := parseStreamID(m.ID)
+		if _, pending := g.pending[id]; pending {
+			messages = append(messages, m)
+		}
+	}
+	if len(messages) == 0 {
+		return nil, true, nil
+	}
+
+	return &StreamMessages{
+		XStream: xredis.XStream{Stream: h.name, Messages: messages},
+	}, true, nil
+}
+
+func (h *memStreamHandle) CreateConsumerGroup(_ context.Context, group string, pos string) error {
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	s, ok := h.stream()
+	if !ok {
+		return ErrStreamNotFound
+	}
+	if _, ok = s.groups[group]; ok {
+		return ErrGroupAlreadyExists
+	}
+
+	lastDelivered, err := s.resolve(pos)
+	if err != nil {
+		return err
+	}
+	s.groups[group] = &memGroup{lastDelivered: lastDelivered, pending: make(map[streamID]struct{})}
+
+	return nil
+}
+
+func (h *memStreamHandle) RemoveConsumerGroup(_ context.Context, group string) error {
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	if s, ok := h.stream(); ok {
+		delete(s.groups, group)
+	}
+
+	return nil
+}
+
+// GetConsumerGroups returns the consumer groups ordered by the name as Redis does.
+func (h *memStreamHandle) GetConsumerGroups(_ context.Context) ([]xredis.XInfoGroup, error) {
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	s, ok := h.stream()
+	if !ok {
+		return nil, nil
+	}
+
+	groups := make([]xredis.XInfoGroup, 0, len(s.groups))
+	for name, g := range s.groups {
+		groups = append(groups, xredis.XInfoGroup{
+			Name:            name,
+			Consumers:       g.consumers,
+			Pending:         int64(len(g.pending)),
+			LastDeliveredID: g.lastDelivered.String(),
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+
+	return groups, nil
+}
+
+func (h *memStreamHandle) GetConsumerGroup(ctx context.Context, group string) (*xredis.XInfoGroup, bool, error) {
+	groups, err := h.GetConsumerGroups(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for i := range groups {
+		if group == groups[i].Name {
+			return &groups[i], true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+func (h *memStreamHandle) SetID(_ context.Context, group string, pos string) error {
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	s, ok := h.stream()
+	if !ok {
+		return ErrGroupNotFound
+	}
+	g, ok := s.groups[group]
+	if !ok {
+		return ErrGroupNotFound
+	}
+
+	lastDelivered, err := s.resolve(pos)
+	if err != nil {
+		return err
+	}
+	g.lastDelivered = lastDelivered
+
+	return nil
+}
+
+func (h *memStreamHandle) Ack(_ context.Context, group string, ids ...string) error {
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	s, ok := h.stream()
+	if !ok {
+		return nil
+	}
+	g, ok := s.groups[group]
+	if !ok {
+		return nil
+	}
+
+	for _, id := range ids {
+		parsed, err := parseStreamID(id)
+		if err != nil {
+			return err
+		}
+		delete(g.pending, parsed)
+	}
+
+	return nil
+}
+
+func (h *memStreamHandle) Delete(ctx context.Context) error {
+	return h.cache.DeleteStream(ctx, h.name)
+}
+
+func (h *memStreamHandle) Range(_ context.Context, start string, end string, count int64) (*StreamMessages, error) {
+	entries, err := h.between(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]xredis.XMessage, 0, len(entries))
+	for i := 0; i < len(entries) && (count <= 0 || int64(i) < count); i++ {
+		messages = append(messages, entries[i].message)
+	}
+
+	return &StreamMessages{
+		XStream: xredis.XStream{Stream: h.name, Messages: messages},
+	}, nil
+}
+
+func (h *memStreamHandle) RevRange(_ context.Context, end string, start string, count int64) (*StreamMessages, error) {
+	entries, err := h.between(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]xredis.XMessage, 0, len(entries))
+	for i := len(entries) - 1; i >= 0 && (count <= 0 || int64(len(messages)) < count); i-- {
+		messages = append(messages, entries[i].message)
+	}
+
+	return &StreamMessages{
+		XStream: xredis.XStream{Stream: h.name, Messages: messages},
+	}, nil
+}
+
+// between returns a copy of the entries with the ID between start and end inclusive.
+func (h *memStreamHandle) between(start string, end string) ([]memStreamEntry, error) {
+	from, err := parseRangeID(start, false)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseRangeID(end, true)
+	if err != nil {
+		return nil, err
+	}
+
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	s, ok := h.stream()
+	if !ok {
+		return nil, nil
+	}
+
+	i := sort.Search(len(s.entries), func(i int) bool {
+		return !s.entries[i].id.less(from)
+	})
+	j := sort.Search(len(s.entries), func(i int) bool {
+		return to.less(s.entries[i].id)
+	})
+	if i >= j {
+		return nil, nil
+	}
+
+	return append([]memStreamEntry(nil), s.entries[i:j]...), nil
+}
+
+// parseRangeID parses the bound of a range, as in Redis an ID without the sequence is the first sequence of the
+// millisecond for the start and the last sequence for the end.
+func parseRangeID(id string, end bool) (streamID, error) {
+	switch id {
+	case "-":
+		return streamID{}, nil
+	case "+":
+		return streamID{ms: math.MaxUint64, seq: math.MaxUint64}, nil
+	}
+
+	parsed, err := parseStreamID(id)
+	if err == nil && end && !strings.Contains(id, "-") {
+		parsed.seq = math.MaxUint64
+	}
+
+	return parsed, err
+}
+
+func (h *memStreamHandle) Trim(_ context.Context, options *TrimOptions) (int64, error) {
+	var minID streamID
+	if len(options.MinID) > 0 {
+		var err error
+		if minID, err = parseStreamID(options.MinID); err != nil {
+			return 0, err
+		}
+	}
+
+	h.cache.Lock()
+	defer h.cache.Unlock()
+
+	s, ok := h.stream()
+	if !ok {
+		return 0, nil
+	}
+
+	trimmed := 0
+	if options.MaxLen > 0 && int64(len(s.entries)) > options.MaxLen {
+		trimmed = len(s.entries) - int(options.MaxLen)
+	}
+	if len(options.MinID) > 0 {
+		for trimmed < len(s.entries) && s.entries[trimmed].id.less(minID) {
+			trimmed++
+		}
+	}
+
+	s.drop(trimmed)
+
+	return int64(trimmed), nil
+}
+
+// drop removes the n oldest entries of the stream. Caller must hold the lock.
+func (s *memStream) drop(n int) {
+	// the dropped entries are no longer delivered to the groups
+	for _, e := range s.entries[:n] {
+		for _, g := range s.groups {
+			delete(g.pending, e.id)
+		}
+	}
+	s.entries = append([]memStreamEntry(nil), s.entries[n:]...)
+}
+
+// matchPattern matches the key against a Redis glob-style pattern supporting '*', '?', '[...]' and '\' escapes.
+func matchPattern(pattern string, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 0 && pattern[0] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if matchPattern(pattern, key[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(key) == 0 {
+				return false
+			}
+		case '[':
+			end := strings.IndexByte(pattern[1:], ']')
+			if end < 0 {
+				// unterminated class is matched literally
+				if len(key) == 0 || key[0] != '[' {
+					return false
+				}
+				break
+			}
+			if len(key) == 0 || !matchClass(pattern[1:end+1], key[0]) {
+				return false
+			}
+			pattern = pattern[end+1:]
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(key) == 0 || key[0] != pattern[0] {
+				return false
+			}
+		}
+
+		pattern = pattern[1:]
+		key = key[1:]
+	}
+
+	return len(key) == 0
+}
+
+func matchClass(class string, c byte) bool {
+	negate := len(class) > 0 && class[0] == '^'
+	if negate {
+		class = class[1:]
+	}
+
+	matched := false
+	for i := 0; i < len(class); i++ {
+		if i+2 < len(class) && class[i+1] == '-' {
+			if class[i] <= c && c <= class[i+2] {
+				matched = true
+			}
+			i += 2
+		} else if class[i] == c {
+			matched = true
+		}
+	}
+
+	return matched != negate
+}
@@ -0,0 +1,253 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// memEvictionSample is the number of the keys sampled to pick the key to evict, like Redis the eviction is
+// approximate so that a write never walks the whole table.
+const memEvictionSample = 5
+
+// memTable is the limits and the usage of the table. The keys of the table are tracked to sample them for eviction.
+type memTable struct {
+	limits    Limits
+	keys      map[string]struct{}
+	scan      *scanIndex
+	bytes     int64
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+// sizeOf returns the approximate memory used by the key and the value of the entry.
+func (e *memEntry) sizeOf(cacheKey string) int64 {
+	size := len(cacheKey) + len(e.value)
+	for f, v := range e.hash {
+		size += len(f) + len(v)
+	}
+	for _, v := range e.list {
+		size += len(v)
+	}
+	for m := range e.zset {
+		size += len(m) + 8
+	}
+
+	return int64(size)
+}
+
+// table returns the table creating it if needed. Caller must hold the lock.
+func (c *memCache) table(tableName string) *memTable {
+	t, ok := c.tables[tableName]
+	if !ok {
+		t = &memTable{keys: make(map[string]struct{}), scan: newScanIndex()}
+		c.tables[tableName] = t
+	}
+
+	return t
+}
+
+// putEntry stores the entry of the key replacing the existing entry. Caller must hold the lock.
+func (c *memCache) putEntry(tableName string, cacheKey string, e *memEntry, now time.Time) {
+	if existing, ok := c.entries[cacheKey]; ok {
+		e.freq = existing.freq
+		c.removeEntry(cacheKey)
+	}
+	c.expireSample(now)
+
+	e.table = tableName
+	c.entries[cacheKey] = e
+	t := c.table(tableName)
+	t.keys[cacheKey] = struct{}{}
+	t.scan.add(cacheKey)
+	c.updated(cacheKey, e, now)
+}
+
+// updated accounts the size of the written entry and evicts the other keys of the table exceeding the limits. Caller
+// must hold the lock.
+func (c *memCache) updated(cacheKey string, e *memEntry, now time.Time) {
+	t := c.table(e.table)
+	size := e.sizeOf(cacheKey)
+	t.bytes += size - e.size
+	e.size = size
+	e.accessed = now
+
+	c.evict(t, cacheKey, now)
+}
+
+// removeEntry removes the key from the keyspace and from its table. Caller must hold the lock.
+func (c *memCache) removeEntry(cacheKey string) {
+	e, ok := c.entries[cacheKey]
+	if !ok {
+		return
+	}
+
+	delete(c.entries, cacheKey)
+	if t, ok := c.tables[e.table]; ok {
+		delete(t.keys, cacheKey)
+		t.scan.remove(cacheKey)
+		t.bytes -= e.size
+	}
+}
+
+// hit records the read of the entry. Caller must hold the lock.
+func (c *memCache) hit(e *memEntry, now time.Time) {
+	e.accessed = now
+	e.freq++
+	c.table(e.table).hits++
+}
+
+// miss records the read of a missing key. Caller must hold the lock.
+func (c *memCache) miss(tableName string) {
+	c.table(tableName).misses++
+}
+
+// admit returns ErrCacheFull if the key can't be written because the table is at its limits. Only the TTL-only
+// policy rejects the writes, the other policies evict the keys once the key is written. Caller must hold the lock.
+func (c *memCache) admit(tableName string, cacheKey string, now time.Time) error {
+	t, ok := c.tables[tableName]
+	if !ok || t.limits.Policy != EvictionTTLOnly {
+		return nil
+	}
+
+	full := func() bool {
+		keys := int64(len(t.keys))
+		if _, ok := t.keys[cacheKey]; ok {
+			// the key is replaced, it doesn't add to the number of the keys
+			keys--
+		}
+		return t.limits.full(keys, t.bytes)
+	}
+
+	if full() {
+		// the expired keys may still be accounted, remove them before rejecting the write
+		c.expireTable(t, now)
+		if full() {
+			return ErrCacheFull
+		}
+	}
+
+	return nil
+}
+
+// evict removes the keys of the table until the table is within its limits, the key being written is never evicted.
+// Caller must hold the lock.
+func (c *memCache) evict(t *memTable, keep string, now time.Time) {
+	if t.limits.Policy == EvictionTTLOnly {
+		return
+	}
+
+	for t.limits.over(int64(len(t.keys)), t.bytes) {
+		victim := c.evictionCandidate(t, keep, now)
+		if victim == "" {
+			return
+		}
+
+		expired := c.entries[victim].expired(now)
+		c.removeEntry(victim)
+		if !expired {
+			t.evictions++
+		}
+	}
+}
+
+// evictionCandidate samples the keys of the table and returns the least recently used or, with the LFU policy, the
+// least frequently used key. An expired key is returned right away. Caller must hold the lock.
+func (c *memCache) evictionCandidate(t *memTable, keep string, now time.Time) string {
+	var (
+		victim  string
+		ve      *memEntry
+		sampled int
+	)
+	for k := range t.keys {
+		if k == keep {
+			continue
+		}
+		if sampled == memEvictionSample {
+			break
+		}
+		sampled++
+
+		e := c.entries[k]
+		if e.expired(now) {
+			return k
+		}
+
+		var less bool
+		switch {
+		case ve == nil:
+			less = true
+		case t.limits.Policy == EvictionLFU && e.freq != ve.freq:
+			less = e.freq < ve.freq
+		default:
+			less = e.accessed.Before(ve.accessed)
+		}
+		if less {
+			victim, ve = k, e
+		}
+	}
+
+	return victim
+}
+
+// expireTable removes the expired keys of the table. Caller must hold the lock.
+func (c *memCache) expireTable(t *memTable, now time.Time) {
+	for k := range t.keys {
+		if c.entries[k].expired(now) {
+			c.removeEntry(k)
+		}
+	}
+}
+
+func (c *memCache) SetLimits(_ context.Context, tableName string, limits *Limits) error {
+	c.Lock()
+	defer c.Unlock()
+
+	t := c.table(tableName)
+	t.limits = *limits
+	c.evict(t, "", time.Now())
+
+	return nil
+}
+
+func (c *memCache) Stats(_ context.Context, tableName string) (*Stats, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	t, ok := c.tables[tableName]
+	if !ok {
+		return &Stats{}, nil
+	}
+	c.expireTable(t, time.Now())
+
+	return &Stats{
+		Keys:      int64(len(t.keys)),
+		Bytes:     t.bytes,
+		Hits:      t.hits,
+		Misses:    t.misses,
+		Evictions: t.evictions,
+	}, nil
+}
+
+func (c *memCache) DropStats(_ context.Context, tableName string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	delete(c.tables, tableName)
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"hash/fnv"
+	"math/rand"
+)
+
+const (
+	scanIndexMaxLevel = 32
+	// scanIndexP is the probability of a node to be promoted to the next level, one in four.
+	scanIndexP = 4
+)
+
+// scanHash returns the position of the key in the scan order.
+func scanHash(cacheKey string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(cacheKey))
+
+	return h.Sum64()
+}
+
+// scanIndex keeps the keys of a table ordered by their scan hash, and by the key for the keys sharing a hash. It is a
+// skip list, so adding and removing a key and finding the first key of a scan page are O(log N). It is guarded by the
+// lock of the memory cache.
+type scanIndex struct {
+	head  *scanNode
+	level int
+	rnd   *rand.Rand
+}
+
+type scanNode struct {
+	hash uint64
+	key  string
+	next []*scanNode
+}
+
+func newScanIndex() *scanIndex {
+	return &scanIndex{
+		head:  &scanNode{next: make([]*scanNode, scanIndexMaxLevel)},
+		level: 1,
+		//nolint:gosec
+		rnd: rand.New(rand.NewSource(rand.Int63())),
+	}
+}
+
+func (n *scanNode) before(hash uint64, key string) bool {
+	return n.hash < hash || (n.hash == hash && n.key < key)
+}
+
+// path returns the last node before the key on every level.
+func (s *scanIndex) path(hash uint64, key string) []*scanNode {
+	update := make([]*scanNode, scanIndexMaxLevel)
+	n := s.head
+	for l := s.level - 1; l >= 0; l-- {
+		for n.next[l] != nil && n.next[l].before(hash, key) {
+			n = n.next[l]
+		}
+		update[l] = n
+	}
+
+	return update
+}
+
+// add adds the key, adding an existing key is a no-op.
+func (s *scanIndex) add(key string) {
+	hash := scanHash(key)
+	update := s.path(hash, key)
+	if n := update[0].next[0]; n != nil && n.hash == hash && n.key == key {
+		return
+	}
+
+	level := 1
+	for level < scanIndexMaxLevel && s.rnd.Intn(scanIndexP) == 0 {
+		level++
+	}
+	for ; s.level < level; s.level++ {
+		update[s.level] = s.head
+	}
+
+	n := &scanNode{hash: hash, key: key, next: make([]*scanNode, level)}
+	for l := 0; l < level; l++ {
+		n.next[l] = update[l].next[l]
+		update[l].next[l] = n
+	}
+}
+
+// remove removes the key, removing a missing key is a no-op.
+func (s *scanIndex) remove(key string) {
+	hash := scanHash(key)
+	update := s.path(hash, key)
+	n := update[0].next[0]
+	if n == nil || n.hash != hash || n.key != key {
+		return
+	}
+
+	for l := 0; l < len(n.next); l++ {
+		update[l].next[l] = n.next[l]
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+}
+
+// seek returns the first node with the hash equal or greater than the cursor.
+func (s *scanIndex) seek(cursor uint64) *scanNode {
+	n := s.head
+	for l := s.level - 1; l >= 0; l-- {
+		for n.next[l] != nil && n.next[l].hash < cursor {
+			n = n.next[l]
+		}
+	}
+
+	return n.next[0]
+}
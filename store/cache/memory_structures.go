@@ -0,0 +1,346 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+)
+
+// structEntry returns the entry of the key if it is of the kind, a missing entry is created only if create is set.
+// The creation and the growth of the structure are subject to the limits of the table. Caller must hold the lock.
+func (c *memCache) structEntry(tableName string, cacheKey string, kind memKind, create bool, now time.Time) (*memEntry, error) {
+	e := c.getEntry(cacheKey, now)
+	switch {
+	case e != nil && e.kind != kind:
+		return nil, ErrWrongType
+	case !create:
+		return e, nil
+	}
+
+	if err := c.admit(tableName, cacheKey, now); err != nil {
+		return nil, err
+	}
+	if e != nil {
+		return e, nil
+	}
+
+	e = &memEntry{kind: kind}
+	switch kind {
+	case memHash:
+		e.hash = make(map[string][]byte)
+	case memSortedSet:
+		e.zset = make(map[string]float64)
+	}
+	c.putEntry(tableName, cacheKey, e, now)
+
+	return e, nil
+}
+
+// readStructEntry returns the entry of the key if it is of the kind and records the read. Caller must hold the lock.
+func (c *memCache) readStructEntry(tableName string, cacheKey string, kind memKind) (*memEntry, error) {
+	now := time.Now()
+	e, err := c.structEntry(tableName, cacheKey, kind, false, now)
+	switch {
+	case err != nil:
+		return nil, err
+	case e == nil:
+		c.miss(tableName)
+	default:
+		c.hit(e, now)
+	}
+
+	return e, nil
+}
+
+// removeIfEmpty removes the key once the structure has no elements like Redis does, otherwise the new size of the
+// structure is accounted. Caller must hold the lock.
+func (c *memCache) removeIfEmpty(cacheKey string, e *memEntry, now time.Time) {
+	if len(e.hash) == 0 && len(e.list) == 0 && len(e.zset) == 0 {
+		c.removeEntry(cacheKey)
+		return
+	}
+
+	c.updated(cacheKey, e, now)
+}
+
+func (c *memCache) HSet(_ context.Context, tableName string, key string, fields map[string][]byte) (int64, error) {
+	if len(fields) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	e, err := c.structEntry(tableName, cacheKey, memHash, true, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var added int64
+	for f, v := range fields {
+		if _, ok := e.hash[f]; !ok {
+			added++
+		}
+		e.hash[f] = bytes.Clone(v)
+	}
+	c.updated(cacheKey, e, now)
+
+	return added, nil
+}
+
+func (c *memCache) HGet(_ context.Context, tableName string, key string, field string) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	e, err := c.readStructEntry(tableName, encodeToCacheKey(tableName, key), memHash)
+	if err != nil {
+		return nil, err
+	}
+	if e == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	value, ok := e.hash[field]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	return value, nil
+}
+
+func (c *memCache) HGetAll(_ context.Context, tableName string, key string) (map[string][]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	e, err := c.readStructEntry(tableName, encodeToCacheKey(tableName, key), memHash)
+	if err != nil || e == nil {
+		return map[string][]byte{}, err
+	}
+
+	fields := make(map[string][]byte, len(e.hash))
+	for f, v := range e.hash {
+		fields[f] = v
+	}
+
+	return fields, nil
+}
+
+func (c *memCache) HDel(_ context.Context, tableName string, key string, fields ...string) (int64, error) {
+	if len(fields) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	e, err := c.structEntry(tableName, cacheKey, memHash, false, now)
+	if err != nil || e == nil {
+		return 0, err
+	}
+
+	var deleted int64
+	for _, f := range fields {
+		if _, ok := e.hash[f]; ok {
+			delete(e.hash, f)
+			deleted++
+		}
+	}
+	c.removeIfEmpty(cacheKey, e, now)
+
+	return deleted, nil
+}
+
+func (c *memCache) LPush(_ context.Context, tableName string, key string, values ...[]byte) (int64, error) {
+	return c.push(tableName, key, true, values)
+}
+
+func (c *memCache) RPush(_ context.Context, tableName string, key string, values ...[]byte) (int64, error) {
+	return c.push(tableName, key, false, values)
+}
+
+func (c *memCache) push(tableName string, key string, front bool, values [][]byte) (int64, error) {
+	if len(values) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	e, err := c.structEntry(tableName, cacheKey, memList, true, now)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, v := range values {
+		if front {
+			// like Redis, the values pushed to the front end up in the reverse order
+			e.list = append([][]byte{bytes.Clone(v)}, e.list...)
+		} else {
+			e.list = append(e.list, bytes.Clone(v))
+		}
+	}
+	c.updated(cacheKey, e, now)
+
+	return int64(len(e.list)), nil
+}
+
+func (c *memCache) LPop(_ context.Context, tableName string, key string) ([]byte, error) {
+	return c.pop(tableName, key, true)
+}
+
+func (c *memCache) RPop(_ context.Context, tableName string, key string) ([]byte, error) {
+	return c.pop(tableName, key, false)
+}
+
+func (c *memCache) pop(tableName string, key string, front bool) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	e, err := c.structEntry(tableName, cacheKey, memList, false, now)
+	if err != nil {
+		return nil, err
+	}
+	if e == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	var value []byte
+	if front {
+		value, e.list = e.list[0], e.list[1:]
+	} else {
+		value, e.list = e.list[len(e.list)-1], e.list[:len(e.list)-1]
+	}
+	c.removeIfEmpty(cacheKey, e, now)
+
+	return value, nil
+}
+
+func (c *memCache) LRange(_ context.Context, tableName string, key string, start int64, stop int64) ([][]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	e, err := c.readStructEntry(tableName, encodeToCacheKey(tableName, key), memList)
+	if err != nil || e == nil {
+		return [][]byte{}, err
+	}
+
+	from, to := rankRange(start, stop, len(e.list))
+	values := make([][]byte, 0, to-from)
+	values = append(values, e.list[from:to]...)
+
+	return values, nil
+}
+
+func (c *memCache) ZAdd(_ context.Context, tableName string, key string, members ...ScoredMember) (int64, error) {
+	if len(members) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	cacheKey := encodeToCacheKey(tableName, key)
+	e, err := c.structEntry(tableName, cacheKey, memSortedSet, true, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var added int64
+	for _, m := range members {
+		if _, ok := e.zset[string(m.Member)]; !ok {
+			added++
+		}
+		e.zset[string(m.Member)] = m.Score
+	}
+	c.updated(cacheKey, e, now)
+
+	return added, nil
+}
+
+func (c *memCache) ZRange(_ context.Context, tableName string, key string, query *RangeQuery) ([]ScoredMember, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	e, err := c.readStructEntry(tableName, encodeToCacheKey(tableName, key), memSortedSet)
+	if err != nil || e == nil {
+		return []ScoredMember{}, err
+	}
+
+	// members are ordered by the score and then lexicographically like Redis
+	members := make([]ScoredMember, 0, len(e.zset))
+	for m, score := range e.zset {
+		members = append(members, ScoredMember{Member: []byte(m), Score: score})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score != members[j].Score {
+			return members[i].Score < members[j].Score
+		}
+		return bytes.Compare(members[i].Member, members[j].Member) < 0
+	})
+	if query.Reverse {
+		for i, j := 0, len(members)-1; i < j; i, j = i+1, j-1 {
+			members[i], members[j] = members[j], members[i]
+		}
+	}
+
+	if !query.ByScore {
+		from, to := rankRange(query.Start, query.Stop, len(members))
+		return members[from:to], nil
+	}
+
+	selected := make([]ScoredMember, 0, len(members))
+	for _, m := range members {
+		if m.Score >= query.Min && m.Score <= query.Max {
+			selected = append(selected, m)
+		}
+	}
+
+	return selected, nil
+}
+
+// rankRange converts the inclusive ranks, negative ranks are counted from the end, to the bounds of a slice of
+// length n.
+func rankRange(start int64, stop int64, n int) (int, int) {
+	length := int64(n)
+	if start < 0 {
+		start += length
+	}
+	if stop < 0 {
+		stop += length
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= length {
+		stop = length - 1
+	}
+	if start > stop {
+		return 0, 0
+	}
+
+	return int(start), int(stop) + 1
+}
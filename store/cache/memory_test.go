@@ -0,0 +1,451 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/internal"
+)
+
+func TestMemoryCacheExpiry(t *testing.T) {
+	ctx := context.TODO()
+	c := newMemCache()
+	data := internal.NewCacheData([]byte(`{"a": "b"}`))
+
+	require.NoError(t, c.Set(ctx, "t1", "ex", data, &SetOptions{PX: 20}))
+	require.NoError(t, c.Set(ctx, "t1", "persist", data, nil))
+	n, err := c.Exists(ctx, "t1", "ex", "persist")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), n)
+
+	time.Sleep(30 * time.Millisecond)
+	_, err = c.Get(ctx, "t1", "ex", nil)
+	require.Equal(t, ErrKeyNotFound, err)
+	keys, err := c.Keys(ctx, "t1", "*")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t1:persist"}, keys)
+
+	// the expired key can be set with NX and the expiry is reset by the plain set
+	require.NoError(t, c.Set(ctx, "t1", "ex", data, &SetOptions{NX: true, PX: 20}))
+	require.NoError(t, c.Set(ctx, "t1", "ex", data, nil))
+	time.Sleep(30 * time.Millisecond)
+	_, err = c.Get(ctx, "t1", "ex", nil)
+	require.NoError(t, err)
+
+	_, err = c.Get(ctx, "t1", "ex", &GetOptions{Expiry: 20 * time.Millisecond})
+	require.NoError(t, err)
+	time.Sleep(30 * time.Millisecond)
+	_, err = c.Get(ctx, "t1", "ex", nil)
+	require.Equal(t, ErrKeyNotFound, err)
+
+	_, err = c.Get(ctx, "t1", "persist", &GetOptions{GetDelete: true})
+	require.NoError(t, err)
+	n, err = c.Exists(ctx, "t1", "persist")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), n)
+
+	prev, err := c.GetSet(ctx, "t1", "new", data)
+	require.NoError(t, err)
+	require.Nil(t, prev)
+}
+
+func TestMemoryCacheCounterExpiry(t *testing.T) {
+	ctx := context.TODO()
+	c := newMemCache()
+
+	// the expiry starts when the counter is created
+	value, err := c.Incr(ctx, "t1", "counter", 1, &IncrOptions{PX: 30})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), value)
+	time.Sleep(20 * time.Millisecond)
+	value, err = c.Incr(ctx, "t1", "counter", 1, &IncrOptions{PX: 30})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), value)
+	time.Sleep(20 * time.Millisecond)
+	value, err = c.Incr(ctx, "t1", "counter", 1, &IncrOptions{PX: 30})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), value)
+
+	_, err = c.Incr(ctx, "t1", "max", math.MaxInt64, nil)
+	require.NoError(t, err)
+	_, err = c.Incr(ctx, "t1", "max", 1, nil)
+	require.Equal(t, ErrNotInteger, err)
+}
+
+func TestMemoryCacheNumberValues(t *testing.T) {
+	ctx := context.TODO()
+	c := newMemCache()
+
+	// the values looking like numbers are returned as they are set
+	for _, v := range []string{`00123`, ` 7`, `8`} {
+		require.NoError(t, c.Set(ctx, "t1", "number", internal.NewCacheData([]byte(v)), nil))
+		g, err := c.Get(ctx, "t1", "number", nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte(v), g.RawData)
+	}
+
+	// only the integers in the canonical form can be incremented
+	value, err := c.Incr(ctx, "t1", "number", 34, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), value)
+	g, err := c.Get(ctx, "t1", "number", nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`42`), g.RawData)
+
+	require.NoError(t, c.Set(ctx, "t1", "padded", internal.NewCacheData([]byte(`00123`)), nil))
+	_, err = c.Incr(ctx, "t1", "padded", 1, nil)
+	require.Equal(t, ErrNotInteger, err)
+}
+
+func TestMemoryCacheScan(t *testing.T) {
+	ctx := context.TODO()
+	c := newMemCache()
+	data := internal.NewCacheData([]byte(`{"a": "b"}`))
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, c.Set(ctx, "t1", fmt.Sprintf("k%d", i), data, nil))
+	}
+	require.NoError(t, c.Set(ctx, "t2", "k1", data, nil))
+
+	// deleting the returned keys doesn't cause the remaining keys to be skipped
+	var cursor uint64
+	seen := make(map[string]struct{})
+	for {
+		keys, next, err := c.Scan(ctx, "t1", cursor, 7, "*")
+		require.NoError(t, err)
+		for _, k := range keys {
+			seen[k] = struct{}{}
+			_, key := decodeCacheKey(k)
+			_, err = c.Delete(ctx, "t1", key)
+			require.NoError(t, err)
+		}
+		if next == 0 {
+			break
+		}
+		require.Greater(t, next, cursor)
+		cursor = next
+	}
+	require.Len(t, seen, 100)
+
+	keys, err := c.Keys(ctx, "t1", "*")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	keys, next, err := c.Scan(ctx, "t2", 0, 10, "*")
+	require.NoError(t, err)
+	require.Equal(t, []string{"t2:k1"}, keys)
+	require.Equal(t, uint64(0), next)
+}
+
+func TestMemoryScanIndex(t *testing.T) {
+	s := newScanIndex()
+	for i := 0; i < 1000; i++ {
+		s.add(fmt.Sprintf("k%d", i))
+	}
+	s.add("k1")
+	for i := 0; i < 1000; i += 2 {
+		s.remove(fmt.Sprintf("k%d", i))
+	}
+	s.remove("missing")
+
+	var (
+		count int
+		prev  *scanNode
+	)
+	for n := s.seek(0); n != nil; n = n.next[0] {
+		if prev != nil {
+			require.True(t, prev.before(n.hash, n.key))
+		}
+		require.Equal(t, scanHash(n.key), n.hash)
+		prev = n
+		count++
+	}
+	require.Equal(t, 500, count)
+
+	// seek starts from the first key with the hash equal or greater than the cursor
+	mid := s.seek(math.MaxUint64 / 2)
+	require.NotNil(t, mid)
+	require.GreaterOrEqual(t, mid.hash, uint64(math.MaxUint64/2))
+	require.Nil(t, s.seek(prev.hash+1))
+}
+
+func TestMemoryCacheEviction(t *testing.T) {
+	ctx := context.TODO()
+	data := internal.NewCacheData([]byte(`{"a": "b"}`))
+
+	t.Run("lru", func(t *testing.T) {
+		c := newMemCache()
+		require.NoError(t, c.SetLimits(ctx, "t1", &Limits{MaxKeys: 3, Policy: EvictionLRU}))
+		for _, k := range []string{"k1", "k2", "k3"} {
+			require.NoError(t, c.Set(ctx, "t1", k, data, nil))
+			time.Sleep(time.Millisecond)
+		}
+
+		// k2 is the least recently used once k1 is read
+		_, err := c.Get(ctx, "t1", "k1", nil)
+		require.NoError(t, err)
+		require.NoError(t, c.Set(ctx, "t1", "k4", data, nil))
+
+		keys, err := c.Keys(ctx, "t1", "*")
+		require.NoError(t, err)
+		require.Equal(t, []string{"t1:k1", "t1:k3", "t1:k4"}, keys)
+
+		stats, err := c.Stats(ctx, "t1")
+		require.NoError(t, err)
+		require.Equal(t, int64(3), stats.Keys)
+		require.Equal(t, int64(1), stats.Evictions)
+		require.Equal(t, int64(1), stats.Hits)
+	})
+
+	t.Run("lfu", func(t *testing.T) {
+		c := newMemCache()
+		require.NoError(t, c.SetLimits(ctx, "t1", &Limits{MaxKeys: 2, Policy: EvictionLFU}))
+		require.NoError(t, c.Set(ctx, "t1", "k1", data, nil))
+		require.NoError(t, c.Set(ctx, "t1", "k2", data, nil))
+		for i := 0; i < 3; i++ {
+			_, err := c.Get(ctx, "t1", "k1", nil)
+			require.NoError(t, err)
+		}
+		_, err := c.Get(ctx, "t1", "k2", nil)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Set(ctx, "t1", "k3", data, nil))
+		keys, err := c.Keys(ctx, "t1", "*")
+		require.NoError(t, err)
+		require.Equal(t, []string{"t1:k1", "t1:k3"}, keys)
+	})
+
+	t.Run("max_bytes", func(t *testing.T) {
+		c := newMemCache()
+		require.NoError(t, c.Set(ctx, "t1", "k1", data, nil))
+		require.NoError(t, c.Set(ctx, "t1", "k2", data, nil))
+		stats, err := c.Stats(ctx, "t1")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), stats.Keys)
+
+		// lowering the limits evicts the keys right away
+		require.NoError(t, c.SetLimits(ctx, "t1", &Limits{MaxBytes: stats.Bytes / 2, Policy: EvictionLRU}))
+		stats, err = c.Stats(ctx, "t1")
+		require.NoError(t, err)
+		require.Equal(t, int64(1), stats.Keys)
+		require.Equal(t, int64(1), stats.Evictions)
+	})
+
+	t.Run("ttl_only", func(t *testing.T) {
+		c := newMemCache()
+		require.NoError(t, c.SetLimits(ctx, "t1", &Limits{MaxKeys: 2, Policy: EvictionTTLOnly}))
+		require.NoError(t, c.Set(ctx, "t1", "k1", data, &SetOptions{PX: 20}))
+		require.NoError(t, c.Set(ctx, "t1", "k2", data, nil))
+
+		require.Equal(t, ErrCacheFull, c.Set(ctx, "t1", "k3", data, nil))
+		_, err := c.HSet(ctx, "t1", "h1", map[string][]byte{"f": []byte("v")})
+		require.Equal(t, ErrCacheFull, err)
+
+		// the existing keys can be overwritten
+		require.NoError(t, c.Set(ctx, "t1", "k2", data, nil))
+
+		// the expired key frees the room
+		time.Sleep(30 * time.Millisecond)
+		require.NoError(t, c.Set(ctx, "t1", "k3", data, nil))
+
+		stats, err := c.Stats(ctx, "t1")
+		require.NoError(t, err)
+		require.Equal(t, int64(2), stats.Keys)
+		require.Equal(t, int64(0), stats.Evictions)
+	})
+}
+
+func TestMemoryCacheStats(t *testing.T) {
+	ctx := context.TODO()
+	c := newMemCache()
+	data := internal.NewCacheData([]byte(`{"a": "b"}`))
+
+	require.NoError(t, c.Set(ctx, "t1", "k1", data, nil))
+	_, err := c.HSet(ctx, "t1", "h1", map[string][]byte{"f1": []byte("v1")})
+	require.NoError(t, err)
+	require.NoError(t, c.Set(ctx, "t2", "k1", data, nil))
+
+	_, err = c.Get(ctx, "t1", "k1", nil)
+	require.NoError(t, err)
+	_, err = c.Get(ctx, "t1", "missing", nil)
+	require.Equal(t, ErrKeyNotFound, err)
+	_, err = c.MGet(ctx, "t1", "k1", "missing")
+	require.NoError(t, err)
+	_, err = c.HGetAll(ctx, "t1", "h1")
+	require.NoError(t, err)
+
+	stats, err := c.Stats(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stats.Keys)
+	require.Equal(t, int64(3), stats.Hits)
+	require.Equal(t, int64(2), stats.Misses)
+	bytes := stats.Bytes
+	require.Greater(t, bytes, int64(0))
+
+	// the growth and the removal of the structure is accounted
+	_, err = c.HSet(ctx, "t1", "h1", map[string][]byte{"f2": []byte("v2")})
+	require.NoError(t, err)
+	stats, err = c.Stats(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, bytes+4, stats.Bytes)
+
+	_, err = c.Delete(ctx, "t1", "k1", "h1")
+	require.NoError(t, err)
+	stats, err = c.Stats(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), stats.Keys)
+	require.Equal(t, int64(0), stats.Bytes)
+
+	// the other table is not affected and the dropped stats start from scratch
+	stats, err = c.Stats(ctx, "t2")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.Keys)
+
+	require.NoError(t, c.DropStats(ctx, "t1"))
+	stats, err = c.Stats(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, &Stats{}, stats)
+}
+
+func TestParseEvictionPolicy(t *testing.T) {
+	for _, c := range []struct {
+		policy   string
+		expected EvictionPolicy
+	}{
+		{"", EvictionLRU},
+		{"LRU", EvictionLRU},
+		{"lfu", EvictionLFU},
+		{"ttl", EvictionTTLOnly},
+	} {
+		policy, err := ParseEvictionPolicy(c.policy)
+		require.NoError(t, err)
+		require.Equal(t, c.expected, policy)
+	}
+
+	_, err := ParseEvictionPolicy("random")
+	require.Error(t, err)
+}
+
+func TestMemoryStreamGroups(t *testing.T) {
+	ctx := context.TODO()
+	c := newMemCache()
+
+	_, err := c.GetStream(ctx, "s1")
+	require.Equal(t, ErrStreamNotFound, err)
+	s, err := c.CreateStream(ctx, "s1")
+	require.NoError(t, err)
+	_, err = c.CreateStream(ctx, "s1")
+	require.Equal(t, ErrStreamAlreadyExists, err)
+
+	first, err := s.Add(ctx, internal.NewStreamData(internal.JsonEncoding, nil, []byte("1")))
+	require.NoError(t, err)
+	second, err := s.Add(ctx, internal.NewStreamData(internal.JsonEncoding, nil, []byte("2")))
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+
+	// the new group starts after the existing entries
+	require.NoError(t, s.CreateConsumerGroup(ctx, "w1", ConsumerGroupDefaultCurrentPos))
+	require.Equal(t, ErrGroupAlreadyExists, s.CreateConsumerGroup(ctx, "w1", "0"))
+
+	messages, exists, err := s.ReadGroup(ctx, DefaultGroup, ReadGroupPosCurrent)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Len(t, messages.Messages, 2)
+	decoded, err := messages.Decode(messages.Messages[1])
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), decoded.RawData)
+	require.Equal(t, second, decoded.Id)
+
+	group, _, err := s.GetConsumerGroup(ctx, DefaultGroup)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), group.Pending)
+	require.Equal(t, second, group.LastDeliveredID)
+
+	require.NoError(t, s.Ack(ctx, DefaultGroup, first))
+	messages, _, err = s.ReadGroup(ctx, DefaultGroup, ReadGroupPosStart)
+	require.NoError(t, err)
+	require.Len(t, messages.Messages, 1)
+	require.Equal(t, second, messages.Messages[0].ID)
+
+	// the blocked read is woken up by the add
+	read := make(chan *StreamMessages)
+	go func() {
+		messages, _, _ := s.ReadGroup(ctx, "w1", ReadGroupPosCurrent)
+		read <- messages
+	}()
+	third, err := s.Add(ctx, internal.NewStreamData(internal.JsonEncoding, nil, []byte("3")))
+	require.NoError(t, err)
+	messages = <-read
+	require.Len(t, messages.Messages, 1)
+	require.Equal(t, third, messages.Messages[0].ID)
+
+	// moving the group back redelivers the entries
+	require.NoError(t, s.SetID(ctx, "w1", "0"))
+	messages, _, err = s.ReadGroup(ctx, "w1", ReadGroupPosCurrent)
+	require.NoError(t, err)
+	require.Len(t, messages.Messages, 3)
+
+	messages, exists, err = s.Read(ctx, second)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Len(t, messages.Messages, 1)
+
+	streams, err := c.ListStreams(ctx, "s*")
+	require.NoError(t, err)
+	require.Equal(t, []string{"s1"}, streams)
+
+	// the deletion of the stream wakes up the blocked readers
+	deleted := make(chan bool)
+	go func() {
+		_, exists, _ := s.ReadGroup(ctx, "w1", ReadGroupPosCurrent)
+		deleted <- exists
+	}()
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, s.Delete(ctx))
+	require.False(t, <-deleted)
+
+	groups, err := s.GetConsumerGroups(ctx)
+	require.NoError(t, err)
+	require.Empty(t, groups)
+}
+
+func TestMatchPattern(t *testing.T) {
+	cases := []struct {
+		pattern string
+		key     string
+		matches bool
+	}{
+		{"*", "", true},
+		{"t1:*", "t1:key", true},
+		{"t1:*", "t2:key", false},
+		{"t1:k?y", "t1:key", true},
+		{"t1:k?y", "t1:ky", false},
+		{"t1:*y*", "t1:a/y/b", true},
+		{"t1:[a-c]1", "t1:b1", true},
+		{"t1:[^a-c]1", "t1:b1", false},
+		{"t1:[xy]1", "t1:y1", true},
+		{`t1:\*`, "t1:*", true},
+		{`t1:\*`, "t1:a", false},
+		{"t1:[a", "t1:[a", true},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.matches, matchPattern(c.pattern, c.key), "%s %s", c.pattern, c.key)
+	}
+}
@@ -16,6 +16,8 @@ package cache
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	xredis "github.com/go-redis/redis/v8"
@@ -48,6 +50,21 @@ type Stream interface {
 	Ack(ctx context.Context, group string, ids ...string) error
 	// Delete is to delete this stream. it removes all the associated consumer group as well.
 	Delete(ctx context.Context) error
+	// Range returns the messages with the ID between start and end inclusive in the ascending order, "-" and "+" are
+	// the first and the last ID of the stream. The count limits the number of the messages if greater than zero.
+	Range(ctx context.Context, start string, end string, count int64) (*StreamMessages, error)
+	// RevRange is similar to Range but returns the messages in the descending order starting from the end.
+	RevRange(ctx context.Context, end string, start string, count int64) (*StreamMessages, error)
+	// Trim removes the oldest messages exceeding the limits and returns the number of the messages removed.
+	Trim(ctx context.Context, options *TrimOptions) (int64, error)
+}
+
+// TrimOptions are the limits of the messages kept in a stream, a zero value disables the limit.
+type TrimOptions struct {
+	// MaxLen is the maximum number of the messages kept in the stream.
+	MaxLen int64
+	// MinID removes the messages with the ID lower than it.
+	MinID string
 }
 
 type SetOptions struct {
@@ -61,6 +78,113 @@ type SetOptions struct {
 	PX uint64
 }
 
+// ttl returns the expiry of the key, zero means the key doesn't expire.
+func (o *SetOptions) ttl() time.Duration {
+	switch {
+	case o == nil:
+		return 0
+	case o.EX > 0:
+		return time.Duration(o.EX) * time.Second
+	case o.PX > 0:
+		return time.Duration(o.PX) * time.Millisecond
+	}
+
+	return 0
+}
+
+type IncrOptions struct {
+	// EX sets the Expiry time of the counter in second when the counter is created.
+	EX uint64
+	// PX sets the Expiry time of the counter in millisecond when the counter is created.
+	PX uint64
+}
+
+func (o *IncrOptions) ttl() time.Duration {
+	if o == nil {
+		return 0
+	}
+
+	return (&SetOptions{EX: o.EX, PX: o.PX}).ttl()
+}
+
+// ScoredMember is a member of a sorted set along with its score.
+type ScoredMember struct {
+	Member []byte
+	Score  float64
+}
+
+// RangeQuery selects the members of a sorted set by the rank or by the score, the members are ordered by the
+// ascending score unless Reverse is set.
+type RangeQuery struct {
+	// Start and Stop are the inclusive ranks, negative ranks are counted from the end. They are ignored if ByScore is set.
+	Start int64
+	Stop  int64
+	// ByScore selects the members with the score between Min and Max inclusive.
+	ByScore bool
+	Min     float64
+	Max     float64
+	Reverse bool
+}
+
+// EvictionPolicy selects the keys evicted once the table reaches its limits.
+type EvictionPolicy string
+
+const (
+	// EvictionLRU evicts the least recently used keys.
+	EvictionLRU EvictionPolicy = "lru"
+	// EvictionLFU evicts the least frequently used keys.
+	EvictionLFU EvictionPolicy = "lfu"
+	// EvictionTTLOnly never evicts, the keys are only removed once they expire and the writes are rejected with
+	// ErrCacheFull while the table is at its limits.
+	EvictionTTLOnly EvictionPolicy = "ttl"
+)
+
+// ParseEvictionPolicy returns the eviction policy, the default policy is LRU.
+func ParseEvictionPolicy(policy string) (EvictionPolicy, error) {
+	switch p := EvictionPolicy(strings.ToLower(policy)); p {
+	case "":
+		return EvictionLRU, nil
+	case EvictionLRU, EvictionLFU, EvictionTTLOnly:
+		return p, nil
+	}
+
+	return "", fmt.Errorf("unsupported eviction policy '%s'", policy)
+}
+
+// Limits are the limits of the table, zero means unlimited. The memory usage of a key is approximate, it is the size
+// of the key and the value as accounted by the backend.
+type Limits struct {
+	MaxKeys  int64
+	MaxBytes int64
+	Policy   EvictionPolicy
+}
+
+// limited returns true if the table has any limit, the usage of the tables without limits is not tracked.
+func (l *Limits) limited() bool {
+	return l.MaxKeys > 0 || l.MaxBytes > 0
+}
+
+// over returns true if the usage exceeds the limits.
+func (l *Limits) over(keys int64, bytes int64) bool {
+	return (l.MaxKeys > 0 && keys > l.MaxKeys) || (l.MaxBytes > 0 && bytes > l.MaxBytes)
+}
+
+// full returns true if a new key can't be added without exceeding the limits.
+func (l *Limits) full(keys int64, bytes int64) bool {
+	return (l.MaxKeys > 0 && keys >= l.MaxKeys) || (l.MaxBytes > 0 && bytes >= l.MaxBytes)
+}
+
+// Stats is the usage of the table. The hits and the misses are counted by the reads of the keys, the evictions are
+// the keys removed to keep the table within its limits. The Redis backend only tracks the keys and the bytes of the
+// tables with limits, they are zero for the other tables.
+type Stats struct {
+	Keys      int64
+	Bytes     int64
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
 type GetOptions struct {
 	// Expiry set the expiration time of the key
 	Expiry    time.Duration
@@ -75,10 +199,62 @@ type Cache interface {
 	Get(ctx context.Context, tableName string, key string, options *GetOptions) (*internal.CacheData, error)
 	// Delete deletes one or more keys
 	Delete(ctx context.Context, tableName string, keys ...string) (int64, error)
+	// Unlink deletes one or more keys, unlike Delete the memory is reclaimed in the background so large values
+	// don't block the cache
+	Unlink(ctx context.Context, tableName string, keys ...string) (int64, error)
 	// Exists returns if the key exists, for multiple keys it returns the count of the number of keys that exists
 	Exists(ctx context.Context, tableName string, key ...string) (int64, error)
+	// Keys returns all the keys matching the pattern in a single call, it blocks the cache while it walks the whole
+	// keyspace so Scan should be preferred for anything but small caches
 	Keys(ctx context.Context, tableName string, pattern string) ([]string, error)
-	Scan(ctx context.Context, tableName string, cursor uint64, count int64, pattern string) ([]string, uint64)
+	// Scan returns a batch of the keys matching the pattern and the cursor of the next batch, the iteration is
+	// complete when the returned cursor is zero
+	Scan(ctx context.Context, tableName string, cursor uint64, count int64, pattern string) ([]string, uint64, error)
+	// MGet returns the values of the keys in the order of the keys, the value of a missing key is nil
+	MGet(ctx context.Context, tableName string, keys ...string) ([]*internal.CacheData, error)
+	// MSet sets all the keys atomically
+	MSet(ctx context.Context, tableName string, values map[string]*internal.CacheData) error
+	// Incr increments the integer value of the key by delta and returns the new value, a missing key is set to zero
+	// before the increment
+	Incr(ctx context.Context, tableName string, key string, delta int64, options *IncrOptions) (int64, error)
+	// Decr decrements the integer value of the key by delta and returns the new value
+	Decr(ctx context.Context, tableName string, key string, delta int64, options *IncrOptions) (int64, error)
+	// CompareAndSet sets the key only if the current value is equal to the expected value, a nil expected value
+	// means the key must not exist. It returns whether the value is set, or ErrConflict if the key keeps being
+	// modified concurrently.
+	CompareAndSet(ctx context.Context, tableName string, key string, expected []byte, value *internal.CacheData, options *SetOptions) (bool, error)
+
+	// HSet sets the fields of the hash and returns the number of the fields added
+	HSet(ctx context.Context, tableName string, key string, fields map[string][]byte) (int64, error)
+	// HGet returns the value of the field of the hash
+	HGet(ctx context.Context, tableName string, key string, field string) ([]byte, error)
+	// HGetAll returns all the fields of the hash, an empty map if the hash doesn't exist
+	HGetAll(ctx context.Context, tableName string, key string) (map[string][]byte, error)
+	// HDel deletes the fields of the hash and returns the number of the fields deleted
+	HDel(ctx context.Context, tableName string, key string, fields ...string) (int64, error)
+
+	// LPush prepends the values to the list and returns the length of the list
+	LPush(ctx context.Context, tableName string, key string, values ...[]byte) (int64, error)
+	// RPush appends the values to the list and returns the length of the list
+	RPush(ctx context.Context, tableName string, key string, values ...[]byte) (int64, error)
+	// LPop removes and returns the first element of the list
+	LPop(ctx context.Context, tableName string, key string) ([]byte, error)
+	// RPop removes and returns the last element of the list
+	RPop(ctx context.Context, tableName string, key string) ([]byte, error)
+	// LRange returns the elements of the list between the inclusive indexes, negative indexes are counted from the end
+	LRange(ctx context.Context, tableName string, key string, start int64, stop int64) ([][]byte, error)
+
+	// ZAdd adds the members to the sorted set or updates their score, it returns the number of the members added
+	ZAdd(ctx context.Context, tableName string, key string, members ...ScoredMember) (int64, error)
+	// ZRange returns the members of the sorted set selected by the query
+	ZRange(ctx context.Context, tableName string, key string, query *RangeQuery) ([]ScoredMember, error)
+
+	// SetLimits sets the limits of the table, the keys exceeding the limits are evicted as per the eviction policy
+	SetLimits(ctx context.Context, tableName string, limits *Limits) error
+	// Stats returns the usage of the table
+	Stats(ctx context.Context, tableName string) (*Stats, error)
+	// DropStats removes the limits and the usage of the table, it is called once the keys of the table are deleted
+	DropStats(ctx context.Context, tableName string) error
 
 	// CreateStream creates and returns a stream object, throws an error if stream already exists
 	CreateStream(ctx context.Context, streamName string) (Stream, error)
@@ -92,6 +268,12 @@ type Cache interface {
 	DeleteStream(ctx context.Context, streamName string) error
 }
 
+// NewCache returns the Redis backed cache, or the in-process cache shared by all the callers if the cache is
+// configured in-memory.
 func NewCache(cfg *config.CacheConfig) Cache {
+	if cfg.InMemory {
+		return getMemCache()
+	}
+
 	return newCache(cfg)
 }
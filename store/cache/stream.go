@@ -17,6 +17,7 @@ package cache
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	xredis "github.com/go-redis/redis/v8"
@@ -188,6 +189,88 @@ func (s *stream) Delete(ctx context.Context) error {
 	return err
 }
 
+func (s *stream) Range(ctx context.Context, start string, end string, count int64) (*StreamMessages, error) {
+	if count > 0 {
+		return s.messages(s.cache.Client.XRangeN(ctx, s.name, start, end, count).Result())
+	}
+
+	return s.messages(s.cache.Client.XRange(ctx, s.name, start, end).Result())
+}
+
+func (s *stream) RevRange(ctx context.Context, end string, start string, count int64) (*StreamMessages, error) {
+	if count > 0 {
+		return s.messages(s.cache.Client.XRevRangeN(ctx, s.name, end, start, count).Result())
+	}
+
+	return s.messages(s.cache.Client.XRevRange(ctx, s.name, end, start).Result())
+}
+
+func (s *stream) messages(messages []xredis.XMessage, err error) (*StreamMessages, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamMessages{
+		XStream: xredis.XStream{Stream: s.name, Messages: messages},
+	}, nil
+}
+
+func (s *stream) Trim(ctx context.Context, options *TrimOptions) (int64, error) {
+	var trimmed int64
+	if options.MaxLen > 0 {
+		n, err := s.cache.Client.XTrimMaxLen(ctx, s.name, options.MaxLen).Result()
+		if err != nil {
+			return trimmed, err
+		}
+		trimmed += n
+	}
+	if len(options.MinID) > 0 {
+		n, err := s.cache.Client.XTrimMinID(ctx, s.name, options.MinID).Result()
+		if err != nil {
+			return trimmed, err
+		}
+		trimmed += n
+	}
+
+	return trimmed, nil
+}
+
+// TimeStreamID returns the first ID of the messages added to a stream at the time.
+func TimeStreamID(t time.Time) string {
+	return streamID{ms: uint64(t.UnixMilli())}.String()
+}
+
+// NextStreamID returns the ID following the id, it is the exclusive start of a range.
+func NextStreamID(id string) (string, error) {
+	parsed, err := parseStreamID(id)
+	if err != nil {
+		return "", err
+	}
+
+	if parsed.seq == math.MaxUint64 {
+		return streamID{ms: parsed.ms + 1}.String(), nil
+	}
+	return streamID{ms: parsed.ms, seq: parsed.seq + 1}.String(), nil
+}
+
+// PrevStreamID returns the ID preceding the id, it is the exclusive end of a range or the position of a consumer
+// group that is delivered the message with the id next.
+func PrevStreamID(id string) (string, error) {
+	parsed, err := parseStreamID(id)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case parsed.seq > 0:
+		return streamID{ms: parsed.ms, seq: parsed.seq - 1}.String(), nil
+	case parsed.ms > 0:
+		return streamID{ms: parsed.ms - 1, seq: math.MaxUint64}.String(), nil
+	default:
+		return parsed.String(), nil
+	}
+}
+
 func encodeToStreamValue(event *internal.StreamData) (map[string]any, error) {
 	enc, err := internal.EncodeStreamData(event)
 	if err != nil {
@@ -29,8 +29,15 @@ import (
 )
 
 func TestStream(t *testing.T) {
+	testStream(t, NewCache(config.GetTestCacheConfig()))
+}
+
+func TestMemoryStream(t *testing.T) {
+	testStream(t, newMemCache())
+}
+
+func testStream(t *testing.T, r Cache) {
 	ctx := context.TODO()
-	r := NewCache(config.GetTestCacheConfig())
 
 	t.Run("add_read", func(t *testing.T) {
 		stream, err := r.CreateOrGetStream(context.TODO(), "test")
@@ -70,6 +77,81 @@ func TestStream(t *testing.T) {
 		require.Equal(t, "first", groups[1].Name)
 		require.Equal(t, "second", groups[2].Name)
 	})
+	t.Run("range_trim", func(t *testing.T) {
+		stream, err := r.CreateOrGetStream(context.TODO(), "test")
+		require.NoError(t, err)
+		defer func() {
+			_ = stream.Delete(ctx)
+		}()
+
+		var ids []string
+		for i := 0; i < 5; i++ {
+			id, err := stream.Add(ctx, internal.NewStreamData(internal.JsonEncoding, nil, []byte(fmt.Sprintf("%d", i))))
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+
+		messageIds := func(messages *StreamMessages) []string {
+			var out []string
+			for _, m := range messages.Messages {
+				out = append(out, m.ID)
+			}
+			return out
+		}
+
+		messages, err := stream.Range(ctx, "-", "+", 0)
+		require.NoError(t, err)
+		require.Equal(t, ids, messageIds(messages))
+
+		messages, err = stream.Range(ctx, ids[1], ids[3], 2)
+		require.NoError(t, err)
+		require.Equal(t, ids[1:3], messageIds(messages))
+
+		next, err := NextStreamID(ids[1])
+		require.NoError(t, err)
+		messages, err = stream.Range(ctx, next, "+", 0)
+		require.NoError(t, err)
+		require.Equal(t, ids[2:], messageIds(messages))
+
+		messages, err = stream.RevRange(ctx, "+", "-", 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{ids[4], ids[3]}, messageIds(messages))
+
+		trimmed, err := stream.Trim(ctx, &TrimOptions{MaxLen: 3})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), trimmed)
+
+		trimmed, err = stream.Trim(ctx, &TrimOptions{MinID: ids[3]})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), trimmed)
+
+		messages, err = stream.Range(ctx, "-", "+", 0)
+		require.NoError(t, err)
+		require.Equal(t, ids[3:], messageIds(messages))
+	})
+}
+
+func TestStreamID(t *testing.T) {
+	next, err := NextStreamID("10-1")
+	require.NoError(t, err)
+	require.Equal(t, "10-2", next)
+
+	prev, err := PrevStreamID("10-1")
+	require.NoError(t, err)
+	require.Equal(t, "10-0", prev)
+
+	prev, err = PrevStreamID("10-0")
+	require.NoError(t, err)
+	require.Equal(t, "9-18446744073709551615", prev)
+
+	prev, err = PrevStreamID("0-0")
+	require.NoError(t, err)
+	require.Equal(t, "0-0", prev)
+
+	require.Equal(t, "1000-0", TimeStreamID(time.UnixMilli(1000)))
+
+	_, err = NextStreamID("invalid")
+	require.Equal(t, ErrInvalidStreamID, err)
 }
 
 func TestBenchmarkingStreams(t *testing.T) {
@@ -0,0 +1,280 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+
+	xredis "github.com/go-redis/redis/v8"
+	"github.com/tigrisdata/tigris/internal"
+	ulog "github.com/tigrisdata/tigris/util/log"
+)
+
+// The hashes, lists and sorted sets are stored as the Redis structures under the cache key, so they share the
+// keyspace with the values and are removed by Delete like any other key. The elements are encoded with the type of
+// the structure.
+
+func (c *cache) HSet(ctx context.Context, tableName string, key string, fields map[string][]byte) (int64, error) {
+	if len(fields) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	values := make([]any, 0, 2*len(fields))
+	for f, v := range fields {
+		values = append(values, f, internal.EncodeCacheElement(internal.CacheHashDataType, v))
+	}
+
+	if err := c.admit(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	added, err := c.Client.HSet(ctx, encodeToCacheKey(tableName, key), values...).Result()
+	if err != nil {
+		return 0, toCacheError(err)
+	}
+	if err = c.track(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	return added, nil
+}
+
+func (c *cache) HGet(ctx context.Context, tableName string, key string, field string) ([]byte, error) {
+	value, err := c.Client.HGet(ctx, encodeToCacheKey(tableName, key), field).Bytes()
+	if err == xredis.Nil {
+		c.touchKey(ctx, tableName, key, false)
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, toCacheError(err)
+	}
+	c.touchKey(ctx, tableName, key, true)
+
+	return internal.DecodeCacheElement(internal.CacheHashDataType, value)
+}
+
+func (c *cache) HGetAll(ctx context.Context, tableName string, key string) (map[string][]byte, error) {
+	values, err := c.Client.HGetAll(ctx, encodeToCacheKey(tableName, key)).Result()
+	if err != nil {
+		return nil, toCacheError(err)
+	}
+	c.touchKey(ctx, tableName, key, len(values) > 0)
+
+	fields := make(map[string][]byte, len(values))
+	for f, v := range values {
+		if fields[f], err = internal.DecodeCacheElement(internal.CacheHashDataType, []byte(v)); err != nil {
+			return nil, err
+		}
+	}
+
+	return fields, nil
+}
+
+func (c *cache) HDel(ctx context.Context, tableName string, key string, fields ...string) (int64, error) {
+	if len(fields) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	deleted, err := c.Client.HDel(ctx, encodeToCacheKey(tableName, key), fields...).Result()
+	if err != nil {
+		return 0, toCacheError(err)
+	}
+	if err = c.track(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+func (c *cache) LPush(ctx context.Context, tableName string, key string, values ...[]byte) (int64, error) {
+	if len(values) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	if err := c.admit(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	length, err := c.Client.LPush(ctx, encodeToCacheKey(tableName, key), encodeListElements(values)...).Result()
+	if err != nil {
+		return 0, toCacheError(err)
+	}
+	if err = c.track(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	return length, nil
+}
+
+func (c *cache) RPush(ctx context.Context, tableName string, key string, values ...[]byte) (int64, error) {
+	if len(values) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	if err := c.admit(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	length, err := c.Client.RPush(ctx, encodeToCacheKey(tableName, key), encodeListElements(values)...).Result()
+	if err != nil {
+		return 0, toCacheError(err)
+	}
+	if err = c.track(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	return length, nil
+}
+
+func (c *cache) LPop(ctx context.Context, tableName string, key string) ([]byte, error) {
+	value, err := c.Client.LPop(ctx, encodeToCacheKey(tableName, key)).Bytes()
+	if err == nil {
+		// the value is already popped, so a failure is only logged, the key is accounted again by its next write
+		_ = ulog.E(c.track(ctx, tableName, key))
+	}
+
+	return decodePopped(value, err)
+}
+
+func (c *cache) RPop(ctx context.Context, tableName string, key string) ([]byte, error) {
+	value, err := c.Client.RPop(ctx, encodeToCacheKey(tableName, key)).Bytes()
+	if err == nil {
+		// the value is already popped, so a failure is only logged, the key is accounted again by its next write
+		_ = ulog.E(c.track(ctx, tableName, key))
+	}
+
+	return decodePopped(value, err)
+}
+
+func (c *cache) LRange(ctx context.Context, tableName string, key string, start int64, stop int64) ([][]byte, error) {
+	values, err := c.Client.LRange(ctx, encodeToCacheKey(tableName, key), start, stop).Result()
+	if err != nil {
+		return nil, toCacheError(err)
+	}
+	c.touchKey(ctx, tableName, key, len(values) > 0)
+
+	elements := make([][]byte, len(values))
+	for i, v := range values {
+		if elements[i], err = internal.DecodeCacheElement(internal.CacheListDataType, []byte(v)); err != nil {
+			return nil, err
+		}
+	}
+
+	return elements, nil
+}
+
+func (c *cache) ZAdd(ctx context.Context, tableName string, key string, members ...ScoredMember) (int64, error) {
+	if len(members) == 0 {
+		return 0, ErrEmptyKey
+	}
+
+	zMembers := make([]*xredis.Z, len(members))
+	for i, m := range members {
+		zMembers[i] = &xredis.Z{
+			Score:  m.Score,
+			Member: internal.EncodeCacheElement(internal.CacheSortedSetDataType, m.Member),
+		}
+	}
+
+	if err := c.admit(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	added, err := c.Client.ZAdd(ctx, encodeToCacheKey(tableName, key), zMembers...).Result()
+	if err != nil {
+		return 0, toCacheError(err)
+	}
+	if err = c.track(ctx, tableName, key); err != nil {
+		return 0, err
+	}
+
+	return added, nil
+}
+
+func (c *cache) ZRange(ctx context.Context, tableName string, key string, query *RangeQuery) ([]ScoredMember, error) {
+	cacheKey := encodeToCacheKey(tableName, key)
+
+	var cmd *xredis.ZSliceCmd
+	switch {
+	case query.ByScore && query.Reverse:
+		cmd = c.Client.ZRevRangeByScoreWithScores(ctx, cacheKey, &xredis.ZRangeBy{Min: formatScore(query.Min), Max: formatScore(query.Max)})
+	case query.ByScore:
+		cmd = c.Client.ZRangeByScoreWithScores(ctx, cacheKey, &xredis.ZRangeBy{Min: formatScore(query.Min), Max: formatScore(query.Max)})
+	case query.Reverse:
+		cmd = c.Client.ZRevRangeWithScores(ctx, cacheKey, query.Start, query.Stop)
+	default:
+		cmd = c.Client.ZRangeWithScores(ctx, cacheKey, query.Start, query.Stop)
+	}
+
+	zMembers, err := cmd.Result()
+	if err != nil {
+		return nil, toCacheError(err)
+	}
+	c.touchKey(ctx, tableName, key, len(zMembers) > 0)
+
+	members := make([]ScoredMember, len(zMembers))
+	for i, z := range zMembers {
+		enc, _ := z.Member.(string)
+		if members[i].Member, err = internal.DecodeCacheElement(internal.CacheSortedSetDataType, []byte(enc)); err != nil {
+			return nil, err
+		}
+		members[i].Score = z.Score
+	}
+
+	return members, nil
+}
+
+func encodeListElements(values [][]byte) []any {
+	elements := make([]any, len(values))
+	for i, v := range values {
+		elements[i] = internal.EncodeCacheElement(internal.CacheListDataType, v)
+	}
+
+	return elements
+}
+
+func decodePopped(value []byte, err error) ([]byte, error) {
+	if err == xredis.Nil {
+		return nil, ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, toCacheError(err)
+	}
+
+	return internal.DecodeCacheElement(internal.CacheListDataType, value)
+}
+
+func formatScore(score float64) string {
+	switch {
+	case math.IsInf(score, 1):
+		return "+inf"
+	case math.IsInf(score, -1):
+		return "-inf"
+	}
+
+	return strconv.FormatFloat(score, 'g', -1, 64)
+}
+
+// toCacheError translates the error of an operation against a key of another type.
+func toCacheError(err error) error {
+	if err != nil && strings.HasPrefix(err.Error(), errStrWrongType) {
+		return ErrWrongType
+	}
+
+	return err
+}
@@ -52,6 +52,7 @@ type baseTx interface {
 	Commit(context.Context) error
 	Rollback(context.Context) error
 	IsRetriable() bool
+	GetReadVersion(ctx context.Context) (int64, error)
 }
 
 type baseKVStore interface {
@@ -257,6 +257,10 @@ func (d *fdbkv) BeginTx(ctx context.Context) (baseTx, error) {
 		return nil, err
 	}
 
+	if v := getCtxReadVersion(ctx); v > 0 {
+		tx.SetReadVersion(v)
+	}
+
 	log.Trace().Msg("create transaction")
 
 	return &ftx{d: d, tx: &tx}, nil
@@ -450,6 +454,16 @@ func (t *ftx) Get(_ context.Context, key []byte, isSnapshot bool) Future {
 	return t.tx.Get(fdb.Key(key))
 }
 
+// GetReadVersion returns the version at which the reads of this transaction are performed.
+func (t *ftx) GetReadVersion(_ context.Context) (int64, error) {
+	v, err := t.tx.GetReadVersion().Get()
+	if err != nil {
+		return 0, convertFDBToStoreErr(err)
+	}
+
+	return v, nil
+}
+
 // RangeSize calculates approximate range table size in bytes - this is an estimate
 // and a range smaller than 3mb will not be that accurate.
 func (t *ftx) RangeSize(_ context.Context, table []byte, lKey Key, rKey Key) (int64, error) {
@@ -596,6 +610,24 @@ func getCtxTimeout(ctx context.Context) int64 {
 	return time.Until(tm).Milliseconds()
 }
 
+type ctxReadVersion struct{}
+
+// CtxWithReadVersion returns the context, which makes transactions started with it to read at the given version
+// instead of the latest one. The version needs to be within the MVCC window of the FoundationDB, otherwise the reads
+// fail with ErrTransactionMaxDurationReached.
+func CtxWithReadVersion(ctx context.Context, version int64) context.Context {
+	return context.WithValue(ctx, ctxReadVersion{}, version)
+}
+
+// getCtxReadVersion returns read version if it's set in the context
+// returns 0 if read version is not set.
+func getCtxReadVersion(ctx context.Context) int64 {
+	if v, ok := ctx.Value(ctxReadVersion{}).(int64); ok {
+		return v
+	}
+	return 0
+}
+
 // setTxTimeout sets transaction timeout
 // Zero input sets unlimited timeout.
 func setTxTimeout(tx *fdb.Transaction, ms int64) error {
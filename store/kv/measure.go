@@ -235,6 +235,14 @@ func (m *TxImplWithMetrics) IsRetriable() bool {
 	return m.tx.IsRetriable()
 }
 
+func (m *TxImplWithMetrics) GetReadVersion(ctx context.Context) (v int64, err error) {
+	m.measure(ctx, "GetReadVersion", func() error {
+		v, err = m.tx.GetReadVersion(ctx)
+		return err
+	})
+	return
+}
+
 func (m *TxImplWithMetrics) Insert(ctx context.Context, table []byte, key Key, data *internal.TableData) (err error) {
 	m.measure(ctx, "Insert", func() error {
 		err = m.tx.Insert(ctx, table, key, data)
@@ -0,0 +1,705 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/rs/zerolog/log"
+	"github.com/tigrisdata/tigris/query/filter"
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	"github.com/tigrisdata/tigris/server/config"
+	"github.com/tigrisdata/tigris/util"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
+)
+
+const (
+	embeddedSchemaFile   = "schema.json"
+	embeddedLogFile      = "docs.log"
+	embeddedSynonymsFile = "synonyms.json"
+
+	embeddedOpPut    = "put"
+	embeddedOpDelete = "delete"
+
+	// minimum number of the log entries before the log is compacted.
+	minCompactionEntries = 1024
+)
+
+type embeddedLogEntry struct {
+	Op  string         `json:"op"`
+	Id  string         `json:"id"`
+	Doc map[string]any `json:"doc,omitempty"`
+}
+
+// EmbeddedStore is the search.Store that serves the search indexes from the Tigris process itself, so a single
+// server doesn't need the external search service. Every index lives in its own directory under the data directory
+// with the schema and an append-only log of the indexed documents, the in-memory inverted index is rebuilt from the
+// log when the store is opened. The writes are synced to the log before they are applied to the in-memory index, so
+// an acknowledged write is never lost by a restart.
+type EmbeddedStore struct {
+	sync.RWMutex
+
+	dir             string
+	compactionRatio int
+	indexes         map[string]*embeddedCollection
+}
+
+type embeddedCollection struct {
+	sync.RWMutex
+
+	*embeddedIndex
+
+	dir        string
+	log        *os.File
+	logEntries int
+}
+
+func NewEmbeddedStore(cfg *config.EmbeddedSearchConfig) (*EmbeddedStore, error) {
+	if err := os.MkdirAll(cfg.DataDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	s := &EmbeddedStore{
+		dir:             cfg.DataDir,
+		compactionRatio: cfg.CompactionRatio,
+		indexes:         make(map[string]*embeddedCollection),
+	}
+
+	entries, err := os.ReadDir(cfg.DataDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		coll, err := openEmbeddedCollection(filepath.Join(cfg.DataDir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if coll != nil {
+			s.indexes[coll.schema.Name] = coll
+		}
+	}
+
+	log.Info().Str("dir", cfg.DataDir).Int("indexes", len(s.indexes)).Msg("initialized embedded search store")
+	return s, nil
+}
+
+func openEmbeddedCollection(dir string) (*embeddedCollection, error) {
+	data, err := os.ReadFile(filepath.Join(dir, embeddedSchemaFile))
+	if os.IsNotExist(err) {
+		// the index was dropped before its directory was removed
+		return nil, os.RemoveAll(dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var schema tsApi.CollectionResponse
+	if err = jsoniter.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+
+	coll := &embeddedCollection{
+		embeddedIndex: newEmbeddedIndex(schema),
+		dir:           dir,
+	}
+	if err = coll.loadSynonyms(); err != nil {
+		return nil, err
+	}
+	if err = coll.replay(); err != nil {
+		return nil, err
+	}
+	if coll.log, err = os.OpenFile(filepath.Join(dir, embeddedLogFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644); err != nil {
+		return nil, err
+	}
+
+	return coll, nil
+}
+
+func (c *embeddedCollection) replay() error {
+	f, err := os.Open(filepath.Join(c.dir, embeddedLogFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			if len(line) > 0 {
+				// the last write didn't complete, the missing document is restored by the consistency check
+				log.Warn().Str("index", c.schema.Name).Msg("ignoring incomplete entry at the end of the search log")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var entry embeddedLogEntry
+		decoder := jsoniter.NewDecoder(bytes.NewReader(line))
+		decoder.UseNumber()
+		if err = decoder.Decode(&entry); err != nil {
+			return err
+		}
+
+		c.apply(entry)
+		c.logEntries++
+	}
+}
+
+func (c *embeddedCollection) apply(entry embeddedLogEntry) {
+	switch entry.Op {
+	case embeddedOpPut:
+		c.put(entry.Id, entry.Doc)
+	case embeddedOpDelete:
+		c.remove(entry.Id)
+	}
+}
+
+// append writes the entries to the log and syncs it, then applies the entries to the index. The index is not changed
+// if the entries can't be persisted. The log is compacted once it has grown past the ratio of the live documents.
+func (c *embeddedCollection) append(compactionRatio int, entries ...embeddedLogEntry) error {
+	var buf bytes.Buffer
+	for _, e := range entries {
+		data, err := jsoniter.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	if _, err := c.log.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	if err := c.log.Sync(); err != nil {
+		return err
+	}
+	c.logEntries += len(entries)
+
+	for _, e := range entries {
+		c.apply(e)
+	}
+
+	if compactionRatio > 0 && c.logEntries > minCompactionEntries && c.logEntries > compactionRatio*len(c.docs) {
+		return c.compact()
+	}
+	return nil
+}
+
+// compact rewrites the log with only the live documents in their indexing order.
+func (c *embeddedCollection) compact() error {
+	tmp := filepath.Join(c.dir, embeddedLogFile+".tmp")
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(f)
+	for _, id := range c.orderedIds() {
+		data, err := jsoniter.Marshal(embeddedLogEntry{Op: embeddedOpPut, Id: id, Doc: c.docs[id].fields})
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+		_, _ = writer.Write(data)
+		_ = writer.WriteByte('\n')
+	}
+	if err = writer.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	_ = c.log.Close()
+	if err = os.Rename(tmp, filepath.Join(c.dir, embeddedLogFile)); err != nil {
+		return err
+	}
+	if c.log, err = os.OpenFile(filepath.Join(c.dir, embeddedLogFile), os.O_WRONLY|os.O_APPEND, 0o644); err != nil {
+		return err
+	}
+	c.logEntries = len(c.docs)
+
+	return nil
+}
+
+func (c *embeddedCollection) saveSchema() error {
+	data, err := jsoniter.Marshal(c.schema)
+	if err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(c.dir, embeddedSchemaFile+".tmp")
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(c.dir, embeddedSchemaFile))
+}
+
+func (c *embeddedCollection) loadSynonyms() error {
+	data, err := os.ReadFile(filepath.Join(c.dir, embeddedSynonymsFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return jsoniter.Unmarshal(data, &c.synonyms)
+}
+
+// saveSynonyms persists the synonyms and replaces the synonyms of the index with them.
+func (c *embeddedCollection) saveSynonyms(synonyms map[string]tsApi.SearchSynonymSchema) error {
+	data, err := jsoniter.Marshal(synonyms)
+	if err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(c.dir, embeddedSynonymsFile+".tmp")
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, filepath.Join(c.dir, embeddedSynonymsFile)); err != nil {
+		return err
+	}
+
+	c.synonyms = synonyms
+	return nil
+}
+
+// copySynonyms returns the copy of the synonyms to change them before they are persisted.
+func (c *embeddedCollection) copySynonyms() map[string]tsApi.SearchSynonymSchema {
+	synonyms := make(map[string]tsApi.SearchSynonymSchema, len(c.synonyms)+1)
+	for id, s := range c.synonyms {
+		synonyms[id] = s
+	}
+	return synonyms
+}
+
+func (s *EmbeddedStore) index(name string) (*embeddedCollection, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	coll, ok := s.indexes[name]
+	if !ok {
+		return nil, errIndexNotFound(name)
+	}
+	return coll, nil
+}
+
+func (s *EmbeddedStore) AllCollections(_ context.Context) (map[string]*tsApi.CollectionResponse, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	resp := make(map[string]*tsApi.CollectionResponse, len(s.indexes))
+	for name, coll := range s.indexes {
+		coll.RLock()
+		resp[name] = coll.describe()
+		coll.RUnlock()
+	}
+	return resp, nil
+}
+
+func (s *EmbeddedStore) DescribeCollection(_ context.Context, name string) (*tsApi.CollectionResponse, error) {
+	coll, err := s.index(name)
+	if err != nil {
+		return nil, err
+	}
+
+	coll.RLock()
+	defer coll.RUnlock()
+
+	return coll.describe(), nil
+}
+
+func (s *EmbeddedStore) CreateCollection(_ context.Context, schema *tsApi.CollectionSchema) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.indexes[schema.Name]; ok {
+		return NewSearchError(http.StatusConflict, ErrCodeDuplicate, "A collection with name `%s` already exists.", schema.Name)
+	}
+
+	seen := make(map[string]struct{}, len(schema.Fields))
+	for _, f := range schema.Fields {
+		if _, ok := seen[f.Name]; ok {
+			return NewSearchError(http.StatusBadRequest, ErrCodeInvalid, errDuplicateFields)
+		}
+		seen[f.Name] = struct{}{}
+	}
+
+	dir := filepath.Join(s.dir, url.PathEscape(schema.Name))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	createdAt := time.Now().Unix()
+	coll := &embeddedCollection{
+		embeddedIndex: newEmbeddedIndex(tsApi.CollectionResponse{
+			CreatedAt:           &createdAt,
+			DefaultSortingField: schema.DefaultSortingField,
+			EnableNestedFields:  schema.EnableNestedFields,
+			Fields:              append([]tsApi.Field{}, schema.Fields...),
+			Name:                schema.Name,
+			SymbolsToIndex:      schema.SymbolsToIndex,
+			TokenSeparators:     schema.TokenSeparators,
+		}),
+		dir: dir,
+	}
+	// a leftover log or synonyms of an index with the same name must not be loaded
+	for _, file := range []string{embeddedLogFile, embeddedSynonymsFile} {
+		if err := os.Remove(filepath.Join(dir, file)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := coll.saveSchema(); err != nil {
+		return err
+	}
+
+	var err error
+	if coll.log, err = os.OpenFile(filepath.Join(dir, embeddedLogFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644); err != nil {
+		return err
+	}
+
+	s.indexes[schema.Name] = coll
+	return nil
+}
+
+func (s *EmbeddedStore) UpdateCollection(_ context.Context, name string, schema *tsApi.CollectionUpdateSchema) error {
+	coll, err := s.index(name)
+	if err != nil {
+		return err
+	}
+
+	coll.Lock()
+	defer coll.Unlock()
+
+	coll.update(schema.Fields)
+	return coll.saveSchema()
+}
+
+func (s *EmbeddedStore) DropCollection(_ context.Context, table string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	coll, ok := s.indexes[table]
+	if !ok {
+		return errIndexNotFound(table)
+	}
+
+	coll.Lock()
+	defer coll.Unlock()
+
+	_ = coll.log.Close()
+	delete(s.indexes, table)
+
+	// removing the schema first makes a partially removed directory look dropped on the next start
+	if err := os.Remove(filepath.Join(coll.dir, embeddedSchemaFile)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.RemoveAll(coll.dir)
+}
+
+func (s *EmbeddedStore) CreateDocument(ctx context.Context, table string, doc map[string]any) error {
+	data, err := util.MapToJSON(doc)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.IndexDocuments(ctx, table, bytes.NewReader(data), IndexDocumentsOptions{Action: Create})
+	if err != nil {
+		return err
+	}
+	if len(resp) == 1 && !resp[0].Success {
+		return NewSearchError(resp[0].Code, ErrCodeIndexingDocuments, resp[0].Error)
+	}
+	return nil
+}
+
+func (s *EmbeddedStore) IndexDocuments(_ context.Context, table string, reader io.Reader, options IndexDocumentsOptions) ([]IndexResp, error) {
+	coll, err := s.index(table)
+	if err != nil {
+		return nil, err
+	}
+
+	coll.Lock()
+	defer coll.Unlock()
+
+	var (
+		responses []IndexResp
+		entries   []embeddedLogEntry
+		buffered  = bufio.NewReader(reader)
+		// the documents of the batch are applied to the index only after they are logged
+		pending = make(map[string]map[string]any)
+	)
+	for {
+		line, err := buffered.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			resp, entry := coll.indexDocument(line, options.Action, pending)
+			responses = append(responses, resp)
+			if entry != nil {
+				entries = append(entries, *entry)
+				pending[entry.Id] = entry.Doc
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+
+	if len(entries) > 0 {
+		if err = coll.append(s.compactionRatio, entries...); err != nil {
+			return nil, err
+		}
+	}
+
+	return responses, nil
+}
+
+// indexDocument validates a single document of the batch and returns the log entry to persist it. The pending
+// documents are the earlier documents of the batch which are not applied to the index yet.
+func (c *embeddedCollection) indexDocument(line []byte, action IndexAction, pending map[string]map[string]any) (IndexResp, *embeddedLogEntry) {
+	doc, err := util.JSONToMap(line)
+	if err != nil {
+		return IndexResp{Code: http.StatusBadRequest, Document: string(line), Error: "Bad JSON."}, nil
+	}
+
+	id, ok := doc["id"].(string)
+	if !ok || len(id) == 0 {
+		return IndexResp{Code: http.StatusBadRequest, Document: string(line), Error: "Document's `id` field should be a string."}, nil
+	}
+
+	existing, found := pending[id]
+	if !found {
+		existing, found = c.get(id)
+	}
+	switch action {
+	case Create:
+		if found {
+			return IndexResp{
+				Code:     http.StatusConflict,
+				Document: string(line),
+				Error:    fmt.Sprintf("A document with id %s already exists.", id),
+			}, nil
+		}
+	case Update:
+		if !found {
+			return IndexResp{
+				Code:     http.StatusNotFound,
+				Document: string(line),
+				Error:    fmt.Sprintf("Could not find a document with id: %s", id),
+			}, nil
+		}
+		merged := make(map[string]any, len(existing)+len(doc))
+		for k, v := range existing {
+			merged[k] = v
+		}
+		for k, v := range doc {
+			merged[k] = v
+		}
+		doc = merged
+	}
+
+	return IndexResp{Success: true}, &embeddedLogEntry{Op: embeddedOpPut, Id: id, Doc: doc}
+}
+
+func (s *EmbeddedStore) DeleteDocument(_ context.Context, table string, key string) error {
+	coll, err := s.index(table)
+	if err != nil {
+		return err
+	}
+
+	coll.Lock()
+	defer coll.Unlock()
+
+	if _, found := coll.get(key); !found {
+		return NewSearchError(http.StatusNotFound, ErrCodeNotFound, "Could not find a document with id: %s", key)
+	}
+	return coll.append(s.compactionRatio, embeddedLogEntry{Op: embeddedOpDelete, Id: key})
+}
+
+func (s *EmbeddedStore) DeleteDocuments(_ context.Context, table string, filter *filter.WrappedFilter) (int, error) {
+	coll, err := s.index(table)
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := parseFilter(filter.SearchFilter())
+	if err != nil {
+		return 0, err
+	}
+	if f == nil {
+		return 0, NewSearchError(http.StatusBadRequest, ErrCodeInvalid, "Parameter `filter_by` must be provided.")
+	}
+
+	coll.Lock()
+	defer coll.Unlock()
+
+	var entries []embeddedLogEntry
+	for _, id := range coll.orderedIds() {
+		if f.matches(coll.docs[id].fields) {
+			entries = append(entries, embeddedLogEntry{Op: embeddedOpDelete, Id: id})
+		}
+	}
+	if len(entries) > 0 {
+		if err = coll.append(s.compactionRatio, entries...); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(entries), nil
+}
+
+func (s *EmbeddedStore) Search(_ context.Context, table string, query *qsearch.Query, pageNo int) ([]tsApi.SearchResult, error) {
+	coll, err := s.index(table)
+	if err != nil {
+		return nil, err
+	}
+
+	coll.RLock()
+	defer coll.RUnlock()
+
+	if query.IsQAndVectorBoth() {
+		if err := checkCandidatesPage(query, pageNo); err != nil {
+			return nil, err
+		}
+		window := candidatesWindow(query)
+		text, err := coll.search(candidatesQuery(query.TextQuery(), window), 1)
+		if err != nil {
+			return nil, err
+		}
+		vector, err := coll.search(candidatesQuery(query.VectorQuery(), window), 1)
+		if err != nil {
+			return nil, err
+		}
+		return []tsApi.SearchResult{*fuseHybrid(text, vector, query, pageNo)}, nil
+	}
+
+	result, err := coll.search(query, pageNo)
+	if err != nil {
+		return nil, err
+	}
+	return []tsApi.SearchResult{*result}, nil
+}
+
+func (s *EmbeddedStore) MultiSearch(ctx context.Context, queries []IndexQuery) ([]tsApi.SearchResult, error) {
+	results := make([]tsApi.SearchResult, 0, len(queries))
+	for _, q := range queries {
+		result, err := s.Search(ctx, q.Table, q.Query, q.PageNo)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result...)
+	}
+	return results, nil
+}
+
+func (s *EmbeddedStore) GetDocuments(_ context.Context, table string, ids []string) (*tsApi.SearchResult, error) {
+	coll, err := s.index(table)
+	if err != nil {
+		return nil, err
+	}
+
+	coll.RLock()
+	defer coll.RUnlock()
+
+	hits := make([]tsApi.SearchResultHit, 0, len(ids))
+	for _, id := range ids {
+		if doc, ok := coll.docs[id]; ok {
+			hits = append(hits, (&embeddedHit{id: id, doc: doc}).toResultHit(nil))
+		}
+	}
+	found := len(hits)
+
+	return &tsApi.SearchResult{
+		Found: &found,
+		Hits:  &hits,
+	}, nil
+}
+
+// Close closes the logs of all the indexes.
+func (s *EmbeddedStore) Close() error {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, coll := range s.indexes {
+		coll.Lock()
+		_ = coll.log.Close()
+		coll.Unlock()
+	}
+	return nil
+}
+
+func (s *EmbeddedStore) UpsertSynonym(_ context.Context, table string, id string, synonym *tsApi.SearchSynonymSchema) error {
+	if len(synonym.Synonyms) == 0 {
+		return NewSearchError(http.StatusBadRequest, ErrCodeInvalid, "Could not find a valid `synonyms` array in the request body.")
+	}
+
+	coll, err := s.index(table)
+	if err != nil {
+		return err
+	}
+
+	coll.Lock()
+	defer coll.Unlock()
+
+	synonyms := coll.copySynonyms()
+	synonyms[id] = *synonym
+	return coll.saveSynonyms(synonyms)
+}
+
+func (s *EmbeddedStore) DeleteSynonym(_ context.Context, table string, id string) error {
+	coll, err := s.index(table)
+	if err != nil {
+		return err
+	}
+
+	coll.Lock()
+	defer coll.Unlock()
+
+	if _, ok := coll.synonyms[id]; !ok {
+		return NewSearchError(http.StatusNotFound, ErrCodeNotFound, "Could not find that `id`.")
+	}
+	synonyms := coll.copySynonyms()
+	delete(synonyms, id)
+	return coll.saveSynonyms(synonyms)
+}
@@ -0,0 +1,548 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tigrisdata/tigris/lib/geo"
+)
+
+// embeddedFilter is a parsed "filter_by" expression. The embedded store accepts the same filter syntax that Tigris
+// builds for the search service through ToSearchFilter, i.e. "f:=v", "f:>v", "f:[v1,v2]" and the geo "f:(lat, lng, r km)"
+// clauses combined by "&&", "||" and parentheses, so both the stores are driven by the same query.
+type embeddedFilter interface {
+	matches(doc map[string]any) bool
+}
+
+type andFilter []embeddedFilter
+
+func (a andFilter) matches(doc map[string]any) bool {
+	for _, f := range a {
+		if !f.matches(doc) {
+			return false
+		}
+	}
+	return true
+}
+
+type orFilter []embeddedFilter
+
+func (o orFilter) matches(doc map[string]any) bool {
+	for _, f := range o {
+		if f.matches(doc) {
+			return true
+		}
+	}
+	return false
+}
+
+const metersPerMile = 1609.344
+
+const (
+	opEq       = "="
+	opNe       = "!="
+	opGt       = ">"
+	opGte      = ">="
+	opLt       = "<"
+	opLte      = "<="
+	opContains = ""
+)
+
+type clauseFilter struct {
+	field  string
+	op     string
+	values []string
+}
+
+func (c *clauseFilter) matches(doc map[string]any) bool {
+	docValues := fieldValues(doc, c.field)
+	if c.op == opNe {
+		for _, dv := range docValues {
+			for _, v := range c.values {
+				if cmp, ok := compareLiteral(dv, v); ok && cmp == 0 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	for _, dv := range docValues {
+		for _, v := range c.values {
+			if c.matchValue(dv, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *clauseFilter) matchValue(docValue any, literal string) bool {
+	if c.op == opContains {
+		if s, ok := docValue.(string); ok {
+			return containsTokens(tokenize(s), tokenize(literal))
+		}
+	}
+
+	cmp, ok := compareLiteral(docValue, literal)
+	if !ok {
+		return false
+	}
+
+	switch c.op {
+	case opEq, opContains:
+		return cmp == 0
+	case opGt:
+		return cmp > 0
+	case opGte:
+		return cmp >= 0
+	case opLt:
+		return cmp < 0
+	case opLte:
+		return cmp <= 0
+	}
+	return false
+}
+
+// geoFilter matches the geopoint field within the radius in meters around the center, or inside the polygon when the
+// polygon is set.
+type geoFilter struct {
+	field   string
+	center  geo.Point
+	radius  float64
+	polygon []geo.Point
+}
+
+// parseGeoFilter parses the coordinates inside the parentheses, "lat, lng, 5 km" is a radius filter and the list of
+// the vertices "lat1, lng1, lat2, lng2, lat3, lng3, ..." is a polygon filter. The zero radius only matches the center.
+func parseGeoFilter(field string, input string) (*geoFilter, error) {
+	parts := strings.Split(input, ",")
+	f := &geoFilter{field: field}
+
+	if len(parts) == 3 {
+		radius := strings.Fields(parts[2])
+		if len(radius) != 2 {
+			return nil, fmt.Errorf("invalid radius '%s'", parts[2])
+		}
+		r, err := strconv.ParseFloat(radius[0], 64)
+		if err != nil || r < 0 || math.IsNaN(r) || math.IsInf(r, 0) {
+			return nil, fmt.Errorf("invalid radius '%s'", parts[2])
+		}
+		switch radius[1] {
+		case "km":
+			f.radius = r * 1000
+		case "mi":
+			f.radius = r * metersPerMile
+		default:
+			return nil, fmt.Errorf("unsupported radius unit '%s'", radius[1])
+		}
+		parts = parts[:2]
+	} else if len(parts) < 6 || len(parts)%2 != 0 {
+		return nil, fmt.Errorf("invalid geo filter '(%s)'", input)
+	}
+
+	points := make([]geo.Point, 0, len(parts)/2)
+	for i := 0; i < len(parts); i += 2 {
+		lat, err1 := strconv.ParseFloat(strings.TrimSpace(parts[i]), 64)
+		lng, err2 := strconv.ParseFloat(strings.TrimSpace(parts[i+1]), 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("invalid coordinates '%s, %s'", parts[i], parts[i+1])
+		}
+		p, err := geo.NewPoint(lat, lng)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+
+	if len(points) == 1 {
+		f.center = points[0]
+	} else {
+		f.polygon = points
+	}
+	return f, nil
+}
+
+func (g *geoFilter) matches(doc map[string]any) bool {
+	p, ok := geoPointOf(doc, g.field)
+	if !ok {
+		return false
+	}
+
+	if len(g.polygon) == 0 {
+		return g.center.DistanceTo(p) <= g.radius
+	}
+	return polygonContains(g.polygon, p)
+}
+
+// geoPointOf returns the geopoint stored in the document field.
+func geoPointOf(doc map[string]any, field string) (geo.Point, bool) {
+	v, ok := doc[field]
+	if !ok {
+		return geo.Point{}, false
+	}
+	p, err := geo.ParsePoint(v)
+	return p, err == nil
+}
+
+// polygonContains returns true if the point is inside the polygon or on its edges, the longitude is used as "x" and
+// the latitude as "y" of the plane.
+func polygonContains(polygon []geo.Point, p geo.Point) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if onSegment(a, b, p) {
+			return true
+		}
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func onSegment(a geo.Point, b geo.Point, p geo.Point) bool {
+	cross := (b.Lng-a.Lng)*(p.Lat-a.Lat) - (b.Lat-a.Lat)*(p.Lng-a.Lng)
+	if math.Abs(cross) > 1e-12 {
+		return false
+	}
+	return p.Lng >= math.Min(a.Lng, b.Lng) && p.Lng <= math.Max(a.Lng, b.Lng) &&
+		p.Lat >= math.Min(a.Lat, b.Lat) && p.Lat <= math.Max(a.Lat, b.Lat)
+}
+
+// parseFilter parses the filter_by string, an empty string returns a nil filter that matches everything.
+func parseFilter(input string) (embeddedFilter, error) {
+	p := &filterParser{input: input}
+	p.skipSpaces()
+	if p.eof() {
+		return nil, nil
+	}
+
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if !p.eof() {
+		return nil, p.errorf("unexpected '%s'", p.input[p.pos:])
+	}
+	return f, nil
+}
+
+type filterParser struct {
+	input string
+	pos   int
+	depth int
+}
+
+func (p *filterParser) errorf(format string, args ...any) error {
+	return NewSearchError(http.StatusBadRequest, ErrCodeInvalid, "Could not parse the filter query: "+format, args...)
+}
+
+func (p *filterParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *filterParser) skipSpaces() {
+	for !p.eof() && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *filterParser) consume(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) parseOr() (embeddedFilter, error) {
+	var or orFilter
+	for {
+		f, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		or = append(or, f)
+		if !p.consume("||") {
+			break
+		}
+	}
+
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+func (p *filterParser) parseAnd() (embeddedFilter, error) {
+	var and andFilter
+	for {
+		f, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, f)
+		if !p.consume("&&") {
+			break
+		}
+	}
+
+	if len(and) == 1 {
+		return and[0], nil
+	}
+	return and, nil
+}
+
+func (p *filterParser) parseFactor() (embeddedFilter, error) {
+	if p.consume("(") {
+		p.depth++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(")") {
+			return nil, p.errorf("missing ')'")
+		}
+		p.depth--
+		return f, nil
+	}
+
+	return p.parseClause()
+}
+
+func (p *filterParser) parseClause() (embeddedFilter, error) {
+	p.skipSpaces()
+	sep := strings.IndexByte(p.input[p.pos:], ':')
+	if sep <= 0 {
+		return nil, p.errorf("expected 'field:value' at '%s'", p.input[p.pos:])
+	}
+	clause := &clauseFilter{field: strings.TrimSpace(p.input[p.pos : p.pos+sep])}
+	p.pos += sep + 1
+
+	p.skipSpaces()
+	for _, op := range []string{opNe, opGte, opLte, opEq, opGt, opLt} {
+		if strings.HasPrefix(p.input[p.pos:], op) {
+			clause.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+
+	p.skipSpaces()
+	if len(clause.op) == 0 && !p.eof() && p.input[p.pos] == '(' {
+		end := strings.IndexByte(p.input[p.pos:], ')')
+		if end < 0 {
+			return nil, p.errorf("missing ')'")
+		}
+		f, err := parseGeoFilter(clause.field, p.input[p.pos+1:p.pos+end])
+		if err != nil {
+			return nil, p.errorf("%s", err.Error())
+		}
+		p.pos += end + 1
+		return f, nil
+	}
+
+	if !p.eof() && p.input[p.pos] == '[' {
+		end := p.scanValue(p.pos+1, "]")
+		if end >= len(p.input) {
+			return nil, p.errorf("missing ']'")
+		}
+		for _, v := range splitValues(p.input[p.pos+1 : end]) {
+			clause.values = append(clause.values, unquote(v))
+		}
+		p.pos = end + 1
+	} else {
+		end := p.scanValue(p.pos, "")
+		clause.values = []string{unquote(strings.TrimSpace(p.input[p.pos:end]))}
+		p.pos = end
+	}
+
+	return clause, nil
+}
+
+// scanValue returns the end of the value starting at pos. A value ends at the terminator, at a logical operator or at
+// the closing parenthesis of an enclosing group. Backtick quoted parts of the value are skipped.
+func (p *filterParser) scanValue(pos int, terminator string) int {
+	quoted := false
+	for ; pos < len(p.input); pos++ {
+		c := p.input[pos]
+		switch {
+		case c == '`':
+			quoted = !quoted
+		case quoted:
+		case len(terminator) > 0:
+			if strings.HasPrefix(p.input[pos:], terminator) {
+				return pos
+			}
+		case strings.HasPrefix(p.input[pos:], "&&"), strings.HasPrefix(p.input[pos:], "||"):
+			return pos
+		case c == ')' && p.depth > 0:
+			return pos
+		}
+	}
+	return pos
+}
+
+func splitValues(list string) []string {
+	var (
+		values []string
+		quoted bool
+		start  int
+	)
+	for i := 0; i < len(list); i++ {
+		switch list[i] {
+		case '`':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				values = append(values, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(list[start:]); len(last) > 0 || len(values) > 0 {
+		values = append(values, last)
+	}
+	return values
+}
+
+func unquote(v string) string {
+	if len(v) >= 2 && v[0] == '`' && v[len(v)-1] == '`' {
+		return v[1 : len(v)-1]
+	}
+	return v
+}
+
+// fieldValues returns the values of the field in the document. The documents are flattened before indexing so the
+// field is first looked up by its full name, otherwise the dotted path is followed through the nested objects and
+// arrays. Array values are expanded.
+func fieldValues(doc map[string]any, field string) []any {
+	if v, ok := doc[field]; ok {
+		return appendValues(nil, v)
+	}
+
+	var values []any
+	collectPath(doc, strings.Split(field, "."), &values)
+	return values
+}
+
+func collectPath(v any, path []string, values *[]any) {
+	if len(path) == 0 {
+		*values = appendValues(*values, v)
+		return
+	}
+
+	switch conv := v.(type) {
+	case map[string]any:
+		for i := len(path); i > 0; i-- {
+			// nested keys may be flattened partially
+			if child, ok := conv[strings.Join(path[:i], ".")]; ok {
+				collectPath(child, path[i:], values)
+				return
+			}
+		}
+	case []any:
+		for _, elem := range conv {
+			collectPath(elem, path, values)
+		}
+	}
+}
+
+func appendValues(values []any, v any) []any {
+	switch conv := v.(type) {
+	case nil:
+		return values
+	case []any:
+		for _, elem := range conv {
+			values = appendValues(values, elem)
+		}
+		return values
+	default:
+		return append(values, v)
+	}
+}
+
+// compareLiteral compares the document value with the filter literal, the literal is interpreted according to the
+// type of the document value. It returns false if they are not comparable.
+func compareLiteral(docValue any, literal string) (int, bool) {
+	switch conv := docValue.(type) {
+	case string:
+		return strings.Compare(conv, literal), true
+	case bool:
+		b, err := strconv.ParseBool(literal)
+		if err != nil {
+			return 0, false
+		}
+		switch {
+		case conv == b:
+			return 0, true
+		case !conv:
+			return -1, true
+		default:
+			return 1, true
+		}
+	case json.Number:
+		return compareNumbers(string(conv), literal)
+	case float64:
+		return compareNumbers(strconv.FormatFloat(conv, 'g', -1, 64), literal)
+	case int64:
+		return compareNumbers(strconv.FormatInt(conv, 10), literal)
+	case int:
+		return compareNumbers(strconv.Itoa(conv), literal)
+	}
+	return 0, false
+}
+
+// compareNumbers compares the integers exactly and falls back to floating point for the rest.
+func compareNumbers(a string, b string) (int, bool) {
+	if ai, err := strconv.ParseInt(a, 10, 64); err == nil {
+		if bi, err := strconv.ParseInt(b, 10, 64); err == nil {
+			switch {
+			case ai < bi:
+				return -1, true
+			case ai > bi:
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+	}
+
+	af, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return 0, false
+	}
+	bf, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return 0, false
+	}
+	switch {
+	case af < bf:
+		return -1, true
+	case af > bf:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	qsearch "github.com/tigrisdata/tigris/query/search"
+)
+
+const (
+	defaultHighlightPreTag  = "<mark>"
+	defaultHighlightPostTag = "</mark>"
+	defaultSnippetLength    = 4
+
+	highlightMatchedTokens = "matched_tokens"
+	highlightSnippet       = "snippet"
+)
+
+// highlighter builds the "highlight" of the hits in the same shape as the search service, a field maps to its
+// matched tokens and the snippet, the array fields map to a list of them.
+type highlighter struct {
+	fields        []string
+	tokens        []string
+	snippetLength int
+	preTag        string
+	postTag       string
+}
+
+// newHighlighter returns nil if the query doesn't have any text to highlight.
+func (idx *embeddedIndex) newHighlighter(query *qsearch.Query) *highlighter {
+	tokens := tokenize(query.Q)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	hl := &highlighter{
+		fields:        query.SearchFields,
+		tokens:        tokens,
+		snippetLength: defaultSnippetLength,
+		preTag:        defaultHighlightPreTag,
+		postTag:       defaultHighlightPostTag,
+	}
+	if len(hl.fields) == 0 {
+		hl.fields = idx.textFields()
+	}
+	if h := query.Highlight; h != nil {
+		if len(h.Fields) > 0 {
+			hl.fields = h.Fields
+		}
+		if h.SnippetLength > 0 {
+			hl.snippetLength = h.SnippetLength
+		}
+		if len(h.PreTag) > 0 {
+			hl.preTag = h.PreTag
+		}
+		if len(h.PostTag) > 0 {
+			hl.postTag = h.PostTag
+		}
+	}
+
+	return hl
+}
+
+func (hl *highlighter) highlight(doc map[string]any) map[string]any {
+	highlight := make(map[string]any)
+	for _, field := range hl.fields {
+		switch conv := lookupField(doc, field).(type) {
+		case string:
+			highlight[field] = hl.snippet(conv)
+		case []any:
+			elems := make([]any, 0, len(conv))
+			for _, e := range conv {
+				if s, ok := e.(string); ok {
+					elems = append(elems, hl.snippet(s))
+				}
+			}
+			highlight[field] = elems
+		}
+	}
+	return highlight
+}
+
+func lookupField(doc map[string]any, field string) any {
+	if v, ok := doc[field]; ok {
+		return v
+	}
+
+	values := fieldValues(doc, field)
+	switch len(values) {
+	case 0:
+		return nil
+	case 1:
+		return values[0]
+	default:
+		return values
+	}
+}
+
+type wordSpan struct {
+	start, end int
+	matched    bool
+}
+
+// snippet marks the matched words of the text. The text up to twice the snippet length words is returned whole,
+// otherwise only the snippet length words around the first match.
+func (hl *highlighter) snippet(text string) map[string]any {
+	words := hl.words(text)
+
+	first := -1
+	matched := make([]any, 0)
+	for i, w := range words {
+		if w.matched {
+			if first == -1 {
+				first = i
+			}
+			matched = append(matched, text[w.start:w.end])
+		}
+	}
+
+	from, to := 0, len(words)
+	if first != -1 && len(words) > 2*hl.snippetLength+1 {
+		from = first - hl.snippetLength
+		if from < 0 {
+			from = 0
+		}
+		to = first + hl.snippetLength + 1
+		if to > len(words) {
+			to = len(words)
+		}
+	}
+
+	var sb strings.Builder
+	if len(words) > 0 {
+		start, end := 0, len(text)
+		if from > 0 {
+			start = words[from].start
+		}
+		if to < len(words) {
+			end = words[to-1].end
+		}
+
+		pos := start
+		for _, w := range words[from:to] {
+			if !w.matched {
+				continue
+			}
+			sb.WriteString(text[pos:w.start])
+			sb.WriteString(hl.preTag)
+			sb.WriteString(text[w.start:w.end])
+			sb.WriteString(hl.postTag)
+			pos = w.end
+		}
+		sb.WriteString(text[pos:end])
+	} else {
+		sb.WriteString(text)
+	}
+
+	return map[string]any{
+		highlightMatchedTokens: matched,
+		highlightSnippet:       sb.String(),
+	}
+}
+
+// words splits the text into the words the same way it is tokenized for the index and marks the ones matching the
+// query, the last query token matches as a prefix.
+func (hl *highlighter) words(text string) []wordSpan {
+	var (
+		words []wordSpan
+		start = -1
+	)
+	flush := func(end int) {
+		if start == -1 {
+			return
+		}
+		word := strings.ToLower(text[start:end])
+		w := wordSpan{start: start, end: end}
+		for i, t := range hl.tokens {
+			if word == t || (i == len(hl.tokens)-1 && strings.HasPrefix(word, t)) {
+				w.matched = true
+				break
+			}
+		}
+		words = append(words, w)
+		start = -1
+	}
+
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+	}
+	flush(len(text))
+
+	return words
+}
@@ -0,0 +1,766 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/tigrisdata/tigris/lib/geo"
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
+)
+
+const (
+	defaultEmbeddedGroupLimit = 3
+	textMatchSortField        = "_text_match"
+	vectorDistanceSortField   = "_vector_distance"
+	textMatchScale            = 1 << 20
+)
+
+type embeddedDoc struct {
+	seq    uint64
+	fields map[string]any
+}
+
+// embeddedIndex is the in-memory state of a single index of the embedded store. The documents are kept as decoded
+// maps, the same shape the search service returns them in, and the string fields are tokenized into an inverted
+// index of field -> token -> document id -> term frequency.
+type embeddedIndex struct {
+	schema tsApi.CollectionResponse
+	docs   map[string]*embeddedDoc
+	terms  map[string]map[string]map[string]int
+	seq    uint64
+	// synonyms are the synonym sets by id.
+	synonyms map[string]tsApi.SearchSynonymSchema
+}
+
+func newEmbeddedIndex(schema tsApi.CollectionResponse) *embeddedIndex {
+	return &embeddedIndex{
+		schema:   schema,
+		docs:     make(map[string]*embeddedDoc),
+		terms:    make(map[string]map[string]map[string]int),
+		synonyms: make(map[string]tsApi.SearchSynonymSchema),
+	}
+}
+
+func (idx *embeddedIndex) describe() *tsApi.CollectionResponse {
+	resp := idx.schema
+	resp.Fields = append([]tsApi.Field{}, idx.schema.Fields...)
+	numDocs := int64(len(idx.docs))
+	resp.NumDocuments = &numDocs
+	return &resp
+}
+
+// textFields returns the fields that are tokenized into the inverted index.
+func (idx *embeddedIndex) textFields() []string {
+	var fields []string
+	for _, f := range idx.schema.Fields {
+		if f.Index != nil && !*f.Index {
+			continue
+		}
+		if f.Type == "string" || f.Type == "string[]" {
+			fields = append(fields, f.Name)
+		}
+	}
+	return fields
+}
+
+func (idx *embeddedIndex) get(id string) (map[string]any, bool) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return nil, false
+	}
+	return doc.fields, true
+}
+
+func (idx *embeddedIndex) put(id string, fields map[string]any) {
+	idx.remove(id)
+
+	idx.seq++
+	idx.docs[id] = &embeddedDoc{seq: idx.seq, fields: fields}
+	for _, field := range idx.textFields() {
+		for _, v := range fieldValues(fields, field) {
+			s, ok := v.(string)
+			if !ok {
+				continue
+			}
+			for _, token := range tokenize(s) {
+				idx.addPosting(field, token, id)
+			}
+		}
+	}
+}
+
+func (idx *embeddedIndex) addPosting(field string, token string, id string) {
+	tokens, ok := idx.terms[field]
+	if !ok {
+		tokens = make(map[string]map[string]int)
+		idx.terms[field] = tokens
+	}
+	postings, ok := tokens[token]
+	if !ok {
+		postings = make(map[string]int)
+		tokens[token] = postings
+	}
+	postings[id]++
+}
+
+func (idx *embeddedIndex) remove(id string) bool {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return false
+	}
+
+	for field, tokens := range idx.terms {
+		for _, v := range fieldValues(doc.fields, field) {
+			s, ok := v.(string)
+			if !ok {
+				continue
+			}
+			for _, token := range tokenize(s) {
+				if postings, ok := tokens[token]; ok {
+					delete(postings, id)
+					if len(postings) == 0 {
+						delete(tokens, token)
+					}
+				}
+			}
+		}
+	}
+	delete(idx.docs, id)
+	return true
+}
+
+// update applies the field changes of the schema and re-indexes the documents.
+func (idx *embeddedIndex) update(fields []tsApi.Field) {
+	existing := idx.schema.Fields
+	for _, f := range fields {
+		if f.Drop != nil && *f.Drop {
+			for i := range existing {
+				if existing[i].Name == f.Name {
+					existing = append(existing[:i], existing[i+1:]...)
+					break
+				}
+			}
+			continue
+		}
+		existing = append(existing, f)
+	}
+	idx.schema.Fields = existing
+
+	ordered, docs := idx.orderedIds(), idx.docs
+	idx.docs = make(map[string]*embeddedDoc, len(docs))
+	idx.terms = make(map[string]map[string]map[string]int)
+	for _, id := range ordered {
+		idx.put(id, docs[id].fields)
+	}
+}
+
+// orderedIds returns the ids of the documents in the order they were indexed.
+func (idx *embeddedIndex) orderedIds() []string {
+	ids := make([]string, 0, len(idx.docs))
+	for id := range idx.docs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return idx.docs[ids[i]].seq < idx.docs[ids[j]].seq })
+	return ids
+}
+
+type embeddedHit struct {
+	id       string
+	doc      *embeddedDoc
+	score    float64
+	distance *float64
+	// geoDistance is the distance in meters of the geopoint fields from the point of the sort by distance.
+	geoDistance map[string]float64
+}
+
+// search runs the query and returns the requested page. The text query matches the documents containing all the
+// query tokens, the last token is matched as a prefix, a token also matches its synonyms and the terms within the
+// tolerated typos. The filter, facets and the total count apply to all the matching documents, the vector search ranks
+// the candidates by the cosine distance. The ranking customizes the order of the text matches by the field weights and
+// the rules, and pins or hides the documents.
+func (idx *embeddedIndex) search(query *qsearch.Query, pageNo int) (*tsApi.SearchResult, error) {
+	var filter embeddedFilter
+	if query.WrappedF != nil {
+		var err error
+		if filter, err = parseFilter(query.WrappedF.SearchFilter()); err != nil {
+			return nil, err
+		}
+	}
+
+	hits := idx.matchText(query)
+	if filter != nil {
+		filtered := hits[:0]
+		for _, h := range hits {
+			if filter.matches(h.doc.fields) {
+				filtered = append(filtered, h)
+			}
+		}
+		hits = filtered
+	}
+
+	if query.IsVectorSearch() {
+		hits = idx.rankByVector(hits, query.VectorS)
+	}
+	if query.Ranking.HasRules() && query.SortOrder == nil {
+		hits = rankEmbeddedHits(hits, query.Ranking)
+	} else {
+		sortHits(hits, query)
+	}
+	if query.Ranking != nil {
+		hits = idx.pinAndHide(hits, query.Ranking, filter)
+	}
+
+	perPage := query.PageSize
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	if pageNo <= 0 {
+		pageNo = 1
+	}
+
+	outOf := len(idx.docs)
+	result := &tsApi.SearchResult{
+		Page:  &pageNo,
+		OutOf: &outOf,
+	}
+	if facets := buildFacetCounts(hits, query); len(facets) > 0 {
+		result.FacetCounts = &facets
+	}
+
+	hl := idx.newHighlighter(query)
+	if query.IsGroupByQuery() {
+		groups := groupHits(hits, query.GroupBy, hl)
+		found := len(groups)
+		start, end := pageBounds(len(groups), pageNo, perPage)
+		page := groups[start:end]
+		result.Found = &found
+		result.GroupedHits = &page
+		return result, nil
+	}
+
+	found := len(hits)
+	start, end := pageBounds(len(hits), pageNo, perPage)
+	page := make([]tsApi.SearchResultHit, 0, end-start)
+	for _, h := range hits[start:end] {
+		page = append(page, h.toResultHit(hl))
+	}
+	result.Found = &found
+	result.Hits = &page
+
+	return result, nil
+}
+
+func pageBounds(total int, pageNo int, perPage int) (int, int) {
+	start := (pageNo - 1) * perPage
+	if start > total {
+		start = total
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+func (idx *embeddedIndex) matchText(query *qsearch.Query) []*embeddedHit {
+	tokens := tokenize(query.Q)
+	if len(tokens) == 0 {
+		hits := make([]*embeddedHit, 0, len(idx.docs))
+		for id, doc := range idx.docs {
+			hits = append(hits, &embeddedHit{id: id, doc: doc})
+		}
+		return hits
+	}
+
+	fields := query.SearchFields
+	if len(fields) == 0 {
+		fields = idx.textFields()
+	}
+	weights := make(map[string]float64, len(fields))
+	for i, field := range fields {
+		weights[field] = 1
+		if query.Ranking != nil && i < len(query.Ranking.Weights) && len(query.SearchFields) > 0 {
+			weights[field] = float64(query.Ranking.Weights[i])
+		}
+	}
+
+	opts := newMatchOptions(query.Typo)
+	var scores map[string]float64
+	for i, token := range tokens {
+		matcher := &tokenMatcher{
+			token:    token,
+			synonyms: expandSynonyms(idx.synonyms, token),
+			prefix:   opts.prefix && i == len(tokens)-1,
+			typos:    opts.allowedTypos(token),
+		}
+		matched := make(map[string]float64)
+		for _, field := range fields {
+			for term, postings := range idx.terms[field] {
+				weight := matcher.weight(term)
+				if weight == 0 {
+					continue
+				}
+				idf := math.Log(1 + float64(len(idx.docs))/float64(len(postings)))
+				for id, freq := range postings {
+					matched[id] += weights[field] * weight * idf * (1 + math.Log(float64(freq)))
+				}
+			}
+		}
+
+		if scores == nil {
+			scores = matched
+			continue
+		}
+		// all the tokens must match
+		for id, score := range scores {
+			if s, ok := matched[id]; ok {
+				scores[id] = score + s
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	hits := make([]*embeddedHit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, &embeddedHit{id: id, doc: idx.docs[id], score: score})
+	}
+	return hits
+}
+
+func (*embeddedIndex) rankByVector(hits []*embeddedHit, vs qsearch.VectorSearch) []*embeddedHit {
+	ranked := hits[:0]
+	for _, h := range hits {
+		vector, ok := toVector(fieldValues(h.doc.fields, vs.VectorF))
+		if !ok || len(vector) != len(vs.VectorV) {
+			continue
+		}
+		distance := vectorDistance(vs.Metric, vs.VectorV, vector)
+		if vs.DistanceThreshold != nil && distance > *vs.DistanceThreshold {
+			continue
+		}
+		h.distance = &distance
+		ranked = append(ranked, h)
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool { return *ranked[i].distance < *ranked[j].distance })
+	if vs.TopK > 0 && len(ranked) > vs.TopK {
+		ranked = ranked[:vs.TopK]
+	}
+	return ranked
+}
+
+// measureGeoDistance sets the distance of the geopoint field from the point on the hits having the field.
+func measureGeoDistance(hits []*embeddedHit, field string, from geo.Point) {
+	for _, h := range hits {
+		if p, ok := geoPointOf(h.doc.fields, field); ok {
+			if h.geoDistance == nil {
+				h.geoDistance = make(map[string]float64)
+			}
+			h.geoDistance[field] = from.DistanceTo(p)
+		}
+	}
+}
+
+// sortHits orders the hits by the requested sort fields, the text match score and the vector distance are available
+// as "_text_match" and "_vector_distance". The geopoint fields sorted by distance are ordered by the distance. Without the sort fields the hits are ordered by the vector distance for
+// the vector search, otherwise by the text match score. The ties are broken by the most recently indexed document.
+func sortHits(hits []*embeddedHit, query *qsearch.Query) {
+	var fields []sortKey
+	if query.SortOrder != nil {
+		for _, f := range *query.SortOrder {
+			if f.Near != nil {
+				measureGeoDistance(hits, f.Name, *f.Near)
+			}
+			fields = append(fields, sortKey{name: f.Name, ascending: f.Ascending, missingFirst: f.MissingValuesFirst, near: f.Near != nil})
+		}
+	}
+	if len(fields) == 0 {
+		if query.IsVectorSearch() {
+			fields = append(fields, sortKey{name: vectorDistanceSortField, ascending: true})
+		} else {
+			fields = append(fields, sortKey{name: textMatchSortField})
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		for _, f := range fields {
+			if cmp := f.compare(hits[i], hits[j]); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return hits[i].doc.seq > hits[j].doc.seq
+	})
+}
+
+// rankEmbeddedHits orders the text matches by the text match score followed by the ranking rules.
+func rankEmbeddedHits(hits []*embeddedHit, ranking *qsearch.Ranking) []*embeddedHit {
+	// the hits are pre-sorted so that the remaining ties are broken by the most recently indexed document
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].doc.seq > hits[j].doc.seq })
+
+	items := make([]rankingItem, len(hits))
+	for i, h := range hits {
+		items[i] = rankingItem{id: h.id, score: h.score, fields: h.doc.fields}
+	}
+
+	ranked := make([]*embeddedHit, 0, len(hits))
+	for _, i := range rankingOrder(items, ranking) {
+		ranked = append(ranked, hits[i])
+	}
+	return ranked
+}
+
+// pinAndHide drops the hidden documents and places the pinned documents on top, a pinned document is included even
+// if it is not matching the text query but it still needs to match the filter.
+func (idx *embeddedIndex) pinAndHide(hits []*embeddedHit, ranking *qsearch.Ranking, filter embeddedFilter) []*embeddedHit {
+	excluded := make(map[string]struct{}, len(ranking.Pinned)+len(ranking.Hidden))
+	for _, id := range ranking.Hidden {
+		excluded[id] = struct{}{}
+	}
+
+	matched := make(map[string]*embeddedHit, len(ranking.Pinned))
+	for _, h := range hits {
+		matched[h.id] = h
+	}
+
+	result := make([]*embeddedHit, 0, len(hits)+len(ranking.Pinned))
+	for _, id := range ranking.Pinned {
+		if _, ok := excluded[id]; ok {
+			continue
+		}
+		h, ok := matched[id]
+		if !ok {
+			doc, found := idx.docs[id]
+			if !found || (filter != nil && !filter.matches(doc.fields)) {
+				continue
+			}
+			h = &embeddedHit{id: id, doc: doc}
+		}
+		excluded[id] = struct{}{}
+		result = append(result, h)
+	}
+	for _, h := range hits {
+		if _, ok := excluded[h.id]; !ok {
+			result = append(result, h)
+		}
+	}
+
+	return result
+}
+
+type sortKey struct {
+	name         string
+	ascending    bool
+	missingFirst bool
+	near         bool
+}
+
+func (k sortKey) value(h *embeddedHit) any {
+	if k.near {
+		if d, ok := h.geoDistance[k.name]; ok {
+			return d
+		}
+		return nil
+	}
+
+	switch k.name {
+	case textMatchSortField:
+		return h.score
+	case vectorDistanceSortField:
+		if h.distance == nil {
+			return nil
+		}
+		return *h.distance
+	}
+
+	if values := fieldValues(h.doc.fields, k.name); len(values) > 0 {
+		return values[0]
+	}
+	return nil
+}
+
+// compare returns the position of "a" relative to "b" in the requested order.
+func (k sortKey) compare(a *embeddedHit, b *embeddedHit) int {
+	va, vb := k.value(a), k.value(b)
+	switch {
+	case va == nil && vb == nil:
+		return 0
+	case va == nil:
+		if k.missingFirst {
+			return -1
+		}
+		return 1
+	case vb == nil:
+		if k.missingFirst {
+			return 1
+		}
+		return -1
+	}
+
+	cmp := compareValues(va, vb)
+	if !k.ascending {
+		cmp = -cmp
+	}
+	return cmp
+}
+
+func compareValues(a any, b any) int {
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			if as, bs, ok := numberStrings(a, b); ok {
+				if cmp, ok := compareNumbers(as, bs); ok {
+					return cmp
+				}
+			}
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	return strings.Compare(valueString(a), valueString(b))
+}
+
+func numberStrings(a any, b any) (string, string, bool) {
+	na, okA := a.(json.Number)
+	nb, okB := b.(json.Number)
+	return string(na), string(nb), okA && okB
+}
+
+func toFloat(v any) (float64, bool) {
+	switch conv := v.(type) {
+	case json.Number:
+		f, err := conv.Float64()
+		return f, err == nil
+	case float64:
+		return conv, true
+	case int64:
+		return float64(conv), true
+	case int:
+		return float64(conv), true
+	}
+	return 0, false
+}
+
+func valueString(v any) string {
+	switch conv := v.(type) {
+	case string:
+		return conv
+	case json.Number:
+		return string(conv)
+	case bool:
+		return strconv.FormatBool(conv)
+	case float64:
+		return strconv.FormatFloat(conv, 'f', -1, 64)
+	}
+
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func buildFacetCounts(hits []*embeddedHit, query *qsearch.Query) []tsApi.FacetCounts {
+	size := query.ToSearchFacetSize()
+	facets := make([]tsApi.FacetCounts, 0, len(query.Facets.Fields))
+	for _, f := range query.Facets.Fields {
+		counts := make(map[string]int)
+		var (
+			numeric          = true
+			sum, minV, maxV  float64
+			totalNumericVals int
+		)
+		for _, h := range hits {
+			for _, v := range fieldValues(h.doc.fields, f.Name) {
+				counts[valueString(v)]++
+				if fv, ok := toFloat(v); ok {
+					if totalNumericVals == 0 || fv < minV {
+						minV = fv
+					}
+					if totalNumericVals == 0 || fv > maxV {
+						maxV = fv
+					}
+					sum += fv
+					totalNumericVals++
+				} else {
+					numeric = false
+				}
+			}
+		}
+
+		values := make([]string, 0, len(counts))
+		for v := range counts {
+			values = append(values, v)
+		}
+		sort.Slice(values, func(i, j int) bool {
+			if counts[values[i]] != counts[values[j]] {
+				return counts[values[i]] > counts[values[j]]
+			}
+			return values[i] < values[j]
+		})
+		if size > 0 && len(values) > size {
+			values = values[:size]
+		}
+
+		fc := tsApi.FacetCounts{FieldName: ptr(f.Name)}
+		fcCounts := make([]struct {
+			Count       *int    `json:"count,omitempty"`
+			Highlighted *string `json:"highlighted,omitempty"`
+			Value       *string `json:"value,omitempty"`
+		}, len(values))
+		for i, v := range values {
+			fcCounts[i].Count = ptr(counts[v])
+			fcCounts[i].Highlighted = ptr(v)
+			fcCounts[i].Value = ptr(v)
+		}
+		fc.Counts = &fcCounts
+
+		if numeric && totalNumericVals > 0 {
+			fc.Stats = &struct {
+				Avg         *float64 `json:"avg,omitempty"`
+				Max         *float64 `json:"max,omitempty"`
+				Min         *float64 `json:"min,omitempty"`
+				Sum         *float64 `json:"sum,omitempty"`
+				TotalValues *int     `json:"total_values,omitempty"`
+			}{
+				Avg:         ptr(sum / float64(totalNumericVals)),
+				Max:         ptr(maxV),
+				Min:         ptr(minV),
+				Sum:         ptr(sum),
+				TotalValues: ptr(len(counts)),
+			}
+		}
+		facets = append(facets, fc)
+	}
+
+	return facets
+}
+
+func groupHits(hits []*embeddedHit, groupBy qsearch.GroupBy, hl *highlighter) []tsApi.SearchGroupedHit {
+	limit := defaultEmbeddedGroupLimit
+	if groupBy.Limit != nil && *groupBy.Limit > 0 {
+		limit = int(*groupBy.Limit)
+	}
+
+	var groups []tsApi.SearchGroupedHit
+	positions := make(map[string]int)
+	for _, h := range hits {
+		key := make([]string, 0, len(groupBy.Fields))
+		for _, f := range groupBy.Fields {
+			values := fieldValues(h.doc.fields, f)
+			if len(values) == 0 {
+				key = append(key, "")
+				continue
+			}
+			key = append(key, valueString(values[0]))
+		}
+
+		joined := strings.Join(key, "\x00")
+		pos, ok := positions[joined]
+		if !ok {
+			pos = len(groups)
+			positions[joined] = pos
+			groups = append(groups, tsApi.SearchGroupedHit{GroupKey: key})
+		}
+		if len(groups[pos].Hits) < limit {
+			groups[pos].Hits = append(groups[pos].Hits, h.toResultHit(hl))
+		}
+	}
+
+	return groups
+}
+
+func (h *embeddedHit) toResultHit(hl *highlighter) tsApi.SearchResultHit {
+	doc := copyValue(h.doc.fields).(map[string]any)
+	textMatch := int64(h.score * textMatchScale)
+	hit := tsApi.SearchResultHit{
+		Document:       &doc,
+		TextMatch:      &textMatch,
+		VectorDistance: h.distance,
+	}
+	if hl != nil {
+		highlight := hl.highlight(h.doc.fields)
+		hit.Highlight = &highlight
+	}
+	if len(h.geoDistance) > 0 {
+		meters := make(map[string]int, len(h.geoDistance))
+		for field, d := range h.geoDistance {
+			meters[field] = int(math.Round(d))
+		}
+		hit.GeoDistanceMeters = &meters
+	}
+	return hit
+}
+
+// copyValue deep copies the document, the callers modify the returned documents while unpacking them.
+func copyValue(v any) any {
+	switch conv := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(conv))
+		for k, elem := range conv {
+			m[k] = copyValue(elem)
+		}
+		return m
+	case []any:
+		arr := make([]any, len(conv))
+		for i, elem := range conv {
+			arr[i] = copyValue(elem)
+		}
+		return arr
+	default:
+		return v
+	}
+}
+
+// tokenize splits the text into the lower cased words.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func containsTokens(tokens []string, expected []string) bool {
+	for _, e := range expected {
+		found := false
+		for _, t := range tokens {
+			if t == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func errIndexNotFound(name string) error {
+	return NewSearchError(http.StatusNotFound, ErrCodeNotFound, "Not found. No collection with name `%s` found.", name)
+}
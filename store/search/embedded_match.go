@@ -0,0 +1,200 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
+)
+
+// the defaults of the search service.
+const (
+	defaultNumTypos    = 2
+	defaultMinLen1Typo = 4
+	defaultMinLen2Typo = 7
+)
+
+// the weights of the ways a query token can match an indexed term.
+const (
+	exactMatchWeight   = 2.0
+	synonymMatchWeight = 1.5
+	prefixMatchWeight  = 1.0
+)
+
+// matchOptions are the typo tolerance settings applied while matching the query tokens.
+type matchOptions struct {
+	prefix      bool
+	numTypos    int
+	minLen1Typo int
+	minLen2Typo int
+}
+
+func newMatchOptions(typo *qsearch.TypoTolerance) matchOptions {
+	opts := matchOptions{
+		prefix:      true,
+		numTypos:    defaultNumTypos,
+		minLen1Typo: defaultMinLen1Typo,
+		minLen2Typo: defaultMinLen2Typo,
+	}
+	if typo == nil {
+		return opts
+	}
+
+	if typo.Prefix != nil {
+		opts.prefix = *typo.Prefix
+	}
+	if typo.NumTypos != nil {
+		opts.numTypos = *typo.NumTypos
+	}
+	if typo.MinLen1Typo != nil {
+		opts.minLen1Typo = *typo.MinLen1Typo
+	}
+	if typo.MinLen2Typo != nil {
+		opts.minLen2Typo = *typo.MinLen2Typo
+	}
+
+	return opts
+}
+
+// allowedTypos returns the number of typos tolerated for the token based on its length.
+func (o matchOptions) allowedTypos(token string) int {
+	typos := 0
+	switch n := utf8.RuneCountInString(token); {
+	case n >= o.minLen2Typo:
+		typos = 2
+	case n >= o.minLen1Typo:
+		typos = 1
+	}
+	if typos > o.numTypos {
+		typos = o.numTypos
+	}
+	return typos
+}
+
+// tokenMatcher matches a single query token against the indexed terms.
+type tokenMatcher struct {
+	token    string
+	synonyms []string
+	prefix   bool
+	typos    int
+}
+
+// weight returns the weight of the term for the token, zero if the term doesn't match. The exact match weighs more
+// than a synonym which weighs more than the prefix match, a match with typos weighs the least.
+func (m *tokenMatcher) weight(term string) float64 {
+	if term == m.token {
+		return exactMatchWeight
+	}
+	for _, s := range m.synonyms {
+		if term == s {
+			return synonymMatchWeight
+		}
+	}
+	if m.prefix && strings.HasPrefix(term, m.token) {
+		return prefixMatchWeight
+	}
+	if m.typos > 0 {
+		if d := editDistance(term, m.token, m.typos); d <= m.typos {
+			return prefixMatchWeight / float64(1+d)
+		}
+	}
+
+	return 0
+}
+
+// editDistance is the Levenshtein distance of the strings, once it is known to be over the max, max+1 is returned.
+func editDistance(a string, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > max || -diff > max {
+		return max + 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev, curr = curr, prev
+	}
+
+	if prev[len(rb)] > max {
+		return max + 1
+	}
+	return prev[len(rb)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// expandSynonyms returns the single word synonyms of the token. A synonym set without a root expands any of its words
+// to all the others, with a root only the root is expanded.
+func expandSynonyms(synonyms map[string]tsApi.SearchSynonymSchema, token string) []string {
+	var expanded []string
+	for _, syn := range synonyms {
+		if syn.Root != nil {
+			if strings.ToLower(*syn.Root) != token {
+				continue
+			}
+			for _, s := range syn.Synonyms {
+				expanded = append(expanded, strings.ToLower(s))
+			}
+			continue
+		}
+
+		found := false
+		for _, s := range syn.Synonyms {
+			if strings.ToLower(s) == token {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		for _, s := range syn.Synonyms {
+			if s = strings.ToLower(s); s != token {
+				expanded = append(expanded, s)
+			}
+		}
+	}
+
+	return expanded
+}
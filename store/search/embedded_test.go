@@ -0,0 +1,494 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tigrisdata/tigris/lib/geo"
+	"github.com/tigrisdata/tigris/query/filter"
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	"github.com/tigrisdata/tigris/query/sort"
+	"github.com/tigrisdata/tigris/schema"
+	"github.com/tigrisdata/tigris/server/config"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
+)
+
+func TestEmbeddedFilter(t *testing.T) {
+	doc := map[string]any{
+		"id":       "1",
+		"title":    "hello world",
+		"price":    json.Number("10.5"),
+		"count":    json.Number("9007199254740993"),
+		"active":   true,
+		"tags":     []any{"a", "b"},
+		"addr.zip": "94107",
+		"items":    []any{map[string]any{"sku": "x1"}, map[string]any{"sku": "x2"}},
+		"location": []any{json.Number("37.7749"), json.Number("-122.4194")},
+	}
+
+	cases := []struct {
+		filter  string
+		matches bool
+	}{
+		{"", true},
+		{"title:=hello world", true},
+		{"title:=hello", false},
+		{"title:hello", true},
+		{"title:!=hello world", false},
+		{"price:>10", true},
+		{"price:>=10.5", true},
+		{"price:<10.5", false},
+		{"count:=9007199254740993", true},
+		{"count:>9007199254740992", true},
+		{"active:=true", true},
+		{"active:=false", false},
+		{"tags:=b", true},
+		{"tags:[c, a]", true},
+		{"id: [2,3]", false},
+		{"addr.zip:=94107", true},
+		{"items.sku:=x2", true},
+		{"missing:=1", false},
+		{"price:>100 || title:=hello world", true},
+		{"price:>100 || title:=hello", false},
+		{"price:>10 && (active:=false || tags:=a)", true},
+		{"(price:>100 || active:=true) && tags:=c", false},
+		{"title:=`a && b`", false},
+		{"location:(37.7849, -122.4194, 2 km)", true},
+		{"location:(37.7849, -122.4194, 0.5 mi)", false},
+		{"location:(37.8, -122.5, 37.8, -122.3, 37.7, -122.3, 37.7, -122.5)", true},
+		{"location:(38.8, -122.5, 38.8, -122.3, 38.7, -122.3, 38.7, -122.5)", false},
+		{"location:(37.7749, -122.4194, 1 km) && price:>100", false},
+		{"location:(37.7749, -122.4194, 0 km)", true},
+		{"location:(37.7849, -122.4194, 0 km)", false},
+	}
+	for _, c := range cases {
+		f, err := parseFilter(c.filter)
+		require.NoError(t, err, c.filter)
+		if f == nil {
+			require.True(t, c.matches, c.filter)
+			continue
+		}
+		require.Equal(t, c.matches, f.matches(doc), c.filter)
+	}
+
+	for _, invalid := range []string{"title", "(title:=a", "tags:[a,b", "location:(37.7, -122.4, -1 km)"} {
+		_, err := parseFilter(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestEmbeddedSnippet(t *testing.T) {
+	hl := &highlighter{tokens: []string{"quick", "fox"}, snippetLength: 2, preTag: "[", postTag: "]"}
+
+	snippet := hl.snippet("The Quick brown fox")
+	require.Equal(t, []any{"Quick", "fox"}, snippet["matched_tokens"])
+	require.Equal(t, "The [Quick] brown [fox]", snippet["snippet"])
+
+	snippet = hl.snippet("one two three four quick five six seven, fox")
+	require.Equal(t, "three four [quick] five six", snippet["snippet"])
+
+	snippet = hl.snippet("nothing here at all, really nothing")
+	require.Empty(t, snippet["matched_tokens"])
+	require.Equal(t, "nothing here at all, really nothing", snippet["snippet"])
+}
+
+func newEmbeddedTestStore(t *testing.T, dir string) *EmbeddedStore {
+	s, err := NewEmbeddedStore(&config.EmbeddedSearchConfig{DataDir: dir, CompactionRatio: 2})
+	require.NoError(t, err)
+	return s
+}
+
+func embeddedTestFilter(t *testing.T, f string) *filter.WrappedFilter {
+	factory := filter.NewFactory([]*schema.QueryableField{
+		{FieldName: "brand", InMemoryAlias: "brand", DataType: schema.StringType},
+		{FieldName: "price", InMemoryAlias: "price", DataType: schema.Int64Type},
+		{FieldName: "location", InMemoryAlias: "location", DataType: schema.GeoPointType},
+	}, nil)
+
+	wrapped, err := factory.WrappedFilter([]byte(f))
+	require.NoError(t, err)
+	return wrapped
+}
+
+func indexEmbeddedDocs(t *testing.T, s *EmbeddedStore, action IndexAction, docs ...string) []IndexResp {
+	resp, err := s.IndexDocuments(context.TODO(), "products", strings.NewReader(strings.Join(docs, "\n")), IndexDocumentsOptions{
+		Action: action,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp, len(docs))
+	return resp
+}
+
+func hitIds(result []tsApi.SearchResult) []string {
+	var ids []string
+	for _, h := range *result[0].Hits {
+		ids = append(ids, (*h.Document)["id"].(string))
+	}
+	return ids
+}
+
+func TestEmbeddedStore(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+	s := newEmbeddedTestStore(t, dir)
+
+	ptrFalse := false
+	require.NoError(t, s.CreateCollection(ctx, &tsApi.CollectionSchema{
+		Name: "products",
+		Fields: []tsApi.Field{
+			{Name: "name", Type: "string"},
+			{Name: "brand", Type: "string"},
+			{Name: "sku", Type: "string", Index: &ptrFalse},
+			{Name: "price", Type: "int64"},
+			{Name: "vec", Type: "float[]"},
+		},
+	}))
+	require.True(t, IsErrDuplicateEntity(s.CreateCollection(ctx, &tsApi.CollectionSchema{Name: "products"})))
+
+	resp := indexEmbeddedDocs(t, s, Create,
+		`{"id":"1","name":"red running shoes","brand":"acme","sku":"shoe","price":100,"vec":[1,0]}`,
+		`{"id":"2","name":"blue running shorts","brand":"acme","price":40,"vec":[0,1]}`,
+		`{"id":"3","name":"red rain jacket","brand":"zeta","price":150,"vec":[0.9,0.1]}`,
+		`{"id":"1","name":"duplicate"}`,
+		`{"name":"no id"}`,
+	)
+	require.True(t, resp[0].Success && resp[1].Success && resp[2].Success)
+	require.Equal(t, 409, resp[3].Code)
+	require.Equal(t, 400, resp[4].Code)
+
+	resp = indexEmbeddedDocs(t, s, Update, `{"id":"2","price":45}`, `{"id":"4","price":1}`)
+	require.True(t, resp[0].Success)
+	require.Equal(t, 404, resp[1].Code)
+
+	t.Run("text", func(t *testing.T) {
+		result, err := s.Search(ctx, "products", qsearch.NewBuilder().Query("run").Build(), 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, *result[0].Found)
+		require.ElementsMatch(t, []string{"1", "2"}, hitIds(result))
+
+		result, err = s.Search(ctx, "products", qsearch.NewBuilder().Query("red shoes").Build(), 1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"1"}, hitIds(result))
+
+		// the non indexed fields are not searchable
+		result, err = s.Search(ctx, "products", qsearch.NewBuilder().Query("shoe").SearchFields([]string{"sku"}).Build(), 1)
+		require.NoError(t, err)
+		require.Equal(t, 0, *result[0].Found)
+	})
+
+	t.Run("highlight", func(t *testing.T) {
+		query := qsearch.NewBuilder().
+			Query("red").
+			Highlight(&qsearch.Highlight{Fields: []string{"name"}, PreTag: "<b>", PostTag: "</b>"}).
+			Build()
+		result, err := s.Search(ctx, "products", query, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, *result[0].Found)
+
+		highlight := *(*result[0].Hits)[0].Highlight
+		require.Len(t, highlight, 1)
+		require.Equal(t, []any{"red"}, highlight["name"].(map[string]any)["matched_tokens"])
+		require.Contains(t, highlight["name"].(map[string]any)["snippet"], "<b>red</b>")
+	})
+
+	t.Run("filter_sort_page", func(t *testing.T) {
+		query := qsearch.NewBuilder().
+			Filter(embeddedTestFilter(t, `{"brand": "acme"}`)).
+			SortOrder(&sort.Ordering{{Name: "price", Ascending: true}}).
+			PageSize(1).
+			Build()
+
+		result, err := s.Search(ctx, "products", query, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, *result[0].Found)
+		require.Equal(t, []string{"2"}, hitIds(result))
+		require.Equal(t, json.Number("45"), (*(*result[0].Hits)[0].Document)["price"])
+
+		result, err = s.Search(ctx, "products", query, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{"1"}, hitIds(result))
+	})
+
+	t.Run("facets", func(t *testing.T) {
+		query := qsearch.NewBuilder().
+			Facets(qsearch.Facets{Fields: []qsearch.FacetField{{Name: "brand", Size: 10}, {Name: "price", Size: 10}}}).
+			Build()
+
+		result, err := s.Search(ctx, "products", query, 1)
+		require.NoError(t, err)
+		facets := *result[0].FacetCounts
+		require.Len(t, facets, 2)
+		require.Equal(t, "acme", *(*facets[0].Counts)[0].Value)
+		require.Equal(t, 2, *(*facets[0].Counts)[0].Count)
+		require.Nil(t, facets[0].Stats)
+		require.Equal(t, float64(295), *facets[1].Stats.Sum)
+		require.Equal(t, float64(45), *facets[1].Stats.Min)
+	})
+
+	t.Run("group_by", func(t *testing.T) {
+		result, err := s.Search(ctx, "products", qsearch.NewBuilder().GroupBy(qsearch.GroupBy{Fields: []string{"brand"}}).Build(), 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, *result[0].Found)
+		require.Len(t, *result[0].GroupedHits, 2)
+	})
+
+	t.Run("vector", func(t *testing.T) {
+		query := qsearch.NewBuilder().VectorSearch(qsearch.VectorSearch{VectorF: "vec", VectorV: []float64{1, 0}, TopK: 2}).Build()
+		result, err := s.Search(ctx, "products", query, 1)
+		require.NoError(t, err)
+		require.Equal(t, []string{"1", "3"}, hitIds(result))
+		require.InDelta(t, 0, *(*result[0].Hits)[0].VectorDistance, 1e-9)
+	})
+
+	t.Run("multi_search", func(t *testing.T) {
+		results, err := s.MultiSearch(ctx, []IndexQuery{
+			{Table: "products", Query: qsearch.NewBuilder().Query("red").Build(), PageNo: 1},
+			{Table: "products", Query: qsearch.NewBuilder().Query("shorts").Build(), PageNo: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, 2, *results[0].Found)
+		require.Equal(t, []string{"2"}, hitIds(results[1:]))
+
+		_, err = s.MultiSearch(ctx, []IndexQuery{{Table: "missing", Query: qsearch.NewBuilder().Build(), PageNo: 1}})
+		require.True(t, IsErrNotFound(err))
+	})
+
+	count, err := s.DeleteDocuments(ctx, "products", embeddedTestFilter(t, `{"price": {"$gt": 120}}`))
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.NoError(t, s.DeleteDocument(ctx, "products", "2"))
+	require.True(t, IsErrNotFound(s.DeleteDocument(ctx, "products", "2")))
+
+	// the documents are restored from the log
+	require.NoError(t, s.Close())
+	s = newEmbeddedTestStore(t, dir)
+
+	result, err := s.GetDocuments(ctx, "products", []string{"1", "2", "3"})
+	require.NoError(t, err)
+	require.Equal(t, 1, *result.Found)
+	require.Equal(t, "red running shoes", (*(*result.Hits)[0].Document)["name"])
+
+	desc, err := s.DescribeCollection(ctx, "products")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), *desc.NumDocuments)
+
+	require.NoError(t, s.DropCollection(ctx, "products"))
+	_, err = s.DescribeCollection(ctx, "products")
+	require.True(t, IsErrNotFound(err))
+
+	require.NoError(t, s.Close())
+	s = newEmbeddedTestStore(t, dir)
+	all, err := s.AllCollections(ctx)
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
+
+func TestEmbeddedStoreCompaction(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+	s := newEmbeddedTestStore(t, dir)
+	require.NoError(t, s.CreateCollection(ctx, &tsApi.CollectionSchema{
+		Name:   "products",
+		Fields: []tsApi.Field{{Name: "name", Type: "string"}},
+	}))
+
+	for i := 0; i < 3*minCompactionEntries; i++ {
+		indexEmbeddedDocs(t, s, Replace, `{"id":"1","name":"first"}`, `{"id":"2","name":"second"}`)
+	}
+	coll, err := s.index("products")
+	require.NoError(t, err)
+	require.Less(t, coll.logEntries, 2*minCompactionEntries)
+
+	require.NoError(t, s.Close())
+	s = newEmbeddedTestStore(t, dir)
+	result, err := s.Search(ctx, "products", qsearch.NewBuilder().Query("second").Build(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, hitIds(result))
+}
+
+func TestEmbeddedStoreSearchSettings(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+	s := newEmbeddedTestStore(t, dir)
+	require.NoError(t, s.CreateCollection(ctx, &tsApi.CollectionSchema{
+		Name:   "products",
+		Fields: []tsApi.Field{{Name: "name", Type: "string"}},
+	}))
+	indexEmbeddedDocs(t, s, Create,
+		`{"id":"1","name":"television stand"}`,
+		`{"id":"2","name":"smartphone case"}`,
+		`{"id":"3","name":"wireless headphones"}`,
+	)
+
+	search := func(q string, typo *qsearch.TypoTolerance) []string {
+		result, err := s.Search(ctx, "products", qsearch.NewBuilder().Query(q).Typo(typo).Build(), 1)
+		require.NoError(t, err)
+		return hitIds(result)
+	}
+
+	t.Run("typos", func(t *testing.T) {
+		require.Equal(t, []string{"3"}, search("wireles headphnes", nil))
+		require.Equal(t, []string{"1"}, search("telvision", nil))
+
+		zero, one, ten := 0, 1, 10
+		require.Empty(t, search("telvision", &qsearch.TypoTolerance{NumTypos: &zero}))
+		require.Empty(t, search("wirelss", &qsearch.TypoTolerance{MinLen1Typo: &ten, MinLen2Typo: &ten}))
+		require.Empty(t, search("hedphnes", &qsearch.TypoTolerance{NumTypos: &one}))
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		ptrFalse := false
+		require.Equal(t, []string{"2"}, search("smart", nil))
+		require.Empty(t, search("smart", &qsearch.TypoTolerance{Prefix: &ptrFalse}))
+	})
+
+	t.Run("synonyms", func(t *testing.T) {
+		require.Empty(t, search("tv", nil))
+
+		require.NoError(t, s.UpsertSynonym(ctx, "products", "tv", &tsApi.SearchSynonymSchema{
+			Synonyms: []string{"tv", "Television"},
+		}))
+		require.NoError(t, s.UpsertSynonym(ctx, "products", "phone", &tsApi.SearchSynonymSchema{
+			Root:     ptr("phone"),
+			Synonyms: []string{"smartphone"},
+		}))
+		require.Equal(t, []string{"1"}, search("tv stand", nil))
+		require.Equal(t, []string{"2"}, search("phone", nil))
+		// the one way synonyms only expand the root
+		require.Empty(t, search("smartphone headphones", nil))
+
+		// the synonyms are restored when the store is opened
+		require.NoError(t, s.Close())
+		s = newEmbeddedTestStore(t, dir)
+		require.Equal(t, []string{"1"}, search("tv", nil))
+
+		require.NoError(t, s.DeleteSynonym(ctx, "products", "tv"))
+		require.True(t, IsErrNotFound(s.DeleteSynonym(ctx, "products", "tv")))
+		require.Empty(t, search("tv", nil))
+	})
+}
+
+func TestEmbeddedStoreRanking(t *testing.T) {
+	ctx := context.TODO()
+	s := newEmbeddedTestStore(t, t.TempDir())
+	require.NoError(t, s.CreateCollection(ctx, &tsApi.CollectionSchema{
+		Name: "products",
+		Fields: []tsApi.Field{
+			{Name: "title", Type: "string"},
+			{Name: "body", Type: "string"},
+			{Name: "popularity", Type: "int64"},
+			{Name: "published", Type: "int64"},
+		},
+	}))
+	indexEmbeddedDocs(t, s, Create,
+		`{"id":"1","title":"database internals","body":"storage engines","popularity":10,"published":100}`,
+		`{"id":"2","title":"storage engines","body":"database internals","popularity":50,"published":500}`,
+		`{"id":"3","title":"cooking","body":"database of recipes","popularity":90,"published":900}`,
+	)
+
+	search := func(q string, fields []string, ranking *qsearch.Ranking) []string {
+		result, err := s.Search(ctx, "products", qsearch.NewBuilder().Query(q).SearchFields(fields).Ranking(ranking).Build(), 1)
+		require.NoError(t, err)
+		return hitIds(result)
+	}
+
+	t.Run("weights", func(t *testing.T) {
+		fields := []string{"title", "body"}
+		require.Equal(t, "1", search("database", fields, &qsearch.Ranking{Weights: []int{5, 1}})[0])
+		require.NotEqual(t, "1", search("database", fields, &qsearch.Ranking{Weights: []int{1, 5}})[0])
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		// a single bucket ranks all the matches by the rule
+		require.Equal(t, []string{"3", "2", "1"}, search("database", nil, &qsearch.Ranking{
+			Buckets: 1,
+			Rules:   []qsearch.RankingRule{{Field: "popularity"}},
+		}))
+		require.Equal(t, []string{"1", "2", "3"}, search("database", nil, &qsearch.Ranking{
+			Buckets: 1,
+			Rules:   []qsearch.RankingRule{{Field: "popularity", Ascending: true}},
+		}))
+		// the closest to the origin ranks first
+		require.Equal(t, []string{"2", "1", "3"}, search("database", nil, &qsearch.Ranking{
+			Buckets: 1,
+			Rules:   []qsearch.RankingRule{{Field: "published", Decay: &qsearch.Decay{Origin: 450, Scale: 100}}},
+		}))
+	})
+
+	t.Run("pinned_hidden", func(t *testing.T) {
+		ranking := &qsearch.Ranking{
+			Buckets: 1,
+			Rules:   []qsearch.RankingRule{{Field: "popularity"}},
+			Pinned:  []string{"1"},
+			Hidden:  []string{"3"},
+		}
+		require.Equal(t, []string{"1", "2"}, search("database", nil, ranking))
+
+		// the pinned documents are included without matching the query
+		require.Equal(t, []string{"3", "2"}, search("storage", nil, &qsearch.Ranking{Pinned: []string{"3"}, Hidden: []string{"1"}}))
+	})
+}
+
+func TestEmbeddedStoreGeo(t *testing.T) {
+	ctx := context.TODO()
+	s := newEmbeddedTestStore(t, t.TempDir())
+	require.NoError(t, s.CreateCollection(ctx, &tsApi.CollectionSchema{
+		Name: "products",
+		Fields: []tsApi.Field{
+			{Name: "name", Type: "string"},
+			{Name: "location", Type: "geopoint"},
+		},
+	}))
+	indexEmbeddedDocs(t, s, Create,
+		`{"id":"1","name":"oakland","location":[37.8044,-122.2712]}`,
+		`{"id":"2","name":"san francisco","location":[37.7749,-122.4194]}`,
+		`{"id":"3","name":"san jose","location":[37.3382,-121.8863]}`,
+		`{"id":"4","name":"unknown"}`,
+	)
+
+	sf := geo.Point{Lat: 37.7749, Lng: -122.4194}
+	result, err := s.Search(ctx, "products", qsearch.NewBuilder().
+		SortOrder(&sort.Ordering{{Name: "location", Ascending: true, Near: &sf}}).
+		Build(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2", "1", "3", "4"}, hitIds(result))
+
+	hits := *result[0].Hits
+	require.Equal(t, 0, (*hits[0].GeoDistanceMeters)["location"])
+	require.InDelta(t, 13400, (*hits[1].GeoDistanceMeters)["location"], 500)
+	require.Nil(t, hits[3].GeoDistanceMeters)
+
+	result, err = s.Search(ctx, "products", qsearch.NewBuilder().
+		Filter(embeddedTestFilter(t, `{"location":{"$near":{"$point":[37.7749,-122.4194],"$maxDistance":20000}}}`)).
+		SortOrder(&sort.Ordering{{Name: "location", Ascending: false, Near: &sf}}).
+		Build(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, hitIds(result))
+}
+
+func TestEditDistance(t *testing.T) {
+	require.Equal(t, 0, editDistance("shoes", "shoes", 2))
+	require.Equal(t, 1, editDistance("shoes", "shoe", 2))
+	require.Equal(t, 1, editDistance("shoes", "shies", 2))
+	require.Equal(t, 2, editDistance("shoes", "shorts", 2))
+	require.Equal(t, 2, editDistance("kitten", "sitting", 1))
+	require.Equal(t, 3, editDistance("abc", "xyz123", 2))
+}
@@ -34,6 +34,14 @@ type storeImplWithMetrics struct {
 }
 
 func NewStoreWithMetrics(config *config.SearchConfig) (Store, error) {
+	if config.Embedded.Enabled {
+		s, err := NewEmbeddedStore(&config.Embedded)
+		if err != nil {
+			return nil, err
+		}
+		return &storeImplWithMetrics{s}, nil
+	}
+
 	client := typesense.NewClient(
 		typesense.WithServer(fmt.Sprintf("http://%s", net.JoinHostPort(config.Host, fmt.Sprintf("%d", config.Port)))),
 		typesense.WithAPIKey(config.AuthKey))
@@ -167,6 +175,25 @@ func (m *storeImplWithMetrics) Search(ctx context.Context, table string, query *
 	return
 }
 
+func (m *storeImplWithMetrics) MultiSearch(ctx context.Context, queries []IndexQuery) (result []tsApi.SearchResult, err error) {
+	reqStatus, reqStatusExists := metrics.RequestStatusFromContext(ctx)
+	m.measure(ctx, "MultiSearch", func(ctx context.Context) error {
+		result, err = m.s.MultiSearch(ctx, queries)
+		return err
+	})
+	if reqStatus != nil && reqStatusExists {
+		for range queries {
+			reqStatus.AddSearchUnit()
+		}
+		for _, res := range result {
+			if res.Hits != nil {
+				reqStatus.AddResultDocs(int64(len(*res.Hits)))
+			}
+		}
+	}
+	return
+}
+
 func (m *storeImplWithMetrics) GetDocuments(ctx context.Context, table string, ids []string) (result *tsApi.SearchResult, err error) {
 	reqStatus, reqStatusExists := metrics.RequestStatusFromContext(ctx)
 	m.measure(ctx, "Get", func(ctx context.Context) error {
@@ -192,3 +219,19 @@ func (m *storeImplWithMetrics) CreateDocument(ctx context.Context, table string,
 	})
 	return
 }
+
+func (m *storeImplWithMetrics) UpsertSynonym(ctx context.Context, table string, id string, synonym *tsApi.SearchSynonymSchema) (err error) {
+	m.measure(ctx, "UpsertSynonym", func(ctx context.Context) error {
+		err = m.s.UpsertSynonym(ctx, table, id, synonym)
+		return err
+	})
+	return
+}
+
+func (m *storeImplWithMetrics) DeleteSynonym(ctx context.Context, table string, id string) (err error) {
+	m.measure(ctx, "DeleteSynonym", func(ctx context.Context) error {
+		err = m.s.DeleteSynonym(ctx, table, id)
+		return err
+	})
+	return
+}
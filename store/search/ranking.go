@@ -0,0 +1,142 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"sort"
+
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
+)
+
+// rankingItem is a hit with the values it is ranked by.
+type rankingItem struct {
+	id     string
+	score  float64
+	fields map[string]any
+}
+
+// rankingOrder returns the positions of the items in the order of the ranking. The items are divided in buckets by
+// their text match score and the rules rank the items of a bucket, the remaining ties keep the input order. The pinned
+// items stay on top in the order they are pinned.
+func rankingOrder(items []rankingItem, ranking *qsearch.Ranking) []int {
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+
+	// the bucket of an item is by its rank of the text match score
+	byScore := make([]int, len(items))
+	copy(byScore, order)
+	sort.SliceStable(byScore, func(i, j int) bool { return items[byScore[i]].score > items[byScore[j]].score })
+	buckets := make([]float64, len(items))
+	for rank, i := range byScore {
+		if ranking.Buckets > 0 {
+			buckets[i] = -float64(rank * ranking.Buckets / len(items))
+		} else {
+			buckets[i] = items[i].score
+		}
+	}
+
+	pinned := make(map[string]int, len(ranking.Pinned))
+	for pos, id := range ranking.Pinned {
+		pinned[id] = pos
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		pa, aPinned := pinned[items[a].id]
+		pb, bPinned := pinned[items[b].id]
+		switch {
+		case aPinned && bPinned:
+			return pa < pb
+		case aPinned != bPinned:
+			return aPinned
+		case buckets[a] != buckets[b]:
+			return buckets[a] > buckets[b]
+		}
+
+		for _, rule := range ranking.Rules {
+			if cmp := compareByRule(rule, items[a].fields, items[b].fields); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+
+	return order
+}
+
+// compareByRule returns the position of "a" relative to "b" by the rule, the missing values are always last. The
+// decay score is ranked in the descending order, the closer to the origin the higher the rank.
+func compareByRule(rule qsearch.RankingRule, a map[string]any, b map[string]any) int {
+	va, aOk := ruleValue(rule, a)
+	vb, bOk := ruleValue(rule, b)
+	switch {
+	case !aOk && !bOk:
+		return 0
+	case !aOk:
+		return 1
+	case !bOk:
+		return -1
+	case va == vb:
+		return 0
+	case (va < vb) == (rule.Ascending && rule.Decay == nil):
+		return -1
+	default:
+		return 1
+	}
+}
+
+func ruleValue(rule qsearch.RankingRule, fields map[string]any) (float64, bool) {
+	values := fieldValues(fields, rule.Field)
+	if len(values) == 0 {
+		return 0, false
+	}
+	v, ok := toFloat(values[0])
+	if !ok {
+		return 0, false
+	}
+	if rule.Decay != nil {
+		return rule.Decay.Score(v), true
+	}
+	return v, true
+}
+
+// rankHits orders the candidates of the search service by the ranking, this is needed for the rules that the search
+// service can't sort by.
+func rankHits(result *tsApi.SearchResult, ranking *qsearch.Ranking) {
+	if result.Hits == nil {
+		return
+	}
+
+	hits := *result.Hits
+	items := make([]rankingItem, len(hits))
+	for i := range hits {
+		items[i].id = hitId(&hits[i])
+		if hits[i].TextMatch != nil {
+			items[i].score = float64(*hits[i].TextMatch)
+		}
+		if hits[i].Document != nil {
+			items[i].fields = *hits[i].Document
+		}
+	}
+
+	ranked := make([]tsApi.SearchResultHit, 0, len(hits))
+	for _, i := range rankingOrder(items, ranking) {
+		ranked = append(ranked, hits[i])
+	}
+	result.Hits = &ranked
+}
@@ -36,6 +36,13 @@ var (
 	Update  IndexAction = "update"
 )
 
+// IndexQuery is a single query of a multi search.
+type IndexQuery struct {
+	Table  string
+	Query  *qsearch.Query
+	PageNo int
+}
+
 type Store interface {
 	// AllCollections is to describe all search indexes.
 	AllCollections(ctx context.Context) (map[string]*tsApi.CollectionResponse, error)
@@ -57,11 +64,21 @@ type Store interface {
 	DeleteDocuments(ctx context.Context, table string, filter *filter.WrappedFilter) (int, error)
 	// Search is to search using Query.
 	Search(ctx context.Context, table string, query *qsearch.Query, pageNo int) ([]tsApi.SearchResult, error)
+	// MultiSearch is to run the queries on one or more indexes together, it returns a result per query.
+	MultiSearch(ctx context.Context, queries []IndexQuery) ([]tsApi.SearchResult, error)
 	// GetDocuments is to get a single or multiple documents by id.
 	GetDocuments(ctx context.Context, table string, ids []string) (*tsApi.SearchResult, error)
+	// UpsertSynonym is to create or replace a synonym set of the search index.
+	UpsertSynonym(ctx context.Context, table string, id string, synonym *tsApi.SearchSynonymSchema) error
+	// DeleteSynonym is to delete a synonym set of the search index.
+	DeleteSynonym(ctx context.Context, table string, id string) error
 }
 
 func NewStore(config *config.SearchConfig) (Store, error) {
+	if config.Embedded.Enabled {
+		return NewEmbeddedStore(&config.Embedded)
+	}
+
 	client := typesense.NewClient(
 		typesense.WithServer(fmt.Sprintf("http://%s", net.JoinHostPort(config.Host, fmt.Sprintf("%d", config.Port)))),
 		typesense.WithAPIKey(config.AuthKey))
@@ -97,6 +114,10 @@ func (*NoopStore) Search(context.Context, string, *qsearch.Query, int) ([]tsApi.
 	return nil, nil
 }
 
+func (*NoopStore) MultiSearch(context.Context, []IndexQuery) ([]tsApi.SearchResult, error) {
+	return nil, nil
+}
+
 func (*NoopStore) GetDocuments(_ context.Context, _ string, _ []string) (*tsApi.SearchResult, error) {
 	return nil, nil
 }
@@ -104,3 +125,9 @@ func (*NoopStore) GetDocuments(_ context.Context, _ string, _ []string) (*tsApi.
 func (*NoopStore) CreateDocument(_ context.Context, _ string, _ map[string]any) error {
 	return nil
 }
+
+func (*NoopStore) UpsertSynonym(context.Context, string, string, *tsApi.SearchSynonymSchema) error {
+	return nil
+}
+
+func (*NoopStore) DeleteSynonym(context.Context, string, string) error { return nil }
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/rs/zerolog/log"
@@ -123,6 +124,9 @@ func (*storeImpl) getBaseSearchParam(table string, query *qsearch.Query, pageNo
 	}
 	if fields := query.ToSearchFields(); len(fields) > 0 {
 		baseParam.QueryBy = &fields
+		if weights := query.ToSearchFieldWeights(); len(weights) > 0 {
+			baseParam.QueryByWeights = &weights
+		}
 	}
 	if facets := query.ToSearchFacets(); len(facets) > 0 {
 		baseParam.FacetBy = &facets
@@ -132,6 +136,14 @@ func (*storeImpl) getBaseSearchParam(table string, query *qsearch.Query, pageNo
 	}
 	if sortBy := query.ToSortFields(); len(sortBy) > 0 {
 		baseParam.SortBy = &sortBy
+	} else if sortBy = query.ToRankingSortFields(); len(sortBy) > 0 {
+		baseParam.SortBy = &sortBy
+	}
+	if pinned := query.ToPinnedHits(); len(pinned) > 0 {
+		baseParam.PinnedHits = &pinned
+	}
+	if hidden := query.ToHiddenHits(); len(hidden) > 0 {
+		baseParam.HiddenHits = &hidden
 	}
 	if groupBy := query.ToSearchGroupBy(); len(groupBy) > 0 {
 		baseParam.GroupBy = &groupBy
@@ -142,21 +154,139 @@ func (*storeImpl) getBaseSearchParam(table string, query *qsearch.Query, pageNo
 	if vector := query.ToSearchVector(); len(vector) > 0 {
 		baseParam.VectorQuery = &vector
 	}
+	if query.IsHighlightQuery() {
+		if fields := query.ToHighlightFields(); len(fields) > 0 {
+			baseParam.HighlightFields = &fields
+		}
+		if query.Highlight.SnippetLength > 0 {
+			baseParam.HighlightAffixNumTokens = &query.Highlight.SnippetLength
+		}
+		if len(query.Highlight.PreTag) > 0 {
+			baseParam.HighlightStartTag = &query.Highlight.PreTag
+		}
+		if len(query.Highlight.PostTag) > 0 {
+			baseParam.HighlightEndTag = &query.Highlight.PostTag
+		}
+	}
+	if query.Typo != nil {
+		baseParam.NumTypos = query.Typo.NumTypos
+		baseParam.MinLen1typo = query.Typo.MinLen1Typo
+		baseParam.MinLen2typo = query.Typo.MinLen2Typo
+		if query.Typo.Prefix != nil {
+			prefix := strconv.FormatBool(*query.Typo.Prefix)
+			baseParam.Prefix = &prefix
+		}
+	}
 
 	return baseParam
 }
 
-func (s *storeImpl) Search(_ context.Context, table string, query *qsearch.Query, pageNo int) ([]tsApi.SearchResult, error) {
-	var params []tsApi.MultiSearchCollectionParameters
-	params = append(params, s.getBaseSearchParam(table, query, pageNo))
+func (s *storeImpl) Search(ctx context.Context, table string, query *qsearch.Query, pageNo int) ([]tsApi.SearchResult, error) {
+	return s.MultiSearch(ctx, []IndexQuery{{Table: table, Query: query, PageNo: pageNo}})
+}
+
+// MultiSearch sends the searches of all the queries in a single multi search, a query may need more than one search
+// which are combined back into a single result per query.
+func (s *storeImpl) MultiSearch(_ context.Context, queries []IndexQuery) ([]tsApi.SearchResult, error) {
+	var (
+		params  []tsApi.MultiSearchCollectionParameters
+		plans   = make([]searchPlan, len(queries))
+		queried = make([]*qsearch.Query, len(queries))
+	)
+	for i, q := range queries {
+		plan, err := s.planSearch(q.Table, q.Query, q.PageNo)
+		if err != nil {
+			return nil, err
+		}
+		plans[i] = plan
+		params = append(params, plans[i].params...)
+		queried[i] = q.Query
+	}
+
+	results, err := s.multiSearch(queried, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	combined := make([]tsApi.SearchResult, len(queries))
+	for i, plan := range plans {
+		combined[i] = plan.combine(results[:len(plan.params)])
+		results = results[len(plan.params):]
+	}
+	return combined, nil
+}
+
+// searchPlan is the searches needed to serve a query and how their results are combined.
+type searchPlan struct {
+	params  []tsApi.MultiSearchCollectionParameters
+	combine func(results []tsApi.SearchResult) tsApi.SearchResult
+}
 
+func (s *storeImpl) planSearch(table string, query *qsearch.Query, pageNo int) (searchPlan, error) {
+	switch {
+	case query.IsQAndVectorBoth():
+		// the text and the vector candidates are fetched together and fused here
+		if err := checkCandidatesPage(query, pageNo); err != nil {
+			return searchPlan{}, err
+		}
+		window := candidatesWindow(query)
+		return searchPlan{
+			params: []tsApi.MultiSearchCollectionParameters{
+				s.getBaseSearchParam(table, candidatesQuery(query.TextQuery(), window), 1),
+				s.getBaseSearchParam(table, candidatesQuery(query.VectorQuery(), window), 1),
+			},
+			combine: func(results []tsApi.SearchResult) tsApi.SearchResult {
+				if needsVectorRescore(query.VectorS) {
+					rescoreVectorHits(&results[1], query.VectorS)
+				}
+				return *fuseHybrid(&results[0], &results[1], query, pageNo)
+			},
+		}, nil
+	case query.IsVectorSearch() && needsVectorRescore(query.VectorS) && !query.IsGroupByQuery():
+		if err := checkCandidatesPage(query, pageNo); err != nil {
+			return searchPlan{}, err
+		}
+		return searchPlan{
+			params: []tsApi.MultiSearchCollectionParameters{
+				s.getBaseSearchParam(table, candidatesQuery(query, candidatesWindow(query)), 1),
+			},
+			combine: func(results []tsApi.SearchResult) tsApi.SearchResult {
+				rescoreVectorHits(&results[0], query.VectorS)
+				return *pageOf(&results[0], query, pageNo)
+			},
+		}, nil
+	case query.Ranking.HasDecay() && query.SortOrder == nil && !query.IsGroupByQuery():
+		// the decay is computed on the candidates ranked by the text match, the hits after them are never returned
+		if err := checkCandidatesPage(query, pageNo); err != nil {
+			return searchPlan{}, err
+		}
+		return searchPlan{
+			params: []tsApi.MultiSearchCollectionParameters{
+				s.getBaseSearchParam(table, candidatesQuery(query, candidatesWindow(query)), 1),
+			},
+			combine: func(results []tsApi.SearchResult) tsApi.SearchResult {
+				rankHits(&results[0], query.Ranking)
+				return *pageOf(&results[0], query, pageNo)
+			},
+		}, nil
+	default:
+		return searchPlan{
+			params: []tsApi.MultiSearchCollectionParameters{s.getBaseSearchParam(table, query, pageNo)},
+			combine: func(results []tsApi.SearchResult) tsApi.SearchResult {
+				return results[0]
+			},
+		}, nil
+	}
+}
+
+func (s *storeImpl) multiSearch(queries []*qsearch.Query, params ...tsApi.MultiSearchCollectionParameters) ([]tsApi.SearchResult, error) {
 	res, err := s.client.MultiSearch.PerformWithContentType(&tsApi.MultiSearchParams{
 		MaxCandidates: &maxCandidates,
 	}, tsApi.MultiSearchSearchesParameter{
 		Searches: params,
 	}, StreamContentType)
 	if err != nil {
-		log.Error().Err(err).Interface("query", query).Msg("search error")
+		log.Error().Err(err).Interface("queries", queries).Msg("search error")
 		return nil, s.convertToInternalError(err)
 	}
 
@@ -166,10 +296,10 @@ func (s *storeImpl) Search(_ context.Context, table string, query *qsearch.Query
 
 	var dest tsApi.MultiSearchResult
 	if err := decoder.Decode(&dest); err != nil {
-		log.Error().Err(err).Interface("query", query).Msg("search error")
+		log.Error().Err(err).Interface("queries", queries).Msg("search error")
 		return nil, s.convertToInternalError(err)
 	}
-	for _, each := range dest.Results {
+	for i, each := range dest.Results {
 		if each.Hits == nil && each.GroupedHits == nil {
 			type errResult struct {
 				Code    int    `json:"code"`
@@ -181,12 +311,15 @@ func (s *storeImpl) Search(_ context.Context, table string, query *qsearch.Query
 			}
 
 			var errorsRes errorsResult
-			if err = jsoniter.Unmarshal(res.Body, &errorsRes); err == nil && len(errorsRes.Res) > 0 {
-				log.Error().Err(err).Interface("query", query).Msg("search error")
-				return nil, NewSearchError(errorsRes.Res[0].Code, ErrCodeUnhandled, errorsRes.Res[0].Message)
+			if err = jsoniter.Unmarshal(res.Body, &errorsRes); err == nil && len(errorsRes.Res) > i {
+				log.Error().Err(err).Interface("queries", queries).Msg("search error")
+				return nil, NewSearchError(errorsRes.Res[i].Code, ErrCodeUnhandled, errorsRes.Res[i].Message)
 			}
 		}
 	}
+	if len(dest.Results) != len(params) {
+		return nil, NewSearchError(http.StatusInternalServerError, ErrCodeUnhandled, "expected %d search results, found %d", len(params), len(dest.Results))
+	}
 
 	return dest.Results, nil
 }
@@ -230,6 +363,16 @@ func (s *storeImpl) DropCollection(_ context.Context, table string) error {
 	return s.convertToInternalError(err)
 }
 
+func (s *storeImpl) UpsertSynonym(_ context.Context, table string, id string, synonym *tsApi.SearchSynonymSchema) error {
+	_, err := s.client.Collection(table).Synonyms().Upsert(id, synonym)
+	return s.convertToInternalError(err)
+}
+
+func (s *storeImpl) DeleteSynonym(_ context.Context, table string, id string) error {
+	_, err := s.client.Collection(table).Synonym(id).Delete()
+	return s.convertToInternalError(err)
+}
+
 func (s *storeImpl) GetDocuments(_ context.Context, table string, ids []string) (*tsApi.SearchResult, error) {
 	filterBy := "id: ["
 	for i, id := range ids {
@@ -240,9 +383,12 @@ func (s *storeImpl) GetDocuments(_ context.Context, table string, ids []string)
 	}
 	filterBy += "]"
 
+	// the default page size is 10
+	perPage := len(ids)
 	res, err := s.client.Collection(table).Documents().Search(&tsApi.SearchCollectionParams{
 		Q:        "*",
 		FilterBy: &filterBy,
+		PerPage:  &perPage,
 	})
 
 	return res, err
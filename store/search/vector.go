@@ -0,0 +1,310 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"math"
+	"net/http"
+	"sort"
+
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	"github.com/tigrisdata/tigris/schema"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
+)
+
+const (
+	defaultPerPage = 10
+	// the maximum number of the candidates re-ranked by the store, the hybrid searches, the vector searches on the
+	// dot and l2 metrics and the searches ranked by a decay only return the hits among them.
+	maxRerankCandidates = 250
+)
+
+// vectorDistance returns the distance of the vectors in the metric, the smaller the distance the closer the vectors.
+// The cosine distance is the default as it is the only metric of the search service.
+func vectorDistance(metric string, a []float64, b []float64) float64 {
+	switch metric {
+	case schema.DotDistance:
+		var dot float64
+		for i := range a {
+			dot += a[i] * b[i]
+		}
+		return 1 - dot
+	case schema.L2Distance:
+		var sum float64
+		for i := range a {
+			sum += (a[i] - b[i]) * (a[i] - b[i])
+		}
+		return math.Sqrt(sum)
+	default:
+		return cosineDistance(a, b)
+	}
+}
+
+func cosineDistance(a []float64, b []float64) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 1
+	}
+	return 1 - dot/(math.Sqrt(normA)*math.Sqrt(normB))
+}
+
+func toVector(values []any) ([]float64, bool) {
+	vector := make([]float64, 0, len(values))
+	for _, v := range values {
+		f, ok := toFloat(v)
+		if !ok {
+			return nil, false
+		}
+		vector = append(vector, f)
+	}
+	return vector, len(vector) > 0
+}
+
+// hitDistance computes the distance of the hit from the query vector using the vector of the document.
+func hitDistance(hit *tsApi.SearchResultHit, vs qsearch.VectorSearch) (float64, bool) {
+	if hit.Document == nil {
+		return 0, false
+	}
+	vector, ok := toVector(fieldValues(*hit.Document, vs.VectorF))
+	if !ok || len(vector) != len(vs.VectorV) {
+		return 0, false
+	}
+	return vectorDistance(vs.Metric, vs.VectorV, vector), true
+}
+
+// needsVectorRescore returns true if the vector hits of the search service can't be used as is, the service only
+// ranks by the cosine distance and has no distance threshold. The rescored search is a re-ranking of the nearest
+// candidates by the cosine distance, a document outside of them is never returned even if it is closer by the metric.
+func needsVectorRescore(vs qsearch.VectorSearch) bool {
+	return (len(vs.Metric) > 0 && vs.Metric != schema.CosineDistance) || vs.DistanceThreshold != nil
+}
+
+// rescoreVectorHits ranks the candidates of the search service by the distance metric of the field and drops the
+// hits over the distance threshold.
+func rescoreVectorHits(result *tsApi.SearchResult, vs qsearch.VectorSearch) {
+	if result.Hits == nil {
+		return
+	}
+
+	hits := make([]tsApi.SearchResultHit, 0, len(*result.Hits))
+	for _, h := range *result.Hits {
+		if vs.Metric != schema.CosineDistance && len(vs.Metric) > 0 {
+			distance, ok := hitDistance(&h, vs)
+			if !ok {
+				continue
+			}
+			h.VectorDistance = &distance
+		}
+		if vs.DistanceThreshold != nil && (h.VectorDistance == nil || *h.VectorDistance > *vs.DistanceThreshold) {
+			continue
+		}
+		hits = append(hits, h)
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return *hits[i].VectorDistance < *hits[j].VectorDistance })
+	if vs.TopK > 0 && len(hits) > vs.TopK {
+		hits = hits[:vs.TopK]
+	}
+
+	found := len(hits)
+	result.Hits = &hits
+	result.Found = &found
+}
+
+// candidatesWindow is the number of the candidates fetched to re-rank them. The window doesn't depend on the page, so
+// all the pages are cut from the same ranking and report the same number of found hits.
+func candidatesWindow(query *qsearch.Query) int {
+	if query.VectorS.TopK > 0 && query.VectorS.TopK < maxRerankCandidates {
+		return query.VectorS.TopK
+	}
+	return maxRerankCandidates
+}
+
+// checkCandidatesPage returns an error if the page starts after the window of the candidates, such a page can't be
+// served by re-ranking the candidates.
+func checkCandidatesPage(query *qsearch.Query, pageNo int) error {
+	perPage := query.PageSize
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	if pageNo <= 0 {
+		pageNo = 1
+	}
+
+	if (pageNo-1)*perPage >= maxRerankCandidates {
+		return NewSearchError(http.StatusBadRequest, ErrCodeInvalid,
+			"page %d is out of the first %d re-ranked hits", pageNo, maxRerankCandidates)
+	}
+	return nil
+}
+
+// candidatesQuery returns the copy of the query to fetch the first window of candidates in a single page.
+func candidatesQuery(query *qsearch.Query, window int) *qsearch.Query {
+	candidates := *query
+	candidates.PageSize = window
+	return &candidates
+}
+
+// pageOf returns the result with only the hits of the page.
+func pageOf(result *tsApi.SearchResult, query *qsearch.Query, pageNo int) *tsApi.SearchResult {
+	perPage := query.PageSize
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	if pageNo <= 0 {
+		pageNo = 1
+	}
+
+	paged := *result
+	paged.Page = &pageNo
+	if result.Hits != nil {
+		start, end := pageBounds(len(*result.Hits), pageNo, perPage)
+		page := (*result.Hits)[start:end]
+		paged.Hits = &page
+	}
+	return &paged
+}
+
+type fusedHit struct {
+	hit      tsApi.SearchResultHit
+	score    float64
+	textRank int
+}
+
+// fuseHybrid combines the text and the vector hits of a hybrid search into a single ranking. The reciprocal rank
+// fusion only uses the positions of a hit in both the rankings, the alpha fusion weighs the min-max normalized text
+// match score and the vector distance. The hits keep both the text score and the vector distance, the distance of
+// the text only hits is computed from their vector so that every hit has both the scores.
+func fuseHybrid(text *tsApi.SearchResult, vector *tsApi.SearchResult, query *qsearch.Query, pageNo int) *tsApi.SearchResult {
+	var textHits, vectorHits []tsApi.SearchResultHit
+	if text.Hits != nil {
+		textHits = *text.Hits
+	}
+	if vector.Hits != nil {
+		vectorHits = *vector.Hits
+	}
+
+	fusion := query.VectorS.Fusion
+	hits := make(map[string]*fusedHit, len(textHits)+len(vectorHits))
+	var order []*fusedHit
+	for rank, h := range textHits {
+		id := hitId(&h)
+		if _, ok := hits[id]; ok {
+			continue
+		}
+		fh := &fusedHit{hit: h, textRank: rank}
+		if fusion.GetMethod() == qsearch.FusionRRF {
+			fh.score = 1 / float64(fusion.GetK()+rank+1)
+		}
+		hits[id] = fh
+		order = append(order, fh)
+	}
+	for rank, h := range vectorHits {
+		id := hitId(&h)
+		fh, ok := hits[id]
+		if !ok {
+			fh = &fusedHit{hit: h, textRank: len(textHits) + rank}
+			hits[id] = fh
+			order = append(order, fh)
+		} else {
+			fh.hit.VectorDistance = h.VectorDistance
+		}
+		if fusion.GetMethod() == qsearch.FusionRRF {
+			fh.score += 1 / float64(fusion.GetK()+rank+1)
+		}
+	}
+	for _, fh := range order {
+		if fh.hit.VectorDistance == nil {
+			if distance, ok := hitDistance(&fh.hit, query.VectorS); ok {
+				fh.hit.VectorDistance = &distance
+			}
+		}
+	}
+
+	if fusion.GetMethod() == qsearch.FusionAlpha {
+		alpha := fusion.GetAlpha()
+		textScore := normalizer(order, func(h *tsApi.SearchResultHit) (float64, bool) {
+			if h.TextMatch == nil {
+				return 0, false
+			}
+			return float64(*h.TextMatch), true
+		})
+		vectorScore := normalizer(order, func(h *tsApi.SearchResultHit) (float64, bool) {
+			if h.VectorDistance == nil {
+				return 0, false
+			}
+			// the closer the vectors the higher the score
+			return -*h.VectorDistance, true
+		})
+		for _, fh := range order {
+			fh.score = alpha*vectorScore(&fh.hit) + (1-alpha)*textScore(&fh.hit)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		if order[i].score != order[j].score {
+			return order[i].score > order[j].score
+		}
+		return order[i].textRank < order[j].textRank
+	})
+
+	fused := make([]tsApi.SearchResultHit, 0, len(order))
+	for _, fh := range order {
+		fused = append(fused, fh.hit)
+	}
+
+	found := len(fused)
+	result := &tsApi.SearchResult{
+		FacetCounts: text.FacetCounts,
+		Found:       &found,
+		OutOf:       text.OutOf,
+		Hits:        &fused,
+	}
+	return pageOf(result, query, pageNo)
+}
+
+// normalizer returns the min-max normalization of the value across the hits, the hits without the value score 0.
+func normalizer(hits []*fusedHit, value func(h *tsApi.SearchResultHit) (float64, bool)) func(h *tsApi.SearchResultHit) float64 {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, fh := range hits {
+		if v, ok := value(&fh.hit); ok {
+			min, max = math.Min(min, v), math.Max(max, v)
+		}
+	}
+
+	return func(h *tsApi.SearchResultHit) float64 {
+		v, ok := value(h)
+		switch {
+		case !ok:
+			return 0
+		case max == min:
+			return 1
+		default:
+			return (v - min) / (max - min)
+		}
+	}
+}
+
+func hitId(hit *tsApi.SearchResultHit) string {
+	if hit.Document == nil {
+		return ""
+	}
+	id, _ := (*hit.Document)[schema.SearchId].(string)
+	return id
+}
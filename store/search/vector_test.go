@@ -0,0 +1,186 @@
+// Copyright 2022-2023 Tigris Data, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	qsearch "github.com/tigrisdata/tigris/query/search"
+	"github.com/tigrisdata/tigris/schema"
+	tsApi "github.com/tigrisdata/typesense-go/typesense/api"
+)
+
+func TestVectorDistance(t *testing.T) {
+	require.InDelta(t, 0, vectorDistance(schema.CosineDistance, []float64{1, 0}, []float64{2, 0}), 1e-9)
+	require.InDelta(t, 1, vectorDistance("", []float64{1, 0}, []float64{0, 1}), 1e-9)
+	require.InDelta(t, -1, vectorDistance(schema.DotDistance, []float64{1, 0}, []float64{2, 0}), 1e-9)
+	require.InDelta(t, 5, vectorDistance(schema.L2Distance, []float64{0, 0}, []float64{3, 4}), 1e-9)
+}
+
+func testHit(id string, textMatch *int64, distance *float64, vec ...any) tsApi.SearchResultHit {
+	return tsApi.SearchResultHit{
+		Document:       &map[string]any{"id": id, "vec": vec},
+		TextMatch:      textMatch,
+		VectorDistance: distance,
+	}
+}
+
+func testHitIds(result *tsApi.SearchResult) []string {
+	var ids []string
+	for i := range *result.Hits {
+		ids = append(ids, hitId(&(*result.Hits)[i]))
+	}
+	return ids
+}
+
+func TestRescoreVectorHits(t *testing.T) {
+	result := &tsApi.SearchResult{Hits: &[]tsApi.SearchResultHit{
+		testHit("1", nil, ptr(0.0), 10.0, 0.0),
+		testHit("2", nil, ptr(0.1), 1.0, 1.0),
+		testHit("3", nil, ptr(0.2), 0.0, 1.0),
+	}}
+	rescoreVectorHits(result, qsearch.VectorSearch{
+		VectorF:           "vec",
+		VectorV:           []float64{0, 0},
+		Metric:            schema.L2Distance,
+		DistanceThreshold: ptr(5.0),
+	})
+	require.Equal(t, []string{"3", "2"}, testHitIds(result))
+	require.Equal(t, 2, *result.Found)
+	require.InDelta(t, 1, *(*result.Hits)[0].VectorDistance, 1e-9)
+}
+
+func TestCandidatesWindow(t *testing.T) {
+	query := qsearch.NewBuilder().
+		VectorSearch(qsearch.VectorSearch{VectorF: "vec", VectorV: []float64{1, 0}, Metric: schema.DotDistance}).
+		PageSize(50).
+		Build()
+
+	// every page is cut from the same candidates
+	require.Equal(t, maxRerankCandidates, candidatesWindow(query))
+	query.VectorS.TopK = 30
+	require.Equal(t, 30, candidatesWindow(query))
+
+	require.NoError(t, checkCandidatesPage(query, 1))
+	require.NoError(t, checkCandidatesPage(query, 5))
+	require.Error(t, checkCandidatesPage(query, 6))
+}
+
+func TestFuseHybrid(t *testing.T) {
+	text := &tsApi.SearchResult{Hits: &[]tsApi.SearchResultHit{
+		testHit("a", ptr(int64(300)), nil, 0.0, 1.0),
+		testHit("b", ptr(int64(200)), nil, 1.0, 0.0),
+		testHit("c", ptr(int64(100)), nil, 1.0, 1.0),
+	}}
+	vector := &tsApi.SearchResult{Hits: &[]tsApi.SearchResultHit{
+		testHit("b", nil, ptr(0.0), 1.0, 0.0),
+		testHit("d", nil, ptr(0.1), 0.9, 0.1),
+		testHit("c", nil, ptr(0.3), 1.0, 1.0),
+	}}
+
+	query := qsearch.NewBuilder().
+		Query("shoes").
+		VectorSearch(qsearch.VectorSearch{VectorF: "vec", VectorV: []float64{1, 0}}).
+		PageSize(2).
+		Build()
+
+	t.Run("rrf", func(t *testing.T) {
+		result := fuseHybrid(text, vector, query, 1)
+		require.Equal(t, 4, *result.Found)
+		require.Equal(t, []string{"b", "c"}, testHitIds(result))
+
+		// a hit keeps the scores of both the sides
+		first := (*result.Hits)[0]
+		require.Equal(t, int64(200), *first.TextMatch)
+		require.Equal(t, 0.0, *first.VectorDistance)
+
+		result = fuseHybrid(text, vector, query, 2)
+		require.Equal(t, []string{"a", "d"}, testHitIds(result))
+		// the distance of the text only hit is computed from its vector
+		require.InDelta(t, 1, *(*result.Hits)[0].VectorDistance, 1e-9)
+		require.Nil(t, (*result.Hits)[1].TextMatch)
+	})
+
+	t.Run("alpha", func(t *testing.T) {
+		query.VectorS.Fusion = &qsearch.Fusion{Method: qsearch.FusionAlpha, Alpha: ptr(1.0)}
+		query.PageSize = 4
+		require.Equal(t, []string{"b", "d", "c", "a"}, testHitIds(fuseHybrid(text, vector, query, 1)))
+
+		query.VectorS.Fusion.Alpha = ptr(0.0)
+		require.Equal(t, []string{"a", "b", "c", "d"}, testHitIds(fuseHybrid(text, vector, query, 1)))
+	})
+}
+
+func TestEmbeddedStoreHybridSearch(t *testing.T) {
+	ctx := context.TODO()
+	s := newEmbeddedTestStore(t, t.TempDir())
+	require.NoError(t, s.CreateCollection(ctx, &tsApi.CollectionSchema{
+		Name: "products",
+		Fields: []tsApi.Field{
+			{Name: "name", Type: "string"},
+			{Name: "vec", Type: "float[]"},
+		},
+	}))
+	indexEmbeddedDocs(t, s, Create,
+		`{"id":"1","name":"red running shoes","vec":[0,1]}`,
+		`{"id":"2","name":"red shoes","vec":[1,0]}`,
+		`{"id":"3","name":"blue jacket","vec":[0.9,0.1]}`,
+	)
+
+	query := qsearch.NewBuilder().
+		Query("shoes").
+		VectorSearch(qsearch.VectorSearch{VectorF: "vec", VectorV: []float64{1, 0}, Metric: schema.L2Distance}).
+		Build()
+	result, err := s.Search(ctx, "products", query, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, *result[0].Found)
+	require.Equal(t, "2", hitIds(result)[0])
+
+	query.VectorS.DistanceThreshold = ptr(0.5)
+	query.VectorS.Fusion = &qsearch.Fusion{Method: qsearch.FusionAlpha, Alpha: ptr(0.9)}
+	result, err = s.Search(ctx, "products", query, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2", "3", "1"}, hitIds(result))
+	for _, h := range *result[0].Hits {
+		require.NotNil(t, h.VectorDistance)
+	}
+}
+
+func TestRankHits(t *testing.T) {
+	hit := func(id string, textMatch int64, popularity int) tsApi.SearchResultHit {
+		doc := map[string]any{"id": id, "popularity": popularity}
+		return tsApi.SearchResultHit{Document: &doc, TextMatch: &textMatch}
+	}
+
+	result := &tsApi.SearchResult{Hits: &[]tsApi.SearchResultHit{
+		hit("a", 300, 1),
+		hit("b", 200, 3),
+		hit("c", 100, 2),
+		hit("d", 50, 9),
+	}}
+	rankHits(result, &qsearch.Ranking{
+		Buckets: 2,
+		Rules:   []qsearch.RankingRule{{Field: "popularity"}},
+		Pinned:  []string{"c"},
+	})
+	require.Equal(t, []string{"c", "b", "a", "d"}, hitIds([]tsApi.SearchResult{*result}))
+
+	// without the buckets the rules only break the ties
+	result = &tsApi.SearchResult{Hits: &[]tsApi.SearchResultHit{hit("a", 100, 1), hit("b", 100, 3), hit("c", 200, 2)}}
+	rankHits(result, &qsearch.Ranking{Rules: []qsearch.RankingRule{{Field: "popularity"}}})
+	require.Equal(t, []string{"c", "b", "a"}, hitIds([]tsApi.SearchResult{*result}))
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/assert"
@@ -89,6 +90,30 @@ func TestDeleteCache(t *testing.T) {
 	assert.Equal(t, "NOT_FOUND", code)
 }
 
+func TestDeleteCacheKeys(t *testing.T) {
+	project := setupTestsOnlyProject(t)
+	cn := getCacheName(t)
+
+	createCache(t, project, cn).Status(http.StatusOK)
+	for i := 0; i < 100; i++ {
+		setCacheKey(t, project, cn, fmt.Sprintf("k%d", i), fmt.Sprintf("value-%d", i)).Status(http.StatusOK)
+	}
+
+	deleteCache(t, project, cn).Status(http.StatusOK)
+
+	// the cache is hidden immediately
+	for _, c := range listCaches(t, project).Status(http.StatusOK).JSON().Object().Value("caches").Array().Iter() {
+		assert.NotEqual(t, cn, c.Object().Value("name").Raw())
+	}
+
+	// the cache is purged once the keys are removed and it can be created again, empty
+	require.Eventually(t, func() bool {
+		return createCache(t, project, cn).Raw().StatusCode == http.StatusOK
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assert.Empty(t, listCacheKeysAndRead(t, project, cn))
+}
+
 func TestListCaches(t *testing.T) {
 	project := setupTestsOnlyProject(t)
 	for i := 1; i <= 5; i++ {
@@ -120,6 +145,101 @@ func TestListCaches(t *testing.T) {
 	}
 }
 
+func TestCacheLimits(t *testing.T) {
+	project := setupTestsOnlyProject(t)
+	cn := getCacheName(t)
+
+	createCacheWithOptions(t, project, cn, CacheTestMap{"eviction_policy": "random"}).
+		Status(http.StatusBadRequest)
+
+	createCacheWithOptions(t, project, cn, CacheTestMap{"max_keys": 2, "eviction_policy": "lru"}).
+		Status(http.StatusOK)
+
+	for _, k := range []string{"k1", "k2", "k3"} {
+		setCacheKey(t, project, cn, k, "value").Status(http.StatusOK)
+	}
+	getCacheKey(t, project, cn, "k3").Status(http.StatusOK)
+	getCacheKey(t, project, cn, "k1").Status(http.StatusNotFound)
+
+	resp := describeCache(t, project, cn).Status(http.StatusOK).JSON().Object()
+	resp.ValueEqual("name", cn)
+	resp.Value("options").Object().
+		ValueEqual("max_keys", 2).
+		ValueEqual("eviction_policy", "lru")
+	stats := resp.Value("stats").Object()
+	stats.ValueEqual("keys", 2).
+		ValueEqual("hits", 1).
+		ValueEqual("misses", 1).
+		ValueEqual("evictions", 1)
+	stats.Value("memory_bytes").Number().Gt(0)
+
+	caches := listCaches(t, project).Status(http.StatusOK).JSON().Object().Value("caches").Array()
+	for _, c := range caches.Iter() {
+		if c.Object().Value("name").Raw() == cn {
+			c.Object().Value("options").Object().ValueEqual("max_keys", 2)
+		}
+	}
+
+	ttlOnly := cn + "_ttl"
+	createCacheWithOptions(t, project, ttlOnly, CacheTestMap{"max_keys": 1, "eviction_policy": "ttl"}).
+		Status(http.StatusOK)
+	setCacheKey(t, project, ttlOnly, "k1", "value").Status(http.StatusOK)
+	setCacheKey(t, project, ttlOnly, "k2", "value").Status(http.StatusTooManyRequests)
+	setCacheKey(t, project, ttlOnly, "k1", "updated").Status(http.StatusOK)
+
+	describeCache(t, project, "missing").Status(http.StatusNotFound)
+}
+
+func TestCollectionCache(t *testing.T) {
+	project, coll := setupTests(t)
+	readThrough := getCacheName(t)
+	writeThrough := readThrough + "_write_through"
+
+	createCacheWithOptions(t, project, readThrough, CacheTestMap{"collection": "missing"}).
+		Status(http.StatusNotFound)
+	createCacheWithOptions(t, project, readThrough, CacheTestMap{"write_through": true}).
+		Status(http.StatusBadRequest)
+
+	createCacheWithOptions(t, project, readThrough, CacheTestMap{"collection": coll}).
+		Status(http.StatusOK)
+	createCacheWithOptions(t, project, writeThrough, CacheTestMap{"collection": coll, "write_through": true}).
+		Status(http.StatusOK)
+
+	insertDocuments(t, project, coll, []Doc{{"pkey_int": 1, "int_value": 10}}, true).
+		Status(http.StatusOK)
+
+	// the missing key is loaded from the collection
+	for _, cn := range []string{readThrough, writeThrough} {
+		getCacheKey(t, project, cn, "1").Status(http.StatusOK).
+			JSON().Object().Value("value").Object().ValueEqual("int_value", 10)
+		getCacheKey(t, project, cn, "2").Status(http.StatusNotFound)
+		getCacheKey(t, project, cn, "not_a_number").Status(http.StatusBadRequest)
+	}
+
+	updateByFilter(t, project, coll, Map{"filter": Map{"pkey_int": 1}}, Map{"fields": Map{"$set": Map{"int_value": 20}}}, nil).
+		Status(http.StatusOK)
+
+	// the read-through cache is invalidated and loads the updated document, the write-through cache is refreshed
+	for _, cn := range []string{readThrough, writeThrough} {
+		getCacheKey(t, project, cn, "1").Status(http.StatusOK).
+			JSON().Object().Value("value").Object().ValueEqual("int_value", 20)
+	}
+
+	deleteByFilter(t, project, coll, Map{"filter": Map{"pkey_int": 1}}).Status(http.StatusOK)
+	for _, cn := range []string{readThrough, writeThrough} {
+		getCacheKey(t, project, cn, "1").Status(http.StatusNotFound)
+	}
+
+	caches := listCaches(t, project).Status(http.StatusOK).JSON().Object().Value("caches").Array()
+	for _, c := range caches.Iter() {
+		if c.Object().Value("name").Raw() == writeThrough {
+			c.Object().Value("options").Object().
+				ValueEqual("collection", coll).
+				ValueEqual("write_through", true)
+		}
+	}
+}
+
 func TestCacheCRUD(t *testing.T) {
 	project := setupTestsOnlyProject(t)
 	cacheName := getCacheName(t)
@@ -283,6 +403,212 @@ func TestCacheSetWithGet(t *testing.T) {
 	assert.Equal(t, "v2", oldValue1)
 }
 
+func TestCacheMGetMSet(t *testing.T) {
+	project := setupTestsOnlyProject(t)
+	cacheName := getCacheName(t)
+	createCache(t, project, cacheName)
+
+	e := cacheExpect(t)
+	e.POST(cacheOperationURL(project, cacheName, "mset")).
+		WithJSON(CacheTestMap{
+			"values": CacheTestMap{"k1": "v1", "k2": CacheTestMap{"a": 1}},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("status", cache.SetStatus)
+
+	e.POST(cacheOperationURL(project, cacheName, "mget")).
+		WithJSON(CacheTestMap{
+			"keys": []string{"k1", "k2", "k3"},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("values", CacheTestMap{"k1": "v1", "k2": CacheTestMap{"a": 1}})
+}
+
+func TestCacheCounters(t *testing.T) {
+	project := setupTestsOnlyProject(t)
+	cacheName := getCacheName(t)
+	createCache(t, project, cacheName)
+
+	e := cacheExpect(t)
+	e.POST(cacheKVOperationURL(project, cacheName, "counter", "incr")).
+		WithJSON(CacheTestMap{}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("value", 1)
+
+	e.POST(cacheKVOperationURL(project, cacheName, "counter", "incr")).
+		WithJSON(CacheTestMap{"delta": 10, "ex": 60}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("value", 11)
+
+	e.POST(cacheKVOperationURL(project, cacheName, "counter", "decr")).
+		WithJSON(CacheTestMap{"delta": 2}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("value", 9)
+
+	setCacheKey(t, project, cacheName, "k1", "v1").Status(http.StatusOK)
+	e.POST(cacheKVOperationURL(project, cacheName, "k1", "incr")).
+		WithJSON(CacheTestMap{}).
+		Expect().
+		Status(http.StatusBadRequest)
+}
+
+func TestCacheCompareAndSet(t *testing.T) {
+	project := setupTestsOnlyProject(t)
+	cacheName := getCacheName(t)
+	createCache(t, project, cacheName)
+
+	compareAndSet := func(expected any, value string) *httpexpect.Object {
+		return cacheExpect(t).POST(cacheKVOperationURL(project, cacheName, "k1", "cas")).
+			WithJSON(CacheTestMap{
+				"expected": expected,
+				"value":    value,
+			}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object()
+	}
+
+	// null expects the key to not exist
+	compareAndSet(nil, "v1").ValueEqual("status", cache.SetStatus).ValueEqual("swapped", true)
+	compareAndSet(nil, "v2").ValueEqual("status", cache.NotSetStatus)
+	compareAndSet("v2", "v3").ValueEqual("status", cache.NotSetStatus)
+	compareAndSet("v1", "v2").ValueEqual("status", cache.SetStatus)
+
+	getCacheKey(t, project, cacheName, "k1").
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("value", "v2")
+}
+
+func TestCacheStructures(t *testing.T) {
+	project := setupTestsOnlyProject(t)
+	cacheName := getCacheName(t)
+	createCache(t, project, cacheName)
+
+	e := cacheExpect(t)
+	t.Run("hash", func(t *testing.T) {
+		e.POST(cacheKVOperationURL(project, cacheName, "user1", "hset")).
+			WithJSON(CacheTestMap{"fields": CacheTestMap{"name": "alice", "age": 30}}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("added", 2)
+
+		e.GET(cacheKVOperationURL(project, cacheName, "user1", "hget")).
+			WithQuery("field", "age").
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("value", 30)
+
+		e.GET(cacheKVOperationURL(project, cacheName, "user1", "hgetall")).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("fields", CacheTestMap{"name": "alice", "age": 30})
+
+		// the hash is not a value
+		getCacheKey(t, project, cacheName, "user1").Status(http.StatusBadRequest)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		e.POST(cacheKVOperationURL(project, cacheName, "list1", "rpush")).
+			WithJSON(CacheTestMap{"values": []any{"b", "c"}}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("length", 2)
+		e.POST(cacheKVOperationURL(project, cacheName, "list1", "lpush")).
+			WithJSON(CacheTestMap{"values": []any{"a"}}).
+			Expect().
+			Status(http.StatusOK)
+
+		e.POST(cacheKVOperationURL(project, cacheName, "list1", "lpop")).
+			WithJSON(CacheTestMap{}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("value", "a")
+
+		e.GET(cacheKVOperationURL(project, cacheName, "list1", "lrange")).
+			WithQuery("start", 0).
+			WithQuery("stop", -1).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("values", []any{"b", "c"})
+	})
+
+	t.Run("sorted_set", func(t *testing.T) {
+		e.POST(cacheKVOperationURL(project, cacheName, "board", "zadd")).
+			WithJSON(CacheTestMap{"members": []CacheTestMap{
+				{"member": "a", "score": 10},
+				{"member": "b", "score": 30},
+				{"member": "c", "score": 20},
+			}}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("added", 3)
+
+		e.GET(cacheKVOperationURL(project, cacheName, "board", "zrange")).
+			WithQuery("start", 0).
+			WithQuery("stop", 1).
+			WithQuery("reverse", true).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("members", []CacheTestMap{{"member": "b", "score": 30}, {"member": "c", "score": 20}})
+
+		e.GET(cacheKVOperationURL(project, cacheName, "board", "zrange")).
+			WithQuery("by_score", true).
+			WithQuery("min", 15).
+			Expect().
+			Status(http.StatusOK).
+			JSON().
+			Object().
+			ValueEqual("members", []CacheTestMap{{"member": "c", "score": 20}, {"member": "b", "score": 30}})
+	})
+
+	// deleting the cache removes the structures along with the values, the cache can be created again once the
+	// keys are removed in the background
+	deleteCache(t, project, cacheName).Status(http.StatusOK)
+	require.Eventually(t, func() bool {
+		return createCache(t, project, cacheName).Raw().StatusCode == http.StatusOK
+	}, 10*time.Second, 100*time.Millisecond)
+	e.GET(cacheKVOperationURL(project, cacheName, "user1", "hgetall")).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		ValueEqual("fields", CacheTestMap{})
+}
+
 func setCacheKey(t *testing.T, project string, cache string, key string, value string) *httpexpect.Response {
 	e := cacheExpect(t)
 	return e.POST(cacheKVOperationURL(project, cache, key, "set")).
@@ -345,6 +671,20 @@ func createCache(t *testing.T, project string, cache string) *httpexpect.Respons
 		Expect()
 }
 
+func createCacheWithOptions(t *testing.T, project string, cache string, options CacheTestMap) *httpexpect.Response {
+	e := cacheExpect(t)
+	return e.POST(cacheOperationURL(project, cache, "create")).
+		WithJSON(CacheTestMap{"options": options}).
+		Expect()
+}
+
+func describeCache(t *testing.T, project string, cache string) *httpexpect.Response {
+	e := cacheExpect(t)
+	return e.POST(cacheOperationURL(project, cache, "describe")).
+		WithJSON(CacheTestMap{}).
+		Expect()
+}
+
 func listCaches(t *testing.T, project string) *httpexpect.Response {
 	e := cacheExpect(t)
 	return e.GET(fmt.Sprintf("/v1/projects/%s/caches/list", project)).
@@ -66,6 +66,8 @@ func ToSecondaryOrder(dataType schema.FieldType, val Value) int {
 		return 25
 	case schema.DateTimeType:
 		return 30
+	case schema.GeoPointType:
+		return 32
 	case schema.MaxType:
 		return SecondaryMaxOrder()
 	}
@@ -20,9 +20,11 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/tigrisdata/tigris/errors"
+	"github.com/tigrisdata/tigris/lib/geo"
 	"github.com/tigrisdata/tigris/schema"
 )
 
@@ -104,6 +106,12 @@ func NewValue(fieldType schema.FieldType, value []byte) (Value, error) {
 			return nil, err
 		}
 		return NewArrayValue(value, arr), nil
+	case schema.GeoPointType:
+		p, err := geo.ParsePointJSON(value)
+		if err != nil {
+			return nil, errors.InvalidArgument("unsupported value type: %s", err.Error())
+		}
+		return NewGeoPointValue(p), nil
 	}
 
 	return nil, errors.InvalidArgument("unsupported value type")
@@ -369,6 +377,56 @@ func (d *DateTimeValue) String() string {
 	return d.Value
 }
 
+// GeoPointValue is a location, it is compared and stored in the index keys by its geohash so that the nearby points
+// are stored close to each other. A value created from a geohash prefix represents the whole cell of the prefix and
+// is only used to bound the index scans.
+type GeoPointValue struct {
+	Point geo.Point
+	Hash  string
+}
+
+func NewGeoPointValue(p geo.Point) *GeoPointValue {
+	return &GeoPointValue{
+		Point: p,
+		Hash:  geo.Encode(p, geo.HashPrecision),
+	}
+}
+
+func NewGeoHashValue(hash string) *GeoPointValue {
+	return &GeoPointValue{
+		Hash: hash,
+	}
+}
+
+func (g *GeoPointValue) CompareTo(v Value) (int, error) {
+	if isNullValue(v) {
+		return 1, nil
+	}
+
+	converted, ok := v.(*GeoPointValue)
+	if !ok {
+		return -2, fmt.Errorf("wrong type compared ")
+	}
+
+	return strings.Compare(g.Hash, converted.Hash), nil
+}
+
+func (g *GeoPointValue) AsInterface() any {
+	return g.Hash
+}
+
+func (*GeoPointValue) DataType() schema.FieldType {
+	return schema.GeoPointType
+}
+
+func (g *GeoPointValue) String() string {
+	if g == nil {
+		return ""
+	}
+
+	return g.Point.String()
+}
+
 type BytesValue []byte
 
 func NewBytesValue(v []byte) *BytesValue {
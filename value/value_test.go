@@ -260,3 +260,23 @@ func TestUUIDAndDateValues(t *testing.T) {
 		require.Equal(t, -2, r)
 	})
 }
+
+func TestGeoPointValue(t *testing.T) {
+	v1, err := NewValue(schema.GeoPointType, []byte(`[37.7749, -122.4194]`))
+	require.NoError(t, err)
+	require.Equal(t, "9q8yyk8ytpxr", v1.AsInterface())
+	require.Equal(t, "[37.7749, -122.4194]", v1.String())
+
+	v2, _ := NewValue(schema.GeoPointType, []byte(`[48.8584, 2.2945]`))
+	r, _ := v1.CompareTo(v2)
+	require.Equal(t, -1, r)
+
+	// the point is inside the cell of its geohash prefix
+	r, _ = v1.CompareTo(NewGeoHashValue("9q8y"))
+	require.Equal(t, 1, r)
+	r, _ = v1.CompareTo(NewGeoHashValue("9q8y~"))
+	require.Equal(t, -1, r)
+
+	_, err = NewValue(schema.GeoPointType, []byte(`[37.7749]`))
+	require.Error(t, err)
+}